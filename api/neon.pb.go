@@ -14,6 +14,7 @@ package api
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -27,19 +28,98 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Outcome of pulling a feed.
+type PullFeedsResponse_Status int32
+
+const (
+	// The status is not set. Clients should treat the response as failed if the error is set, and
+	// as pulled otherwise.
+	PullFeedsResponse_UNSPECIFIED PullFeedsResponse_Status = 0
+	// The feed was pulled. The feed is only set if it has entries to return.
+	PullFeedsResponse_SUCCESS PullFeedsResponse_Status = 1
+	// The feed was pulled, but its remote content has not changed since the last pull.
+	PullFeedsResponse_NOT_MODIFIED PullFeedsResponse_Status = 2
+	// The feed was not pulled, as it is paused, backing off after failed pulls, or not yet due.
+	PullFeedsResponse_SKIPPED PullFeedsResponse_Status = 3
+	// The feed could not be pulled. The error is set.
+	PullFeedsResponse_FAIL PullFeedsResponse_Status = 4
+)
+
+// Enum value maps for PullFeedsResponse_Status.
+var (
+	PullFeedsResponse_Status_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SUCCESS",
+		2: "NOT_MODIFIED",
+		3: "SKIPPED",
+		4: "FAIL",
+	}
+	PullFeedsResponse_Status_value = map[string]int32{
+		"UNSPECIFIED":  0,
+		"SUCCESS":      1,
+		"NOT_MODIFIED": 2,
+		"SKIPPED":      3,
+		"FAIL":         4,
+	}
+)
+
+func (x PullFeedsResponse_Status) Enum() *PullFeedsResponse_Status {
+	p := new(PullFeedsResponse_Status)
+	*p = x
+	return p
+}
+
+func (x PullFeedsResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullFeedsResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_neon_proto_enumTypes[0].Descriptor()
+}
+
+func (PullFeedsResponse_Status) Type() protoreflect.EnumType {
+	return &file_neon_proto_enumTypes[0]
+}
+
+func (x PullFeedsResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullFeedsResponse_Status.Descriptor instead.
+func (PullFeedsResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{15, 0}
+}
+
 type Feed struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	FeedUrl       string                 `protobuf:"bytes,3,opt,name=feed_url,json=feedUrl,proto3" json:"feed_url,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	SiteUrl       *string                `protobuf:"bytes,5,opt,name=site_url,json=siteUrl,proto3,oneof" json:"site_url,omitempty"`
-	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
-	SubTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sub_time,json=subTime,proto3" json:"sub_time,omitempty"`
-	LastPullTime  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_pull_time,json=lastPullTime,proto3" json:"last_pull_time,omitempty"`
-	IsStarred     bool                   `protobuf:"varint,10,opt,name=is_starred,json=isStarred,proto3" json:"is_starred,omitempty"`
-	Entries       []*Entry               `protobuf:"bytes,15,rep,name=entries,proto3" json:"entries,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	FeedUrl      string                 `protobuf:"bytes,3,opt,name=feed_url,json=feedUrl,proto3" json:"feed_url,omitempty"`
+	Tags         []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	SiteUrl      *string                `protobuf:"bytes,5,opt,name=site_url,json=siteUrl,proto3,oneof" json:"site_url,omitempty"`
+	Description  *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	UpdateTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	SubTime      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sub_time,json=subTime,proto3" json:"sub_time,omitempty"`
+	LastPullTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_pull_time,json=lastPullTime,proto3" json:"last_pull_time,omitempty"`
+	IsStarred    bool                   `protobuf:"varint,10,opt,name=is_starred,json=isStarred,proto3" json:"is_starred,omitempty"`
+	// Interval between scheduled pulls of the feed, if it overrides the server default.
+	PullInterval *durationpb.Duration `protobuf:"bytes,11,opt,name=pull_interval,json=pullInterval,proto3,oneof" json:"pull_interval,omitempty"`
+	// Retention policy of the feed, overriding the global policy where set.
+	Retention *RetentionPolicy `protobuf:"bytes,12,opt,name=retention,proto3" json:"retention,omitempty"`
+	// Number of pulls of the feed that have failed since the last successful one.
+	ConsecutiveFailures uint32 `protobuf:"varint,13,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Error message of the last pull of the feed, if it failed.
+	LastError *string  `protobuf:"bytes,14,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	Entries   []*Entry `protobuf:"bytes,15,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Time before which the feed is not pulled, after failed pulls.
+	BackoffUntil *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=backoff_until,json=backoffUntil,proto3,oneof" json:"backoff_until,omitempty"`
+	// Whether pulls of the feed are paused, e.g. after the feed is reported gone.
+	IsPaused bool `protobuf:"varint,17,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	// Time before which the feed is not due a pull, according to its refresh hints.
+	NextPullTime *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=next_pull_time,json=nextPullTime,proto3,oneof" json:"next_pull_time,omitempty"`
+	// Whether the full content of new entries is extracted from the pages they link to after each
+	// pull, for feeds that only provide summaries.
+	FetchContent  bool `protobuf:"varint,19,opt,name=fetch_content,json=fetchContent,proto3" json:"fetch_content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -144,6 +224,34 @@ func (x *Feed) GetIsStarred() bool {
 	return false
 }
 
+func (x *Feed) GetPullInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PullInterval
+	}
+	return nil
+}
+
+func (x *Feed) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Feed) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *Feed) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
 func (x *Feed) GetEntries() []*Entry {
 	if x != nil {
 		return x.Entries
@@ -151,6 +259,284 @@ func (x *Feed) GetEntries() []*Entry {
 	return nil
 }
 
+func (x *Feed) GetBackoffUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BackoffUntil
+	}
+	return nil
+}
+
+func (x *Feed) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *Feed) GetNextPullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextPullTime
+	}
+	return nil
+}
+
+func (x *Feed) GetFetchContent() bool {
+	if x != nil {
+		return x.FetchContent
+	}
+	return false
+}
+
+// RetentionPolicy describes which entries are kept. Bookmarked entries are always kept.
+type RetentionPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of most recent entries kept per feed.
+	MaxEntries *uint32 `protobuf:"varint,1,opt,name=max_entries,json=maxEntries,proto3,oneof" json:"max_entries,omitempty"`
+	// Duration for which read entries are kept.
+	MaxReadAge    *durationpb.Duration `protobuf:"bytes,2,opt,name=max_read_age,json=maxReadAge,proto3,oneof" json:"max_read_age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_neon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetentionPolicy) GetMaxEntries() uint32 {
+	if x != nil && x.MaxEntries != nil {
+		return *x.MaxEntries
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetMaxReadAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxReadAge
+	}
+	return nil
+}
+
+// FeedCredentials are sent when fetching a feed, either for HTTP basic authentication or as a
+// bearer token. They are never returned by the server.
+type FeedCredentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	Password      *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Token         *string                `protobuf:"bytes,3,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedCredentials) Reset() {
+	*x = FeedCredentials{}
+	mi := &file_neon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedCredentials) ProtoMessage() {}
+
+func (x *FeedCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedCredentials.ProtoReflect.Descriptor instead.
+func (*FeedCredentials) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeedCredentials) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *FeedCredentials) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *FeedCredentials) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+// ExecOptions configure how the command of an exec: feed is run.
+type ExecOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Absolute path of the working directory of the command.
+	Dir           *string              `protobuf:"bytes,1,opt,name=dir,proto3,oneof" json:"dir,omitempty"`
+	Timeout       *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecOptions) Reset() {
+	*x = ExecOptions{}
+	mi := &file_neon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecOptions) ProtoMessage() {}
+
+func (x *ExecOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecOptions.ProtoReflect.Descriptor instead.
+func (*ExecOptions) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExecOptions) GetDir() string {
+	if x != nil && x.Dir != nil {
+		return *x.Dir
+	}
+	return ""
+}
+
+func (x *ExecOptions) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// ScrapeSpec defines a feed scraped from a web page that has no feed of its own, through CSS
+// selectors. The title, link, date, and summary selectors are matched within each item.
+type ScrapeSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Item  string                 `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// If not set, the first link of the item is used.
+	Link          *string `protobuf:"bytes,3,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	Date          *string `protobuf:"bytes,4,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	Summary       *string `protobuf:"bytes,5,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScrapeSpec) Reset() {
+	*x = ScrapeSpec{}
+	mi := &file_neon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScrapeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrapeSpec) ProtoMessage() {}
+
+func (x *ScrapeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrapeSpec.ProtoReflect.Descriptor instead.
+func (*ScrapeSpec) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScrapeSpec) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *ScrapeSpec) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ScrapeSpec) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *ScrapeSpec) GetDate() string {
+	if x != nil && x.Date != nil {
+		return *x.Date
+	}
+	return ""
+}
+
+func (x *ScrapeSpec) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
 type Entry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -164,13 +550,16 @@ type Entry struct {
 	Description   *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	Content       *string                `protobuf:"bytes,10,opt,name=content,proto3,oneof" json:"content,omitempty"`
 	Url           *string                `protobuf:"bytes,11,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Authors       []string               `protobuf:"bytes,12,rep,name=authors,proto3" json:"authors,omitempty"`
+	Categories    []string               `protobuf:"bytes,13,rep,name=categories,proto3" json:"categories,omitempty"`
+	Enclosures    []*Entry_Enclosure     `protobuf:"bytes,14,rep,name=enclosures,proto3" json:"enclosures,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Entry) Reset() {
 	*x = Entry{}
-	mi := &file_neon_proto_msgTypes[1]
+	mi := &file_neon_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -182,7 +571,7 @@ func (x *Entry) String() string {
 func (*Entry) ProtoMessage() {}
 
 func (x *Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[1]
+	mi := &file_neon_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +584,7 @@ func (x *Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entry.ProtoReflect.Descriptor instead.
 func (*Entry) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{1}
+	return file_neon_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Entry) GetId() uint32 {
@@ -275,20 +664,48 @@ func (x *Entry) GetUrl() string {
 	return ""
 }
 
+func (x *Entry) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Entry) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Entry) GetEnclosures() []*Entry_Enclosure {
+	if x != nil {
+		return x.Enclosures
+	}
+	return nil
+}
+
 type AddFeedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	IsStarred     *bool                  `protobuf:"varint,5,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL of the feed or of a page advertising it. Local feed files are given as file:// URLs, and
+	// feeds generated by a command as exec: URLs, e.g. "exec:./report.sh".
+	Url         string           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title       *string          `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string          `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Tags        []string         `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsStarred   *bool            `protobuf:"varint,5,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+	Credentials *FeedCredentials `protobuf:"bytes,6,opt,name=credentials,proto3,oneof" json:"credentials,omitempty"`
+	// Options of exec: feeds.
+	Exec *ExecOptions `protobuf:"bytes,7,opt,name=exec,proto3,oneof" json:"exec,omitempty"`
+	// If set, the URL is a web page whose entries are scraped with the spec.
+	Scrape        *ScrapeSpec `protobuf:"bytes,8,opt,name=scrape,proto3,oneof" json:"scrape,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddFeedRequest) Reset() {
 	*x = AddFeedRequest{}
-	mi := &file_neon_proto_msgTypes[2]
+	mi := &file_neon_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +717,7 @@ func (x *AddFeedRequest) String() string {
 func (*AddFeedRequest) ProtoMessage() {}
 
 func (x *AddFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[2]
+	mi := &file_neon_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +730,7 @@ func (x *AddFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFeedRequest.ProtoReflect.Descriptor instead.
 func (*AddFeedRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{2}
+	return file_neon_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AddFeedRequest) GetUrl() string {
@@ -351,17 +768,38 @@ func (x *AddFeedRequest) GetIsStarred() bool {
 	return false
 }
 
-type AddFeedResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Feed          *Feed                  `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
-	IsAdded       bool                   `protobuf:"varint,2,opt,name=is_added,json=isAdded,proto3" json:"is_added,omitempty"`
-	unknownFields protoimpl.UnknownFields
+func (x *AddFeedRequest) GetCredentials() *FeedCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *AddFeedRequest) GetExec() *ExecOptions {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *AddFeedRequest) GetScrape() *ScrapeSpec {
+	if x != nil {
+		return x.Scrape
+	}
+	return nil
+}
+
+type AddFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feed          *Feed                  `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	IsAdded       bool                   `protobuf:"varint,2,opt,name=is_added,json=isAdded,proto3" json:"is_added,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddFeedResponse) Reset() {
 	*x = AddFeedResponse{}
-	mi := &file_neon_proto_msgTypes[3]
+	mi := &file_neon_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -373,7 +811,7 @@ func (x *AddFeedResponse) String() string {
 func (*AddFeedResponse) ProtoMessage() {}
 
 func (x *AddFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[3]
+	mi := &file_neon_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +824,7 @@ func (x *AddFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFeedResponse.ProtoReflect.Descriptor instead.
 func (*AddFeedResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{3}
+	return file_neon_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AddFeedResponse) GetFeed() *Feed {
@@ -403,6 +841,95 @@ func (x *AddFeedResponse) GetIsAdded() bool {
 	return false
 }
 
+type DiscoverFeedsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL of the web page; if it points to a feed, the feed is the only candidate.
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverFeedsRequest) Reset() {
+	*x = DiscoverFeedsRequest{}
+	mi := &file_neon_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsRequest) ProtoMessage() {}
+
+func (x *DiscoverFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiscoverFeedsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type DiscoverFeedsResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Candidates    []*DiscoverFeedsResponse_Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverFeedsResponse) Reset() {
+	*x = DiscoverFeedsResponse{}
+	mi := &file_neon_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsResponse) ProtoMessage() {}
+
+func (x *DiscoverFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DiscoverFeedsResponse) GetCandidates() []*DiscoverFeedsResponse_Candidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
 type EditFeedsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ops           []*EditFeedsRequest_Op `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
@@ -412,7 +939,7 @@ type EditFeedsRequest struct {
 
 func (x *EditFeedsRequest) Reset() {
 	*x = EditFeedsRequest{}
-	mi := &file_neon_proto_msgTypes[4]
+	mi := &file_neon_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -424,7 +951,7 @@ func (x *EditFeedsRequest) String() string {
 func (*EditFeedsRequest) ProtoMessage() {}
 
 func (x *EditFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[4]
+	mi := &file_neon_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +964,7 @@ func (x *EditFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFeedsRequest.ProtoReflect.Descriptor instead.
 func (*EditFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{4}
+	return file_neon_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EditFeedsRequest) GetOps() []*EditFeedsRequest_Op {
@@ -456,7 +983,7 @@ type EditFeedsResponse struct {
 
 func (x *EditFeedsResponse) Reset() {
 	*x = EditFeedsResponse{}
-	mi := &file_neon_proto_msgTypes[5]
+	mi := &file_neon_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +995,7 @@ func (x *EditFeedsResponse) String() string {
 func (*EditFeedsResponse) ProtoMessage() {}
 
 func (x *EditFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[5]
+	mi := &file_neon_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +1008,7 @@ func (x *EditFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFeedsResponse.ProtoReflect.Descriptor instead.
 func (*EditFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{5}
+	return file_neon_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EditFeedsResponse) GetFeeds() []*Feed {
@@ -500,7 +1027,7 @@ type ListFeedsRequest struct {
 
 func (x *ListFeedsRequest) Reset() {
 	*x = ListFeedsRequest{}
-	mi := &file_neon_proto_msgTypes[6]
+	mi := &file_neon_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +1039,7 @@ func (x *ListFeedsRequest) String() string {
 func (*ListFeedsRequest) ProtoMessage() {}
 
 func (x *ListFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[6]
+	mi := &file_neon_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +1052,7 @@ func (x *ListFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedsRequest.ProtoReflect.Descriptor instead.
 func (*ListFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{6}
+	return file_neon_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListFeedsRequest) GetMaxEntriesPerFeed() uint32 {
@@ -544,7 +1071,7 @@ type ListFeedsResponse struct {
 
 func (x *ListFeedsResponse) Reset() {
 	*x = ListFeedsResponse{}
-	mi := &file_neon_proto_msgTypes[7]
+	mi := &file_neon_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +1083,7 @@ func (x *ListFeedsResponse) String() string {
 func (*ListFeedsResponse) ProtoMessage() {}
 
 func (x *ListFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[7]
+	mi := &file_neon_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +1096,7 @@ func (x *ListFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedsResponse.ProtoReflect.Descriptor instead.
 func (*ListFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{7}
+	return file_neon_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListFeedsResponse) GetFeeds() []*Feed {
@@ -583,13 +1110,16 @@ type PullFeedsRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	FeedIds           []uint32               `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
 	MaxEntriesPerFeed *uint32                `protobuf:"varint,2,opt,name=max_entries_per_feed,json=maxEntriesPerFeed,proto3,oneof" json:"max_entries_per_feed,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// If true, feeds are pulled even if they are paused, backing off after failed pulls, or not yet
+	// due according to their refresh hints.
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PullFeedsRequest) Reset() {
 	*x = PullFeedsRequest{}
-	mi := &file_neon_proto_msgTypes[8]
+	mi := &file_neon_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +1131,7 @@ func (x *PullFeedsRequest) String() string {
 func (*PullFeedsRequest) ProtoMessage() {}
 
 func (x *PullFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[8]
+	mi := &file_neon_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +1144,7 @@ func (x *PullFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullFeedsRequest.ProtoReflect.Descriptor instead.
 func (*PullFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{8}
+	return file_neon_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PullFeedsRequest) GetFeedIds() []uint32 {
@@ -631,18 +1161,28 @@ func (x *PullFeedsRequest) GetMaxEntriesPerFeed() uint32 {
 	return 0
 }
 
+func (x *PullFeedsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type PullFeedsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Feed          *Feed                  `protobuf:"bytes,2,opt,name=feed,proto3,oneof" json:"feed,omitempty"`
-	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Feed  *Feed                  `protobuf:"bytes,2,opt,name=feed,proto3,oneof" json:"feed,omitempty"`
+	Error *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// How long the pull waited to stay within the request limits of the feed host, if at all.
+	Throttled     *durationpb.Duration     `protobuf:"bytes,4,opt,name=throttled,proto3,oneof" json:"throttled,omitempty"`
+	Status        PullFeedsResponse_Status `protobuf:"varint,5,opt,name=status,proto3,enum=neon.PullFeedsResponse_Status" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PullFeedsResponse) Reset() {
 	*x = PullFeedsResponse{}
-	mi := &file_neon_proto_msgTypes[9]
+	mi := &file_neon_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +1194,7 @@ func (x *PullFeedsResponse) String() string {
 func (*PullFeedsResponse) ProtoMessage() {}
 
 func (x *PullFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[9]
+	mi := &file_neon_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +1207,7 @@ func (x *PullFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullFeedsResponse.ProtoReflect.Descriptor instead.
 func (*PullFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{9}
+	return file_neon_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PullFeedsResponse) GetUrl() string {
@@ -691,6 +1231,20 @@ func (x *PullFeedsResponse) GetError() string {
 	return ""
 }
 
+func (x *PullFeedsResponse) GetThrottled() *durationpb.Duration {
+	if x != nil {
+		return x.Throttled
+	}
+	return nil
+}
+
+func (x *PullFeedsResponse) GetStatus() PullFeedsResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PullFeedsResponse_UNSPECIFIED
+}
+
 type DeleteFeedsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FeedIds       []uint32               `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
@@ -700,7 +1254,7 @@ type DeleteFeedsRequest struct {
 
 func (x *DeleteFeedsRequest) Reset() {
 	*x = DeleteFeedsRequest{}
-	mi := &file_neon_proto_msgTypes[10]
+	mi := &file_neon_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +1266,7 @@ func (x *DeleteFeedsRequest) String() string {
 func (*DeleteFeedsRequest) ProtoMessage() {}
 
 func (x *DeleteFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[10]
+	mi := &file_neon_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +1279,7 @@ func (x *DeleteFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFeedsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{10}
+	return file_neon_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteFeedsRequest) GetFeedIds() []uint32 {
@@ -743,7 +1297,7 @@ type DeleteFeedsResponse struct {
 
 func (x *DeleteFeedsResponse) Reset() {
 	*x = DeleteFeedsResponse{}
-	mi := &file_neon_proto_msgTypes[11]
+	mi := &file_neon_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -755,7 +1309,7 @@ func (x *DeleteFeedsResponse) String() string {
 func (*DeleteFeedsResponse) ProtoMessage() {}
 
 func (x *DeleteFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[11]
+	mi := &file_neon_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +1322,7 @@ func (x *DeleteFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFeedsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{11}
+	return file_neon_proto_rawDescGZIP(), []int{17}
 }
 
 type ListEntriesRequest struct {
@@ -781,7 +1335,7 @@ type ListEntriesRequest struct {
 
 func (x *ListEntriesRequest) Reset() {
 	*x = ListEntriesRequest{}
-	mi := &file_neon_proto_msgTypes[12]
+	mi := &file_neon_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +1347,7 @@ func (x *ListEntriesRequest) String() string {
 func (*ListEntriesRequest) ProtoMessage() {}
 
 func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[12]
+	mi := &file_neon_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +1360,7 @@ func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
 func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{12}
+	return file_neon_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListEntriesRequest) GetFeedIds() []uint32 {
@@ -832,7 +1386,7 @@ type ListEntriesResponse struct {
 
 func (x *ListEntriesResponse) Reset() {
 	*x = ListEntriesResponse{}
-	mi := &file_neon_proto_msgTypes[13]
+	mi := &file_neon_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1398,7 @@ func (x *ListEntriesResponse) String() string {
 func (*ListEntriesResponse) ProtoMessage() {}
 
 func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[13]
+	mi := &file_neon_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1411,7 @@ func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
 func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{13}
+	return file_neon_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListEntriesResponse) GetEntries() []*Entry {
@@ -876,7 +1430,7 @@ type EditEntriesRequest struct {
 
 func (x *EditEntriesRequest) Reset() {
 	*x = EditEntriesRequest{}
-	mi := &file_neon_proto_msgTypes[14]
+	mi := &file_neon_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -888,7 +1442,7 @@ func (x *EditEntriesRequest) String() string {
 func (*EditEntriesRequest) ProtoMessage() {}
 
 func (x *EditEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[14]
+	mi := &file_neon_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1455,7 @@ func (x *EditEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditEntriesRequest.ProtoReflect.Descriptor instead.
 func (*EditEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{14}
+	return file_neon_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EditEntriesRequest) GetOps() []*EditEntriesRequest_Op {
@@ -920,7 +1474,7 @@ type EditEntriesResponse struct {
 
 func (x *EditEntriesResponse) Reset() {
 	*x = EditEntriesResponse{}
-	mi := &file_neon_proto_msgTypes[15]
+	mi := &file_neon_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -932,7 +1486,7 @@ func (x *EditEntriesResponse) String() string {
 func (*EditEntriesResponse) ProtoMessage() {}
 
 func (x *EditEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[15]
+	mi := &file_neon_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,7 +1499,7 @@ func (x *EditEntriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditEntriesResponse.ProtoReflect.Descriptor instead.
 func (*EditEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{15}
+	return file_neon_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EditEntriesResponse) GetEntries() []*Entry {
@@ -964,7 +1518,7 @@ type StreamEntriesRequest struct {
 
 func (x *StreamEntriesRequest) Reset() {
 	*x = StreamEntriesRequest{}
-	mi := &file_neon_proto_msgTypes[16]
+	mi := &file_neon_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -976,7 +1530,7 @@ func (x *StreamEntriesRequest) String() string {
 func (*StreamEntriesRequest) ProtoMessage() {}
 
 func (x *StreamEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[16]
+	mi := &file_neon_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,38 +1543,329 @@ func (x *StreamEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamEntriesRequest.ProtoReflect.Descriptor instead.
 func (*StreamEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{16}
+	return file_neon_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StreamEntriesRequest) GetFeedId() uint32 {
+	if x != nil {
+		return x.FeedId
+	}
+	return 0
+}
+
+type StreamEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *Entry                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEntriesResponse) Reset() {
+	*x = StreamEntriesResponse{}
+	mi := &file_neon_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntriesResponse) ProtoMessage() {}
+
+func (x *StreamEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntriesResponse.ProtoReflect.Descriptor instead.
+func (*StreamEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StreamEntriesResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntryRequest) Reset() {
+	*x = GetEntryRequest{}
+	mi := &file_neon_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryRequest) ProtoMessage() {}
+
+func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetEntryRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *Entry                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntryResponse) Reset() {
+	*x = GetEntryResponse{}
+	mi := &file_neon_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryResponse) ProtoMessage() {}
+
+func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetEntryResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetEntryResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ExtractEntryContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtractEntryContentRequest) Reset() {
+	*x = ExtractEntryContentRequest{}
+	mi := &file_neon_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractEntryContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractEntryContentRequest) ProtoMessage() {}
+
+func (x *ExtractEntryContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractEntryContentRequest.ProtoReflect.Descriptor instead.
+func (*ExtractEntryContentRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExtractEntryContentRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ExtractEntryContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entry with the extracted content. Its original content is kept as its description if it has
+	// none.
+	Entry         *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtractEntryContentResponse) Reset() {
+	*x = ExtractEntryContentResponse{}
+	mi := &file_neon_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractEntryContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractEntryContentResponse) ProtoMessage() {}
+
+func (x *ExtractEntryContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractEntryContentResponse.ProtoReflect.Descriptor instead.
+func (*ExtractEntryContentResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExtractEntryContentResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SearchEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Query uses the SQLite FTS5 query syntax, e.g. supporting "phrase queries".
+	Query         string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	FeedIds       []uint32 `protobuf:"varint,2,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	MaxResults    *uint32  `protobuf:"varint,4,opt,name=max_results,json=maxResults,proto3,oneof" json:"max_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEntriesRequest) Reset() {
+	*x = SearchEntriesRequest{}
+	mi := &file_neon_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntriesRequest) ProtoMessage() {}
+
+func (x *SearchEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SearchEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchEntriesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchEntriesRequest) GetFeedIds() []uint32 {
+	if x != nil {
+		return x.FeedIds
+	}
+	return nil
+}
+
+func (x *SearchEntriesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *StreamEntriesRequest) GetFeedId() uint32 {
-	if x != nil {
-		return x.FeedId
+func (x *SearchEntriesRequest) GetMaxResults() uint32 {
+	if x != nil && x.MaxResults != nil {
+		return *x.MaxResults
 	}
 	return 0
 }
 
-type StreamEntriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *Entry                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+type SearchEntriesResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Results       []*SearchEntriesResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamEntriesResponse) Reset() {
-	*x = StreamEntriesResponse{}
-	mi := &file_neon_proto_msgTypes[17]
+func (x *SearchEntriesResponse) Reset() {
+	*x = SearchEntriesResponse{}
+	mi := &file_neon_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamEntriesResponse) String() string {
+func (x *SearchEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEntriesResponse) ProtoMessage() {}
+func (*SearchEntriesResponse) ProtoMessage() {}
 
-func (x *StreamEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[17]
+func (x *SearchEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,40 +1876,43 @@ func (x *StreamEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEntriesResponse.ProtoReflect.Descriptor instead.
-func (*StreamEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SearchEntriesResponse.ProtoReflect.Descriptor instead.
+func (*SearchEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *StreamEntriesResponse) GetEntry() *Entry {
+func (x *SearchEntriesResponse) GetResults() []*SearchEntriesResponse_Result {
 	if x != nil {
-		return x.Entry
+		return x.Results
 	}
 	return nil
 }
 
-type GetEntryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type PruneEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Feeds whose entries are pruned; all feeds are pruned if empty.
+	FeedIds []uint32 `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
+	// If true, no entries are removed.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntryRequest) Reset() {
-	*x = GetEntryRequest{}
-	mi := &file_neon_proto_msgTypes[18]
+func (x *PruneEntriesRequest) Reset() {
+	*x = PruneEntriesRequest{}
+	mi := &file_neon_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntryRequest) String() string {
+func (x *PruneEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntryRequest) ProtoMessage() {}
+func (*PruneEntriesRequest) ProtoMessage() {}
 
-func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[18]
+func (x *PruneEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,40 +1923,49 @@ func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
-func (*GetEntryRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PruneEntriesRequest.ProtoReflect.Descriptor instead.
+func (*PruneEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetEntryRequest) GetId() uint32 {
+func (x *PruneEntriesRequest) GetFeedIds() []uint32 {
 	if x != nil {
-		return x.Id
+		return x.FeedIds
 	}
-	return 0
+	return nil
 }
 
-type GetEntryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *Entry                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+func (x *PruneEntriesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PruneEntriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entries that were removed, or would be removed in a dry run. Their description and content
+	// are not set.
+	Entries       []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntryResponse) Reset() {
-	*x = GetEntryResponse{}
-	mi := &file_neon_proto_msgTypes[19]
+func (x *PruneEntriesResponse) Reset() {
+	*x = PruneEntriesResponse{}
+	mi := &file_neon_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntryResponse) String() string {
+func (x *PruneEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntryResponse) ProtoMessage() {}
+func (*PruneEntriesResponse) ProtoMessage() {}
 
-func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[19]
+func (x *PruneEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,14 +1976,14 @@ func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntryResponse.ProtoReflect.Descriptor instead.
-func (*GetEntryResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PruneEntriesResponse.ProtoReflect.Descriptor instead.
+func (*PruneEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetEntryResponse) GetEntry() *Entry {
+func (x *PruneEntriesResponse) GetEntries() []*Entry {
 	if x != nil {
-		return x.Entry
+		return x.Entries
 	}
 	return nil
 }
@@ -1140,7 +1997,7 @@ type ExportOPMLRequest struct {
 
 func (x *ExportOPMLRequest) Reset() {
 	*x = ExportOPMLRequest{}
-	mi := &file_neon_proto_msgTypes[20]
+	mi := &file_neon_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1152,7 +2009,7 @@ func (x *ExportOPMLRequest) String() string {
 func (*ExportOPMLRequest) ProtoMessage() {}
 
 func (x *ExportOPMLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[20]
+	mi := &file_neon_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,7 +2022,7 @@ func (x *ExportOPMLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportOPMLRequest.ProtoReflect.Descriptor instead.
 func (*ExportOPMLRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{20}
+	return file_neon_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ExportOPMLRequest) GetTitle() string {
@@ -1184,7 +2041,7 @@ type ExportOPMLResponse struct {
 
 func (x *ExportOPMLResponse) Reset() {
 	*x = ExportOPMLResponse{}
-	mi := &file_neon_proto_msgTypes[21]
+	mi := &file_neon_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1196,7 +2053,7 @@ func (x *ExportOPMLResponse) String() string {
 func (*ExportOPMLResponse) ProtoMessage() {}
 
 func (x *ExportOPMLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[21]
+	mi := &file_neon_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +2066,7 @@ func (x *ExportOPMLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportOPMLResponse.ProtoReflect.Descriptor instead.
 func (*ExportOPMLResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{21}
+	return file_neon_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ExportOPMLResponse) GetPayload() []byte {
@@ -1228,7 +2085,7 @@ type ImportOPMLRequest struct {
 
 func (x *ImportOPMLRequest) Reset() {
 	*x = ImportOPMLRequest{}
-	mi := &file_neon_proto_msgTypes[22]
+	mi := &file_neon_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1240,7 +2097,7 @@ func (x *ImportOPMLRequest) String() string {
 func (*ImportOPMLRequest) ProtoMessage() {}
 
 func (x *ImportOPMLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[22]
+	mi := &file_neon_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,7 +2110,7 @@ func (x *ImportOPMLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportOPMLRequest.ProtoReflect.Descriptor instead.
 func (*ImportOPMLRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{22}
+	return file_neon_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ImportOPMLRequest) GetPayload() []byte {
@@ -1273,7 +2130,7 @@ type ImportOPMLResponse struct {
 
 func (x *ImportOPMLResponse) Reset() {
 	*x = ImportOPMLResponse{}
-	mi := &file_neon_proto_msgTypes[23]
+	mi := &file_neon_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1285,7 +2142,7 @@ func (x *ImportOPMLResponse) String() string {
 func (*ImportOPMLResponse) ProtoMessage() {}
 
 func (x *ImportOPMLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[23]
+	mi := &file_neon_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +2155,7 @@ func (x *ImportOPMLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportOPMLResponse.ProtoReflect.Descriptor instead.
 func (*ImportOPMLResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{23}
+	return file_neon_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ImportOPMLResponse) GetNumProcessed() uint32 {
@@ -1323,7 +2180,7 @@ type GetStatsRequest struct {
 
 func (x *GetStatsRequest) Reset() {
 	*x = GetStatsRequest{}
-	mi := &file_neon_proto_msgTypes[24]
+	mi := &file_neon_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1335,7 +2192,7 @@ func (x *GetStatsRequest) String() string {
 func (*GetStatsRequest) ProtoMessage() {}
 
 func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[24]
+	mi := &file_neon_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,7 +2205,7 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{24}
+	return file_neon_proto_rawDescGZIP(), []int{36}
 }
 
 type GetStatsResponse struct {
@@ -1360,7 +2217,7 @@ type GetStatsResponse struct {
 
 func (x *GetStatsResponse) Reset() {
 	*x = GetStatsResponse{}
-	mi := &file_neon_proto_msgTypes[25]
+	mi := &file_neon_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +2229,7 @@ func (x *GetStatsResponse) String() string {
 func (*GetStatsResponse) ProtoMessage() {}
 
 func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[25]
+	mi := &file_neon_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +2242,7 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{25}
+	return file_neon_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetStatsResponse) GetGlobal() *GetStatsResponse_Stats {
@@ -1403,7 +2260,7 @@ type GetInfoRequest struct {
 
 func (x *GetInfoRequest) Reset() {
 	*x = GetInfoRequest{}
-	mi := &file_neon_proto_msgTypes[26]
+	mi := &file_neon_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1415,7 +2272,7 @@ func (x *GetInfoRequest) String() string {
 func (*GetInfoRequest) ProtoMessage() {}
 
 func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[26]
+	mi := &file_neon_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +2285,7 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{26}
+	return file_neon_proto_rawDescGZIP(), []int{38}
 }
 
 type GetInfoResponse struct {
@@ -1442,7 +2299,7 @@ type GetInfoResponse struct {
 
 func (x *GetInfoResponse) Reset() {
 	*x = GetInfoResponse{}
-	mi := &file_neon_proto_msgTypes[27]
+	mi := &file_neon_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1454,7 +2311,7 @@ func (x *GetInfoResponse) String() string {
 func (*GetInfoResponse) ProtoMessage() {}
 
 func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[27]
+	mi := &file_neon_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1467,7 +2324,7 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{27}
+	return file_neon_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetInfoResponse) GetName() string {
@@ -1491,6 +2348,129 @@ func (x *GetInfoResponse) GetGitCommit() string {
 	return ""
 }
 
+// Enclosure is a media file attached to an entry.
+type Entry_Enclosure struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Url      string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MimeType *string                `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	// Declared size of the file in bytes.
+	Length        *uint64 `protobuf:"varint,3,opt,name=length,proto3,oneof" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entry_Enclosure) Reset() {
+	*x = Entry_Enclosure{}
+	mi := &file_neon_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entry_Enclosure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry_Enclosure) ProtoMessage() {}
+
+func (x *Entry_Enclosure) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry_Enclosure.ProtoReflect.Descriptor instead.
+func (*Entry_Enclosure) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Entry_Enclosure) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Entry_Enclosure) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
+	}
+	return ""
+}
+
+func (x *Entry_Enclosure) GetLength() uint64 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
+type DiscoverFeedsResponse_Candidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// Feed format, one of "rss", "atom", or "json".
+	Format        *string `protobuf:"bytes,3,opt,name=format,proto3,oneof" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverFeedsResponse_Candidate) Reset() {
+	*x = DiscoverFeedsResponse_Candidate{}
+	mi := &file_neon_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverFeedsResponse_Candidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsResponse_Candidate) ProtoMessage() {}
+
+func (x *DiscoverFeedsResponse_Candidate) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsResponse_Candidate.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsResponse_Candidate) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *DiscoverFeedsResponse_Candidate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DiscoverFeedsResponse_Candidate) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *DiscoverFeedsResponse_Candidate) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return ""
+}
+
 type EditFeedsRequest_Op struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
 	Id            uint32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1501,7 +2481,7 @@ type EditFeedsRequest_Op struct {
 
 func (x *EditFeedsRequest_Op) Reset() {
 	*x = EditFeedsRequest_Op{}
-	mi := &file_neon_proto_msgTypes[28]
+	mi := &file_neon_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1513,7 +2493,7 @@ func (x *EditFeedsRequest_Op) String() string {
 func (*EditFeedsRequest_Op) ProtoMessage() {}
 
 func (x *EditFeedsRequest_Op) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[28]
+	mi := &file_neon_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +2506,7 @@ func (x *EditFeedsRequest_Op) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFeedsRequest_Op.ProtoReflect.Descriptor instead.
 func (*EditFeedsRequest_Op) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{4, 0}
+	return file_neon_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *EditFeedsRequest_Op) GetId() uint32 {
@@ -1550,15 +2530,23 @@ type EditFeedsRequest_Op_Fields struct {
 	// NOTE: This means an empty fields message in an op request will delete
 	//
 	//	existing tags.
-	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
-	IsStarred     *bool    `protobuf:"varint,4,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+	Tags      []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsStarred *bool    `protobuf:"varint,4,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+	// NOTE: A zero duration removes the feed-specific pull interval.
+	PullInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=pull_interval,json=pullInterval,proto3,oneof" json:"pull_interval,omitempty"`
+	// NOTE: Zero values remove the respective feed-specific retention policy fields.
+	Retention *RetentionPolicy `protobuf:"bytes,6,opt,name=retention,proto3,oneof" json:"retention,omitempty"`
+	IsPaused  *bool            `protobuf:"varint,7,opt,name=is_paused,json=isPaused,proto3,oneof" json:"is_paused,omitempty"`
+	// NOTE: Empty credentials remove the existing credentials.
+	Credentials   *FeedCredentials `protobuf:"bytes,8,opt,name=credentials,proto3,oneof" json:"credentials,omitempty"`
+	FetchContent  *bool            `protobuf:"varint,9,opt,name=fetch_content,json=fetchContent,proto3,oneof" json:"fetch_content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EditFeedsRequest_Op_Fields) Reset() {
 	*x = EditFeedsRequest_Op_Fields{}
-	mi := &file_neon_proto_msgTypes[29]
+	mi := &file_neon_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1570,7 +2558,7 @@ func (x *EditFeedsRequest_Op_Fields) String() string {
 func (*EditFeedsRequest_Op_Fields) ProtoMessage() {}
 
 func (x *EditFeedsRequest_Op_Fields) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[29]
+	mi := &file_neon_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +2571,7 @@ func (x *EditFeedsRequest_Op_Fields) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFeedsRequest_Op_Fields.ProtoReflect.Descriptor instead.
 func (*EditFeedsRequest_Op_Fields) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{4, 0, 0}
+	return file_neon_proto_rawDescGZIP(), []int{10, 0, 0}
 }
 
 func (x *EditFeedsRequest_Op_Fields) GetTitle() string {
@@ -1614,6 +2602,41 @@ func (x *EditFeedsRequest_Op_Fields) GetIsStarred() bool {
 	return false
 }
 
+func (x *EditFeedsRequest_Op_Fields) GetPullInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PullInterval
+	}
+	return nil
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetIsPaused() bool {
+	if x != nil && x.IsPaused != nil {
+		return *x.IsPaused
+	}
+	return false
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetCredentials() *FeedCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetFetchContent() bool {
+	if x != nil && x.FetchContent != nil {
+		return *x.FetchContent
+	}
+	return false
+}
+
 type EditEntriesRequest_Op struct {
 	state         protoimpl.MessageState        `protogen:"open.v1"`
 	Id            uint32                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1624,7 +2647,7 @@ type EditEntriesRequest_Op struct {
 
 func (x *EditEntriesRequest_Op) Reset() {
 	*x = EditEntriesRequest_Op{}
-	mi := &file_neon_proto_msgTypes[30]
+	mi := &file_neon_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1636,7 +2659,7 @@ func (x *EditEntriesRequest_Op) String() string {
 func (*EditEntriesRequest_Op) ProtoMessage() {}
 
 func (x *EditEntriesRequest_Op) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[30]
+	mi := &file_neon_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,7 +2672,7 @@ func (x *EditEntriesRequest_Op) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditEntriesRequest_Op.ProtoReflect.Descriptor instead.
 func (*EditEntriesRequest_Op) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{14, 0}
+	return file_neon_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *EditEntriesRequest_Op) GetId() uint32 {
@@ -1676,7 +2699,7 @@ type EditEntriesRequest_Op_Fields struct {
 
 func (x *EditEntriesRequest_Op_Fields) Reset() {
 	*x = EditEntriesRequest_Op_Fields{}
-	mi := &file_neon_proto_msgTypes[31]
+	mi := &file_neon_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1688,7 +2711,7 @@ func (x *EditEntriesRequest_Op_Fields) String() string {
 func (*EditEntriesRequest_Op_Fields) ProtoMessage() {}
 
 func (x *EditEntriesRequest_Op_Fields) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[31]
+	mi := &file_neon_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1701,7 +2724,7 @@ func (x *EditEntriesRequest_Op_Fields) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditEntriesRequest_Op_Fields.ProtoReflect.Descriptor instead.
 func (*EditEntriesRequest_Op_Fields) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{14, 0, 0}
+	return file_neon_proto_rawDescGZIP(), []int{20, 0, 0}
 }
 
 func (x *EditEntriesRequest_Op_Fields) GetIsRead() bool {
@@ -1718,6 +2741,69 @@ func (x *EditEntriesRequest_Op_Fields) GetIsBookmarked() bool {
 	return false
 }
 
+type SearchEntriesResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *Entry                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Snippet is an excerpt of the entry, with matching terms enclosed by the STX (U+0002) and
+	// ETX (U+0003) characters.
+	Snippet string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// Rank is the relevance of the entry; lower values indicate better matches.
+	Rank          float64 `protobuf:"fixed64,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEntriesResponse_Result) Reset() {
+	*x = SearchEntriesResponse_Result{}
+	mi := &file_neon_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEntriesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntriesResponse_Result) ProtoMessage() {}
+
+func (x *SearchEntriesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntriesResponse_Result.ProtoReflect.Descriptor instead.
+func (*SearchEntriesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *SearchEntriesResponse_Result) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *SearchEntriesResponse_Result) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchEntriesResponse_Result) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
 type GetStatsResponse_Stats struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	NumFeeds             uint32                 `protobuf:"varint,1,opt,name=num_feeds,json=numFeeds,proto3" json:"num_feeds,omitempty"`
@@ -1732,7 +2818,7 @@ type GetStatsResponse_Stats struct {
 
 func (x *GetStatsResponse_Stats) Reset() {
 	*x = GetStatsResponse_Stats{}
-	mi := &file_neon_proto_msgTypes[32]
+	mi := &file_neon_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1744,7 +2830,7 @@ func (x *GetStatsResponse_Stats) String() string {
 func (*GetStatsResponse_Stats) ProtoMessage() {}
 
 func (x *GetStatsResponse_Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[32]
+	mi := &file_neon_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,7 +2843,7 @@ func (x *GetStatsResponse_Stats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsResponse_Stats.ProtoReflect.Descriptor instead.
 func (*GetStatsResponse_Stats) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{25, 0}
+	return file_neon_proto_rawDescGZIP(), []int{37, 0}
 }
 
 func (x *GetStatsResponse_Stats) GetNumFeeds() uint32 {
@@ -1807,7 +2893,7 @@ var File_neon_proto protoreflect.FileDescriptor
 const file_neon_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"neon.proto\x12\x04neon\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x03\n" +
+	"neon.proto\x12\x04neon\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\a\n" +
 	"\x04Feed\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x19\n" +
@@ -1821,11 +2907,55 @@ const file_neon_proto_rawDesc = "" +
 	"\x0elast_pull_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastPullTime\x12\x1d\n" +
 	"\n" +
 	"is_starred\x18\n" +
-	" \x01(\bR\tisStarred\x12%\n" +
-	"\aentries\x18\x0f \x03(\v2\v.neon.EntryR\aentriesB\v\n" +
+	" \x01(\bR\tisStarred\x12C\n" +
+	"\rpull_interval\x18\v \x01(\v2\x19.google.protobuf.DurationH\x03R\fpullInterval\x88\x01\x01\x123\n" +
+	"\tretention\x18\f \x01(\v2\x15.neon.RetentionPolicyR\tretention\x121\n" +
+	"\x14consecutive_failures\x18\r \x01(\rR\x13consecutiveFailures\x12\"\n" +
+	"\n" +
+	"last_error\x18\x0e \x01(\tH\x04R\tlastError\x88\x01\x01\x12%\n" +
+	"\aentries\x18\x0f \x03(\v2\v.neon.EntryR\aentries\x12D\n" +
+	"\rbackoff_until\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\fbackoffUntil\x88\x01\x01\x12\x1b\n" +
+	"\tis_paused\x18\x11 \x01(\bR\bisPaused\x12E\n" +
+	"\x0enext_pull_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampH\x06R\fnextPullTime\x88\x01\x01\x12#\n" +
+	"\rfetch_content\x18\x13 \x01(\bR\ffetchContentB\v\n" +
 	"\t_site_urlB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
-	"\f_update_time\"\x90\x03\n" +
+	"\f_update_timeB\x10\n" +
+	"\x0e_pull_intervalB\r\n" +
+	"\v_last_errorB\x10\n" +
+	"\x0e_backoff_untilB\x11\n" +
+	"\x0f_next_pull_time\"\x9a\x01\n" +
+	"\x0fRetentionPolicy\x12$\n" +
+	"\vmax_entries\x18\x01 \x01(\rH\x00R\n" +
+	"maxEntries\x88\x01\x01\x12@\n" +
+	"\fmax_read_age\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x01R\n" +
+	"maxReadAge\x88\x01\x01B\x0e\n" +
+	"\f_max_entriesB\x0f\n" +
+	"\r_max_read_age\"\x92\x01\n" +
+	"\x0fFeedCredentials\x12\x1f\n" +
+	"\busername\x18\x01 \x01(\tH\x00R\busername\x88\x01\x01\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tH\x01R\bpassword\x88\x01\x01\x12\x19\n" +
+	"\x05token\x18\x03 \x01(\tH\x02R\x05token\x88\x01\x01B\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_passwordB\b\n" +
+	"\x06_token\"r\n" +
+	"\vExecOptions\x12\x15\n" +
+	"\x03dir\x18\x01 \x01(\tH\x00R\x03dir\x88\x01\x01\x128\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x01R\atimeout\x88\x01\x01B\x06\n" +
+	"\x04_dirB\n" +
+	"\n" +
+	"\b_timeout\"\xa5\x01\n" +
+	"\n" +
+	"ScrapeSpec\x12\x12\n" +
+	"\x04item\x18\x01 \x01(\tR\x04item\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
+	"\x04link\x18\x03 \x01(\tH\x00R\x04link\x88\x01\x01\x12\x17\n" +
+	"\x04date\x18\x04 \x01(\tH\x01R\x04date\x88\x01\x01\x12\x1d\n" +
+	"\asummary\x18\x05 \x01(\tH\x02R\asummary\x88\x01\x01B\a\n" +
+	"\x05_linkB\a\n" +
+	"\x05_dateB\n" +
+	"\n" +
+	"\b_summary\"\xf8\x04\n" +
 	"\x05Entry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
 	"\afeed_id\x18\x02 \x01(\rR\x06feedId\x12\x14\n" +
@@ -1839,39 +2969,83 @@ const file_neon_proto_rawDesc = "" +
 	"\vdescription\x18\t \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
 	"\acontent\x18\n" +
 	" \x01(\tH\x01R\acontent\x88\x01\x01\x12\x15\n" +
-	"\x03url\x18\v \x01(\tH\x02R\x03url\x88\x01\x01B\x0e\n" +
+	"\x03url\x18\v \x01(\tH\x02R\x03url\x88\x01\x01\x12\x18\n" +
+	"\aauthors\x18\f \x03(\tR\aauthors\x12\x1e\n" +
+	"\n" +
+	"categories\x18\r \x03(\tR\n" +
+	"categories\x125\n" +
+	"\n" +
+	"enclosures\x18\x0e \x03(\v2\x15.neon.Entry.EnclosureR\n" +
+	"enclosures\x1au\n" +
+	"\tEnclosure\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12 \n" +
+	"\tmime_type\x18\x02 \x01(\tH\x00R\bmimeType\x88\x01\x01\x12\x1b\n" +
+	"\x06length\x18\x03 \x01(\x04H\x01R\x06length\x88\x01\x01B\f\n" +
+	"\n" +
+	"_mime_typeB\t\n" +
+	"\a_lengthB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
 	"\b_contentB\x06\n" +
-	"\x04_url\"\xc5\x01\n" +
+	"\x04_url\"\x82\x03\n" +
 	"\x0eAddFeedRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x12\n" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\"\n" +
 	"\n" +
-	"is_starred\x18\x05 \x01(\bH\x02R\tisStarred\x88\x01\x01B\b\n" +
+	"is_starred\x18\x05 \x01(\bH\x02R\tisStarred\x88\x01\x01\x12<\n" +
+	"\vcredentials\x18\x06 \x01(\v2\x15.neon.FeedCredentialsH\x03R\vcredentials\x88\x01\x01\x12*\n" +
+	"\x04exec\x18\a \x01(\v2\x11.neon.ExecOptionsH\x04R\x04exec\x88\x01\x01\x12-\n" +
+	"\x06scrape\x18\b \x01(\v2\x10.neon.ScrapeSpecH\x05R\x06scrape\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\r\n" +
-	"\v_is_starred\"L\n" +
+	"\v_is_starredB\x0e\n" +
+	"\f_credentialsB\a\n" +
+	"\x05_execB\t\n" +
+	"\a_scrape\"L\n" +
 	"\x0fAddFeedResponse\x12\x1e\n" +
 	"\x04feed\x18\x01 \x01(\v2\n" +
 	".neon.FeedR\x04feed\x12\x19\n" +
-	"\bis_added\x18\x02 \x01(\bR\aisAdded\"\xbe\x02\n" +
+	"\bis_added\x18\x02 \x01(\bR\aisAdded\"(\n" +
+	"\x14DiscoverFeedsRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xca\x01\n" +
+	"\x15DiscoverFeedsResponse\x12E\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2%.neon.DiscoverFeedsResponse.CandidateR\n" +
+	"candidates\x1aj\n" +
+	"\tCandidate\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x03 \x01(\tH\x01R\x06format\x88\x01\x01B\b\n" +
+	"\x06_titleB\t\n" +
+	"\a_format\"\x97\x05\n" +
 	"\x10EditFeedsRequest\x12+\n" +
-	"\x03ops\x18\x01 \x03(\v2\x19.neon.EditFeedsRequest.OpR\x03ops\x1a\xfc\x01\n" +
+	"\x03ops\x18\x01 \x03(\v2\x19.neon.EditFeedsRequest.OpR\x03ops\x1a\xd5\x04\n" +
 	"\x02Op\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x128\n" +
-	"\x06fields\x18\x02 \x01(\v2 .neon.EditFeedsRequest.Op.FieldsR\x06fields\x1a\xab\x01\n" +
+	"\x06fields\x18\x02 \x01(\v2 .neon.EditFeedsRequest.Op.FieldsR\x06fields\x1a\x84\x04\n" +
 	"\x06Fields\x12\x19\n" +
 	"\x05title\x18\x01 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x12\n" +
 	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\"\n" +
 	"\n" +
-	"is_starred\x18\x04 \x01(\bH\x02R\tisStarred\x88\x01\x01B\b\n" +
+	"is_starred\x18\x04 \x01(\bH\x02R\tisStarred\x88\x01\x01\x12C\n" +
+	"\rpull_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationH\x03R\fpullInterval\x88\x01\x01\x128\n" +
+	"\tretention\x18\x06 \x01(\v2\x15.neon.RetentionPolicyH\x04R\tretention\x88\x01\x01\x12 \n" +
+	"\tis_paused\x18\a \x01(\bH\x05R\bisPaused\x88\x01\x01\x12<\n" +
+	"\vcredentials\x18\b \x01(\v2\x15.neon.FeedCredentialsH\x06R\vcredentials\x88\x01\x01\x12(\n" +
+	"\rfetch_content\x18\t \x01(\bH\aR\ffetchContent\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\r\n" +
-	"\v_is_starred\"5\n" +
+	"\v_is_starredB\x10\n" +
+	"\x0e_pull_intervalB\f\n" +
+	"\n" +
+	"_retentionB\f\n" +
+	"\n" +
+	"_is_pausedB\x0e\n" +
+	"\f_credentialsB\x10\n" +
+	"\x0e_fetch_content\"5\n" +
 	"\x11EditFeedsResponse\x12 \n" +
 	"\x05feeds\x18\x01 \x03(\v2\n" +
 	".neon.FeedR\x05feeds\"a\n" +
@@ -1880,18 +3054,29 @@ const file_neon_proto_rawDesc = "" +
 	"\x15_max_entries_per_feed\"5\n" +
 	"\x11ListFeedsResponse\x12 \n" +
 	"\x05feeds\x18\x01 \x03(\v2\n" +
-	".neon.FeedR\x05feeds\"|\n" +
+	".neon.FeedR\x05feeds\"\x92\x01\n" +
 	"\x10PullFeedsRequest\x12\x19\n" +
 	"\bfeed_ids\x18\x01 \x03(\rR\afeedIds\x124\n" +
-	"\x14max_entries_per_feed\x18\x02 \x01(\rH\x00R\x11maxEntriesPerFeed\x88\x01\x01B\x17\n" +
-	"\x15_max_entries_per_feed\"x\n" +
+	"\x14max_entries_per_feed\x18\x02 \x01(\rH\x00R\x11maxEntriesPerFeed\x88\x01\x01\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05forceB\x17\n" +
+	"\x15_max_entries_per_feed\"\xcd\x02\n" +
 	"\x11PullFeedsResponse\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12#\n" +
 	"\x04feed\x18\x02 \x01(\v2\n" +
 	".neon.FeedH\x00R\x04feed\x88\x01\x01\x12\x19\n" +
-	"\x05error\x18\x03 \x01(\tH\x01R\x05error\x88\x01\x01B\a\n" +
+	"\x05error\x18\x03 \x01(\tH\x01R\x05error\x88\x01\x01\x12<\n" +
+	"\tthrottled\x18\x04 \x01(\v2\x19.google.protobuf.DurationH\x02R\tthrottled\x88\x01\x01\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.neon.PullFeedsResponse.StatusR\x06status\"O\n" +
+	"\x06Status\x12\x0f\n" +
+	"\vUNSPECIFIED\x10\x00\x12\v\n" +
+	"\aSUCCESS\x10\x01\x12\x10\n" +
+	"\fNOT_MODIFIED\x10\x02\x12\v\n" +
+	"\aSKIPPED\x10\x03\x12\b\n" +
+	"\x04FAIL\x10\x04B\a\n" +
 	"\x05_feedB\b\n" +
-	"\x06_error\"/\n" +
+	"\x06_errorB\f\n" +
+	"\n" +
+	"_throttled\"/\n" +
 	"\x12DeleteFeedsRequest\x12\x19\n" +
 	"\bfeed_ids\x18\x01 \x03(\rR\afeedIds\"\x15\n" +
 	"\x13DeleteFeedsResponse\"k\n" +
@@ -1921,7 +3106,29 @@ const file_neon_proto_rawDesc = "" +
 	"\x0fGetEntryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"5\n" +
 	"\x10GetEntryResponse\x12!\n" +
-	"\x05entry\x18\x01 \x01(\v2\v.neon.EntryR\x05entry\"8\n" +
+	"\x05entry\x18\x01 \x01(\v2\v.neon.EntryR\x05entry\",\n" +
+	"\x1aExtractEntryContentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"@\n" +
+	"\x1bExtractEntryContentResponse\x12!\n" +
+	"\x05entry\x18\x01 \x01(\v2\v.neon.EntryR\x05entry\"\x91\x01\n" +
+	"\x14SearchEntriesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x19\n" +
+	"\bfeed_ids\x18\x02 \x03(\rR\afeedIds\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12$\n" +
+	"\vmax_results\x18\x04 \x01(\rH\x00R\n" +
+	"maxResults\x88\x01\x01B\x0e\n" +
+	"\f_max_results\"\xb0\x01\n" +
+	"\x15SearchEntriesResponse\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".neon.SearchEntriesResponse.ResultR\aresults\x1aY\n" +
+	"\x06Result\x12!\n" +
+	"\x05entry\x18\x01 \x01(\v2\v.neon.EntryR\x05entry\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x01R\x04rank\"I\n" +
+	"\x13PruneEntriesRequest\x12\x19\n" +
+	"\bfeed_ids\x18\x01 \x03(\rR\afeedIds\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"=\n" +
+	"\x14PruneEntriesResponse\x12%\n" +
+	"\aentries\x18\x01 \x03(\v2\v.neon.EntryR\aentries\"8\n" +
 	"\x11ExportOPMLRequest\x12\x19\n" +
 	"\x05title\x18\x01 \x01(\tH\x00R\x05title\x88\x01\x01B\b\n" +
 	"\x06_title\".\n" +
@@ -1952,9 +3159,10 @@ const file_neon_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1d\n" +
 	"\n" +
-	"git_commit\x18\x03 \x01(\tR\tgitCommit2\xdc\x06\n" +
+	"git_commit\x18\x03 \x01(\tR\tgitCommit2\x9b\t\n" +
 	"\x04Neon\x128\n" +
-	"\aAddFeed\x12\x14.neon.AddFeedRequest\x1a\x15.neon.AddFeedResponse\"\x00\x12>\n" +
+	"\aAddFeed\x12\x14.neon.AddFeedRequest\x1a\x15.neon.AddFeedResponse\"\x00\x12J\n" +
+	"\rDiscoverFeeds\x12\x1a.neon.DiscoverFeedsRequest\x1a\x1b.neon.DiscoverFeedsResponse\"\x00\x12>\n" +
 	"\tEditFeeds\x12\x16.neon.EditFeedsRequest\x1a\x17.neon.EditFeedsResponse\"\x00\x12>\n" +
 	"\tListFeeds\x12\x16.neon.ListFeedsRequest\x1a\x17.neon.ListFeedsResponse\"\x00\x12@\n" +
 	"\tPullFeeds\x12\x16.neon.PullFeedsRequest\x1a\x17.neon.PullFeedsResponse\"\x000\x01\x12D\n" +
@@ -1962,7 +3170,10 @@ const file_neon_proto_rawDesc = "" +
 	"\rStreamEntries\x12\x1a.neon.StreamEntriesRequest\x1a\x1b.neon.StreamEntriesResponse\"\x000\x01\x12D\n" +
 	"\vListEntries\x12\x18.neon.ListEntriesRequest\x1a\x19.neon.ListEntriesResponse\"\x00\x12D\n" +
 	"\vEditEntries\x12\x18.neon.EditEntriesRequest\x1a\x19.neon.EditEntriesResponse\"\x00\x12;\n" +
-	"\bGetEntry\x12\x15.neon.GetEntryRequest\x1a\x16.neon.GetEntryResponse\"\x00\x12A\n" +
+	"\bGetEntry\x12\x15.neon.GetEntryRequest\x1a\x16.neon.GetEntryResponse\"\x00\x12\\\n" +
+	"\x13ExtractEntryContent\x12 .neon.ExtractEntryContentRequest\x1a!.neon.ExtractEntryContentResponse\"\x00\x12J\n" +
+	"\rSearchEntries\x12\x1a.neon.SearchEntriesRequest\x1a\x1b.neon.SearchEntriesResponse\"\x00\x12G\n" +
+	"\fPruneEntries\x12\x19.neon.PruneEntriesRequest\x1a\x1a.neon.PruneEntriesResponse\"\x00\x12A\n" +
 	"\n" +
 	"ExportOPML\x12\x17.neon.ExportOPMLRequest\x1a\x18.neon.ExportOPMLResponse\"\x00\x12A\n" +
 	"\n" +
@@ -1982,96 +3193,142 @@ func file_neon_proto_rawDescGZIP() []byte {
 	return file_neon_proto_rawDescData
 }
 
-var file_neon_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_neon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_neon_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_neon_proto_goTypes = []any{
-	(*Feed)(nil),                         // 0: neon.Feed
-	(*Entry)(nil),                        // 1: neon.Entry
-	(*AddFeedRequest)(nil),               // 2: neon.AddFeedRequest
-	(*AddFeedResponse)(nil),              // 3: neon.AddFeedResponse
-	(*EditFeedsRequest)(nil),             // 4: neon.EditFeedsRequest
-	(*EditFeedsResponse)(nil),            // 5: neon.EditFeedsResponse
-	(*ListFeedsRequest)(nil),             // 6: neon.ListFeedsRequest
-	(*ListFeedsResponse)(nil),            // 7: neon.ListFeedsResponse
-	(*PullFeedsRequest)(nil),             // 8: neon.PullFeedsRequest
-	(*PullFeedsResponse)(nil),            // 9: neon.PullFeedsResponse
-	(*DeleteFeedsRequest)(nil),           // 10: neon.DeleteFeedsRequest
-	(*DeleteFeedsResponse)(nil),          // 11: neon.DeleteFeedsResponse
-	(*ListEntriesRequest)(nil),           // 12: neon.ListEntriesRequest
-	(*ListEntriesResponse)(nil),          // 13: neon.ListEntriesResponse
-	(*EditEntriesRequest)(nil),           // 14: neon.EditEntriesRequest
-	(*EditEntriesResponse)(nil),          // 15: neon.EditEntriesResponse
-	(*StreamEntriesRequest)(nil),         // 16: neon.StreamEntriesRequest
-	(*StreamEntriesResponse)(nil),        // 17: neon.StreamEntriesResponse
-	(*GetEntryRequest)(nil),              // 18: neon.GetEntryRequest
-	(*GetEntryResponse)(nil),             // 19: neon.GetEntryResponse
-	(*ExportOPMLRequest)(nil),            // 20: neon.ExportOPMLRequest
-	(*ExportOPMLResponse)(nil),           // 21: neon.ExportOPMLResponse
-	(*ImportOPMLRequest)(nil),            // 22: neon.ImportOPMLRequest
-	(*ImportOPMLResponse)(nil),           // 23: neon.ImportOPMLResponse
-	(*GetStatsRequest)(nil),              // 24: neon.GetStatsRequest
-	(*GetStatsResponse)(nil),             // 25: neon.GetStatsResponse
-	(*GetInfoRequest)(nil),               // 26: neon.GetInfoRequest
-	(*GetInfoResponse)(nil),              // 27: neon.GetInfoResponse
-	(*EditFeedsRequest_Op)(nil),          // 28: neon.EditFeedsRequest.Op
-	(*EditFeedsRequest_Op_Fields)(nil),   // 29: neon.EditFeedsRequest.Op.Fields
-	(*EditEntriesRequest_Op)(nil),        // 30: neon.EditEntriesRequest.Op
-	(*EditEntriesRequest_Op_Fields)(nil), // 31: neon.EditEntriesRequest.Op.Fields
-	(*GetStatsResponse_Stats)(nil),       // 32: neon.GetStatsResponse.Stats
-	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
+	(PullFeedsResponse_Status)(0),           // 0: neon.PullFeedsResponse.Status
+	(*Feed)(nil),                            // 1: neon.Feed
+	(*RetentionPolicy)(nil),                 // 2: neon.RetentionPolicy
+	(*FeedCredentials)(nil),                 // 3: neon.FeedCredentials
+	(*ExecOptions)(nil),                     // 4: neon.ExecOptions
+	(*ScrapeSpec)(nil),                      // 5: neon.ScrapeSpec
+	(*Entry)(nil),                           // 6: neon.Entry
+	(*AddFeedRequest)(nil),                  // 7: neon.AddFeedRequest
+	(*AddFeedResponse)(nil),                 // 8: neon.AddFeedResponse
+	(*DiscoverFeedsRequest)(nil),            // 9: neon.DiscoverFeedsRequest
+	(*DiscoverFeedsResponse)(nil),           // 10: neon.DiscoverFeedsResponse
+	(*EditFeedsRequest)(nil),                // 11: neon.EditFeedsRequest
+	(*EditFeedsResponse)(nil),               // 12: neon.EditFeedsResponse
+	(*ListFeedsRequest)(nil),                // 13: neon.ListFeedsRequest
+	(*ListFeedsResponse)(nil),               // 14: neon.ListFeedsResponse
+	(*PullFeedsRequest)(nil),                // 15: neon.PullFeedsRequest
+	(*PullFeedsResponse)(nil),               // 16: neon.PullFeedsResponse
+	(*DeleteFeedsRequest)(nil),              // 17: neon.DeleteFeedsRequest
+	(*DeleteFeedsResponse)(nil),             // 18: neon.DeleteFeedsResponse
+	(*ListEntriesRequest)(nil),              // 19: neon.ListEntriesRequest
+	(*ListEntriesResponse)(nil),             // 20: neon.ListEntriesResponse
+	(*EditEntriesRequest)(nil),              // 21: neon.EditEntriesRequest
+	(*EditEntriesResponse)(nil),             // 22: neon.EditEntriesResponse
+	(*StreamEntriesRequest)(nil),            // 23: neon.StreamEntriesRequest
+	(*StreamEntriesResponse)(nil),           // 24: neon.StreamEntriesResponse
+	(*GetEntryRequest)(nil),                 // 25: neon.GetEntryRequest
+	(*GetEntryResponse)(nil),                // 26: neon.GetEntryResponse
+	(*ExtractEntryContentRequest)(nil),      // 27: neon.ExtractEntryContentRequest
+	(*ExtractEntryContentResponse)(nil),     // 28: neon.ExtractEntryContentResponse
+	(*SearchEntriesRequest)(nil),            // 29: neon.SearchEntriesRequest
+	(*SearchEntriesResponse)(nil),           // 30: neon.SearchEntriesResponse
+	(*PruneEntriesRequest)(nil),             // 31: neon.PruneEntriesRequest
+	(*PruneEntriesResponse)(nil),            // 32: neon.PruneEntriesResponse
+	(*ExportOPMLRequest)(nil),               // 33: neon.ExportOPMLRequest
+	(*ExportOPMLResponse)(nil),              // 34: neon.ExportOPMLResponse
+	(*ImportOPMLRequest)(nil),               // 35: neon.ImportOPMLRequest
+	(*ImportOPMLResponse)(nil),              // 36: neon.ImportOPMLResponse
+	(*GetStatsRequest)(nil),                 // 37: neon.GetStatsRequest
+	(*GetStatsResponse)(nil),                // 38: neon.GetStatsResponse
+	(*GetInfoRequest)(nil),                  // 39: neon.GetInfoRequest
+	(*GetInfoResponse)(nil),                 // 40: neon.GetInfoResponse
+	(*Entry_Enclosure)(nil),                 // 41: neon.Entry.Enclosure
+	(*DiscoverFeedsResponse_Candidate)(nil), // 42: neon.DiscoverFeedsResponse.Candidate
+	(*EditFeedsRequest_Op)(nil),             // 43: neon.EditFeedsRequest.Op
+	(*EditFeedsRequest_Op_Fields)(nil),      // 44: neon.EditFeedsRequest.Op.Fields
+	(*EditEntriesRequest_Op)(nil),           // 45: neon.EditEntriesRequest.Op
+	(*EditEntriesRequest_Op_Fields)(nil),    // 46: neon.EditEntriesRequest.Op.Fields
+	(*SearchEntriesResponse_Result)(nil),    // 47: neon.SearchEntriesResponse.Result
+	(*GetStatsResponse_Stats)(nil),          // 48: neon.GetStatsResponse.Stats
+	(*timestamppb.Timestamp)(nil),           // 49: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 50: google.protobuf.Duration
 }
 var file_neon_proto_depIdxs = []int32{
-	33, // 0: neon.Feed.update_time:type_name -> google.protobuf.Timestamp
-	33, // 1: neon.Feed.sub_time:type_name -> google.protobuf.Timestamp
-	33, // 2: neon.Feed.last_pull_time:type_name -> google.protobuf.Timestamp
-	1,  // 3: neon.Feed.entries:type_name -> neon.Entry
-	33, // 4: neon.Entry.update_time:type_name -> google.protobuf.Timestamp
-	33, // 5: neon.Entry.pub_time:type_name -> google.protobuf.Timestamp
-	0,  // 6: neon.AddFeedResponse.feed:type_name -> neon.Feed
-	28, // 7: neon.EditFeedsRequest.ops:type_name -> neon.EditFeedsRequest.Op
-	0,  // 8: neon.EditFeedsResponse.feeds:type_name -> neon.Feed
-	0,  // 9: neon.ListFeedsResponse.feeds:type_name -> neon.Feed
-	0,  // 10: neon.PullFeedsResponse.feed:type_name -> neon.Feed
-	1,  // 11: neon.ListEntriesResponse.entries:type_name -> neon.Entry
-	30, // 12: neon.EditEntriesRequest.ops:type_name -> neon.EditEntriesRequest.Op
-	1,  // 13: neon.EditEntriesResponse.entries:type_name -> neon.Entry
-	1,  // 14: neon.StreamEntriesResponse.entry:type_name -> neon.Entry
-	1,  // 15: neon.GetEntryResponse.entry:type_name -> neon.Entry
-	32, // 16: neon.GetStatsResponse.global:type_name -> neon.GetStatsResponse.Stats
-	29, // 17: neon.EditFeedsRequest.Op.fields:type_name -> neon.EditFeedsRequest.Op.Fields
-	31, // 18: neon.EditEntriesRequest.Op.fields:type_name -> neon.EditEntriesRequest.Op.Fields
-	33, // 19: neon.GetStatsResponse.Stats.last_pull_time:type_name -> google.protobuf.Timestamp
-	33, // 20: neon.GetStatsResponse.Stats.most_recent_update_time:type_name -> google.protobuf.Timestamp
-	2,  // 21: neon.Neon.AddFeed:input_type -> neon.AddFeedRequest
-	4,  // 22: neon.Neon.EditFeeds:input_type -> neon.EditFeedsRequest
-	6,  // 23: neon.Neon.ListFeeds:input_type -> neon.ListFeedsRequest
-	8,  // 24: neon.Neon.PullFeeds:input_type -> neon.PullFeedsRequest
-	10, // 25: neon.Neon.DeleteFeeds:input_type -> neon.DeleteFeedsRequest
-	16, // 26: neon.Neon.StreamEntries:input_type -> neon.StreamEntriesRequest
-	12, // 27: neon.Neon.ListEntries:input_type -> neon.ListEntriesRequest
-	14, // 28: neon.Neon.EditEntries:input_type -> neon.EditEntriesRequest
-	18, // 29: neon.Neon.GetEntry:input_type -> neon.GetEntryRequest
-	20, // 30: neon.Neon.ExportOPML:input_type -> neon.ExportOPMLRequest
-	22, // 31: neon.Neon.ImportOPML:input_type -> neon.ImportOPMLRequest
-	24, // 32: neon.Neon.GetStats:input_type -> neon.GetStatsRequest
-	26, // 33: neon.Neon.GetInfo:input_type -> neon.GetInfoRequest
-	3,  // 34: neon.Neon.AddFeed:output_type -> neon.AddFeedResponse
-	5,  // 35: neon.Neon.EditFeeds:output_type -> neon.EditFeedsResponse
-	7,  // 36: neon.Neon.ListFeeds:output_type -> neon.ListFeedsResponse
-	9,  // 37: neon.Neon.PullFeeds:output_type -> neon.PullFeedsResponse
-	11, // 38: neon.Neon.DeleteFeeds:output_type -> neon.DeleteFeedsResponse
-	17, // 39: neon.Neon.StreamEntries:output_type -> neon.StreamEntriesResponse
-	13, // 40: neon.Neon.ListEntries:output_type -> neon.ListEntriesResponse
-	15, // 41: neon.Neon.EditEntries:output_type -> neon.EditEntriesResponse
-	19, // 42: neon.Neon.GetEntry:output_type -> neon.GetEntryResponse
-	21, // 43: neon.Neon.ExportOPML:output_type -> neon.ExportOPMLResponse
-	23, // 44: neon.Neon.ImportOPML:output_type -> neon.ImportOPMLResponse
-	25, // 45: neon.Neon.GetStats:output_type -> neon.GetStatsResponse
-	27, // 46: neon.Neon.GetInfo:output_type -> neon.GetInfoResponse
-	34, // [34:47] is the sub-list for method output_type
-	21, // [21:34] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	49, // 0: neon.Feed.update_time:type_name -> google.protobuf.Timestamp
+	49, // 1: neon.Feed.sub_time:type_name -> google.protobuf.Timestamp
+	49, // 2: neon.Feed.last_pull_time:type_name -> google.protobuf.Timestamp
+	50, // 3: neon.Feed.pull_interval:type_name -> google.protobuf.Duration
+	2,  // 4: neon.Feed.retention:type_name -> neon.RetentionPolicy
+	6,  // 5: neon.Feed.entries:type_name -> neon.Entry
+	49, // 6: neon.Feed.backoff_until:type_name -> google.protobuf.Timestamp
+	49, // 7: neon.Feed.next_pull_time:type_name -> google.protobuf.Timestamp
+	50, // 8: neon.RetentionPolicy.max_read_age:type_name -> google.protobuf.Duration
+	50, // 9: neon.ExecOptions.timeout:type_name -> google.protobuf.Duration
+	49, // 10: neon.Entry.update_time:type_name -> google.protobuf.Timestamp
+	49, // 11: neon.Entry.pub_time:type_name -> google.protobuf.Timestamp
+	41, // 12: neon.Entry.enclosures:type_name -> neon.Entry.Enclosure
+	3,  // 13: neon.AddFeedRequest.credentials:type_name -> neon.FeedCredentials
+	4,  // 14: neon.AddFeedRequest.exec:type_name -> neon.ExecOptions
+	5,  // 15: neon.AddFeedRequest.scrape:type_name -> neon.ScrapeSpec
+	1,  // 16: neon.AddFeedResponse.feed:type_name -> neon.Feed
+	42, // 17: neon.DiscoverFeedsResponse.candidates:type_name -> neon.DiscoverFeedsResponse.Candidate
+	43, // 18: neon.EditFeedsRequest.ops:type_name -> neon.EditFeedsRequest.Op
+	1,  // 19: neon.EditFeedsResponse.feeds:type_name -> neon.Feed
+	1,  // 20: neon.ListFeedsResponse.feeds:type_name -> neon.Feed
+	1,  // 21: neon.PullFeedsResponse.feed:type_name -> neon.Feed
+	50, // 22: neon.PullFeedsResponse.throttled:type_name -> google.protobuf.Duration
+	0,  // 23: neon.PullFeedsResponse.status:type_name -> neon.PullFeedsResponse.Status
+	6,  // 24: neon.ListEntriesResponse.entries:type_name -> neon.Entry
+	45, // 25: neon.EditEntriesRequest.ops:type_name -> neon.EditEntriesRequest.Op
+	6,  // 26: neon.EditEntriesResponse.entries:type_name -> neon.Entry
+	6,  // 27: neon.StreamEntriesResponse.entry:type_name -> neon.Entry
+	6,  // 28: neon.GetEntryResponse.entry:type_name -> neon.Entry
+	6,  // 29: neon.ExtractEntryContentResponse.entry:type_name -> neon.Entry
+	47, // 30: neon.SearchEntriesResponse.results:type_name -> neon.SearchEntriesResponse.Result
+	6,  // 31: neon.PruneEntriesResponse.entries:type_name -> neon.Entry
+	48, // 32: neon.GetStatsResponse.global:type_name -> neon.GetStatsResponse.Stats
+	44, // 33: neon.EditFeedsRequest.Op.fields:type_name -> neon.EditFeedsRequest.Op.Fields
+	50, // 34: neon.EditFeedsRequest.Op.Fields.pull_interval:type_name -> google.protobuf.Duration
+	2,  // 35: neon.EditFeedsRequest.Op.Fields.retention:type_name -> neon.RetentionPolicy
+	3,  // 36: neon.EditFeedsRequest.Op.Fields.credentials:type_name -> neon.FeedCredentials
+	46, // 37: neon.EditEntriesRequest.Op.fields:type_name -> neon.EditEntriesRequest.Op.Fields
+	6,  // 38: neon.SearchEntriesResponse.Result.entry:type_name -> neon.Entry
+	49, // 39: neon.GetStatsResponse.Stats.last_pull_time:type_name -> google.protobuf.Timestamp
+	49, // 40: neon.GetStatsResponse.Stats.most_recent_update_time:type_name -> google.protobuf.Timestamp
+	7,  // 41: neon.Neon.AddFeed:input_type -> neon.AddFeedRequest
+	9,  // 42: neon.Neon.DiscoverFeeds:input_type -> neon.DiscoverFeedsRequest
+	11, // 43: neon.Neon.EditFeeds:input_type -> neon.EditFeedsRequest
+	13, // 44: neon.Neon.ListFeeds:input_type -> neon.ListFeedsRequest
+	15, // 45: neon.Neon.PullFeeds:input_type -> neon.PullFeedsRequest
+	17, // 46: neon.Neon.DeleteFeeds:input_type -> neon.DeleteFeedsRequest
+	23, // 47: neon.Neon.StreamEntries:input_type -> neon.StreamEntriesRequest
+	19, // 48: neon.Neon.ListEntries:input_type -> neon.ListEntriesRequest
+	21, // 49: neon.Neon.EditEntries:input_type -> neon.EditEntriesRequest
+	25, // 50: neon.Neon.GetEntry:input_type -> neon.GetEntryRequest
+	27, // 51: neon.Neon.ExtractEntryContent:input_type -> neon.ExtractEntryContentRequest
+	29, // 52: neon.Neon.SearchEntries:input_type -> neon.SearchEntriesRequest
+	31, // 53: neon.Neon.PruneEntries:input_type -> neon.PruneEntriesRequest
+	33, // 54: neon.Neon.ExportOPML:input_type -> neon.ExportOPMLRequest
+	35, // 55: neon.Neon.ImportOPML:input_type -> neon.ImportOPMLRequest
+	37, // 56: neon.Neon.GetStats:input_type -> neon.GetStatsRequest
+	39, // 57: neon.Neon.GetInfo:input_type -> neon.GetInfoRequest
+	8,  // 58: neon.Neon.AddFeed:output_type -> neon.AddFeedResponse
+	10, // 59: neon.Neon.DiscoverFeeds:output_type -> neon.DiscoverFeedsResponse
+	12, // 60: neon.Neon.EditFeeds:output_type -> neon.EditFeedsResponse
+	14, // 61: neon.Neon.ListFeeds:output_type -> neon.ListFeedsResponse
+	16, // 62: neon.Neon.PullFeeds:output_type -> neon.PullFeedsResponse
+	18, // 63: neon.Neon.DeleteFeeds:output_type -> neon.DeleteFeedsResponse
+	24, // 64: neon.Neon.StreamEntries:output_type -> neon.StreamEntriesResponse
+	20, // 65: neon.Neon.ListEntries:output_type -> neon.ListEntriesResponse
+	22, // 66: neon.Neon.EditEntries:output_type -> neon.EditEntriesResponse
+	26, // 67: neon.Neon.GetEntry:output_type -> neon.GetEntryResponse
+	28, // 68: neon.Neon.ExtractEntryContent:output_type -> neon.ExtractEntryContentResponse
+	30, // 69: neon.Neon.SearchEntries:output_type -> neon.SearchEntriesResponse
+	32, // 70: neon.Neon.PruneEntries:output_type -> neon.PruneEntriesResponse
+	34, // 71: neon.Neon.ExportOPML:output_type -> neon.ExportOPMLResponse
+	36, // 72: neon.Neon.ImportOPML:output_type -> neon.ImportOPMLResponse
+	38, // 73: neon.Neon.GetStats:output_type -> neon.GetStatsResponse
+	40, // 74: neon.Neon.GetInfo:output_type -> neon.GetInfoResponse
+	58, // [58:75] is the sub-list for method output_type
+	41, // [41:58] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_neon_proto_init() }
@@ -2082,27 +3339,35 @@ func file_neon_proto_init() {
 	file_neon_proto_msgTypes[0].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[1].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[2].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[3].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[4].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[5].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[6].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[8].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[9].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[12].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[20].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[25].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[29].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[31].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[14].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[15].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[18].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[28].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[32].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[37].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[40].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[41].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[43].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[45].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[47].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_neon_proto_rawDesc), len(file_neon_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   33,
+			NumEnums:      1,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_neon_proto_goTypes,
 		DependencyIndexes: file_neon_proto_depIdxs,
+		EnumInfos:         file_neon_proto_enumTypes,
 		MessageInfos:      file_neon_proto_msgTypes,
 	}.Build()
 	File_neon_proto = out.File
@@ -24,19 +24,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Neon_AddFeed_FullMethodName       = "/neon.Neon/AddFeed"
-	Neon_EditFeeds_FullMethodName     = "/neon.Neon/EditFeeds"
-	Neon_ListFeeds_FullMethodName     = "/neon.Neon/ListFeeds"
-	Neon_PullFeeds_FullMethodName     = "/neon.Neon/PullFeeds"
-	Neon_DeleteFeeds_FullMethodName   = "/neon.Neon/DeleteFeeds"
-	Neon_StreamEntries_FullMethodName = "/neon.Neon/StreamEntries"
-	Neon_ListEntries_FullMethodName   = "/neon.Neon/ListEntries"
-	Neon_EditEntries_FullMethodName   = "/neon.Neon/EditEntries"
-	Neon_GetEntry_FullMethodName      = "/neon.Neon/GetEntry"
-	Neon_ExportOPML_FullMethodName    = "/neon.Neon/ExportOPML"
-	Neon_ImportOPML_FullMethodName    = "/neon.Neon/ImportOPML"
-	Neon_GetStats_FullMethodName      = "/neon.Neon/GetStats"
-	Neon_GetInfo_FullMethodName       = "/neon.Neon/GetInfo"
+	Neon_AddFeed_FullMethodName             = "/neon.Neon/AddFeed"
+	Neon_DiscoverFeeds_FullMethodName       = "/neon.Neon/DiscoverFeeds"
+	Neon_EditFeeds_FullMethodName           = "/neon.Neon/EditFeeds"
+	Neon_ListFeeds_FullMethodName           = "/neon.Neon/ListFeeds"
+	Neon_PullFeeds_FullMethodName           = "/neon.Neon/PullFeeds"
+	Neon_DeleteFeeds_FullMethodName         = "/neon.Neon/DeleteFeeds"
+	Neon_StreamEntries_FullMethodName       = "/neon.Neon/StreamEntries"
+	Neon_ListEntries_FullMethodName         = "/neon.Neon/ListEntries"
+	Neon_EditEntries_FullMethodName         = "/neon.Neon/EditEntries"
+	Neon_GetEntry_FullMethodName            = "/neon.Neon/GetEntry"
+	Neon_ExtractEntryContent_FullMethodName = "/neon.Neon/ExtractEntryContent"
+	Neon_SearchEntries_FullMethodName       = "/neon.Neon/SearchEntries"
+	Neon_PruneEntries_FullMethodName        = "/neon.Neon/PruneEntries"
+	Neon_ExportOPML_FullMethodName          = "/neon.Neon/ExportOPML"
+	Neon_ImportOPML_FullMethodName          = "/neon.Neon/ImportOPML"
+	Neon_GetStats_FullMethodName            = "/neon.Neon/GetStats"
+	Neon_GetInfo_FullMethodName             = "/neon.Neon/GetInfo"
 )
 
 // NeonClient is the client API for Neon service.
@@ -45,6 +49,8 @@ const (
 type NeonClient interface {
 	// AddFeeds adds a new feed source.
 	AddFeed(ctx context.Context, in *AddFeedRequest, opts ...grpc.CallOption) (*AddFeedResponse, error)
+	// DiscoverFeeds lists the feeds advertised by a web page.
+	DiscoverFeeds(ctx context.Context, in *DiscoverFeedsRequest, opts ...grpc.CallOption) (*DiscoverFeedsResponse, error)
 	// EditFeeds sets one or more fields of feeds.
 	EditFeeds(ctx context.Context, in *EditFeedsRequest, opts ...grpc.CallOption) (*EditFeedsResponse, error)
 	// ListFeeds lists all added feed sources.
@@ -61,6 +67,13 @@ type NeonClient interface {
 	EditEntries(ctx context.Context, in *EditEntriesRequest, opts ...grpc.CallOption) (*EditEntriesResponse, error)
 	// GetEntry returns the content of an entry.
 	GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error)
+	// ExtractEntryContent replaces the content of an entry with the full content extracted from the
+	// page it links to.
+	ExtractEntryContent(ctx context.Context, in *ExtractEntryContentRequest, opts ...grpc.CallOption) (*ExtractEntryContentResponse, error)
+	// SearchEntries returns entries matching a full-text query, ordered by relevance.
+	SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (*SearchEntriesResponse, error)
+	// PruneEntries removes entries that fall outside the retention policy.
+	PruneEntries(ctx context.Context, in *PruneEntriesRequest, opts ...grpc.CallOption) (*PruneEntriesResponse, error)
 	// ExportOPML exports feed subscriptions as an OPML document.
 	ExportOPML(ctx context.Context, in *ExportOPMLRequest, opts ...grpc.CallOption) (*ExportOPMLResponse, error)
 	// ImportOPML imports an OPML document.
@@ -89,6 +102,16 @@ func (c *neonClient) AddFeed(ctx context.Context, in *AddFeedRequest, opts ...gr
 	return out, nil
 }
 
+func (c *neonClient) DiscoverFeeds(ctx context.Context, in *DiscoverFeedsRequest, opts ...grpc.CallOption) (*DiscoverFeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverFeedsResponse)
+	err := c.cc.Invoke(ctx, Neon_DiscoverFeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *neonClient) EditFeeds(ctx context.Context, in *EditFeedsRequest, opts ...grpc.CallOption) (*EditFeedsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EditFeedsResponse)
@@ -187,6 +210,36 @@ func (c *neonClient) GetEntry(ctx context.Context, in *GetEntryRequest, opts ...
 	return out, nil
 }
 
+func (c *neonClient) ExtractEntryContent(ctx context.Context, in *ExtractEntryContentRequest, opts ...grpc.CallOption) (*ExtractEntryContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtractEntryContentResponse)
+	err := c.cc.Invoke(ctx, Neon_ExtractEntryContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (*SearchEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchEntriesResponse)
+	err := c.cc.Invoke(ctx, Neon_SearchEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) PruneEntries(ctx context.Context, in *PruneEntriesRequest, opts ...grpc.CallOption) (*PruneEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PruneEntriesResponse)
+	err := c.cc.Invoke(ctx, Neon_PruneEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *neonClient) ExportOPML(ctx context.Context, in *ExportOPMLRequest, opts ...grpc.CallOption) (*ExportOPMLResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportOPMLResponse)
@@ -233,6 +286,8 @@ func (c *neonClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...gr
 type NeonServer interface {
 	// AddFeeds adds a new feed source.
 	AddFeed(context.Context, *AddFeedRequest) (*AddFeedResponse, error)
+	// DiscoverFeeds lists the feeds advertised by a web page.
+	DiscoverFeeds(context.Context, *DiscoverFeedsRequest) (*DiscoverFeedsResponse, error)
 	// EditFeeds sets one or more fields of feeds.
 	EditFeeds(context.Context, *EditFeedsRequest) (*EditFeedsResponse, error)
 	// ListFeeds lists all added feed sources.
@@ -249,6 +304,13 @@ type NeonServer interface {
 	EditEntries(context.Context, *EditEntriesRequest) (*EditEntriesResponse, error)
 	// GetEntry returns the content of an entry.
 	GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error)
+	// ExtractEntryContent replaces the content of an entry with the full content extracted from the
+	// page it links to.
+	ExtractEntryContent(context.Context, *ExtractEntryContentRequest) (*ExtractEntryContentResponse, error)
+	// SearchEntries returns entries matching a full-text query, ordered by relevance.
+	SearchEntries(context.Context, *SearchEntriesRequest) (*SearchEntriesResponse, error)
+	// PruneEntries removes entries that fall outside the retention policy.
+	PruneEntries(context.Context, *PruneEntriesRequest) (*PruneEntriesResponse, error)
 	// ExportOPML exports feed subscriptions as an OPML document.
 	ExportOPML(context.Context, *ExportOPMLRequest) (*ExportOPMLResponse, error)
 	// ImportOPML imports an OPML document.
@@ -270,6 +332,9 @@ type UnimplementedNeonServer struct{}
 func (UnimplementedNeonServer) AddFeed(context.Context, *AddFeedRequest) (*AddFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFeed not implemented")
 }
+func (UnimplementedNeonServer) DiscoverFeeds(context.Context, *DiscoverFeedsRequest) (*DiscoverFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverFeeds not implemented")
+}
 func (UnimplementedNeonServer) EditFeeds(context.Context, *EditFeedsRequest) (*EditFeedsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditFeeds not implemented")
 }
@@ -294,6 +359,15 @@ func (UnimplementedNeonServer) EditEntries(context.Context, *EditEntriesRequest)
 func (UnimplementedNeonServer) GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
 }
+func (UnimplementedNeonServer) ExtractEntryContent(context.Context, *ExtractEntryContentRequest) (*ExtractEntryContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtractEntryContent not implemented")
+}
+func (UnimplementedNeonServer) SearchEntries(context.Context, *SearchEntriesRequest) (*SearchEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEntries not implemented")
+}
+func (UnimplementedNeonServer) PruneEntries(context.Context, *PruneEntriesRequest) (*PruneEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneEntries not implemented")
+}
 func (UnimplementedNeonServer) ExportOPML(context.Context, *ExportOPMLRequest) (*ExportOPMLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportOPML not implemented")
 }
@@ -345,6 +419,24 @@ func _Neon_AddFeed_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Neon_DiscoverFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).DiscoverFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_DiscoverFeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).DiscoverFeeds(ctx, req.(*DiscoverFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Neon_EditFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EditFeedsRequest)
 	if err := dec(in); err != nil {
@@ -475,6 +567,60 @@ func _Neon_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Neon_ExtractEntryContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractEntryContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).ExtractEntryContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_ExtractEntryContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).ExtractEntryContent(ctx, req.(*ExtractEntryContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_SearchEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).SearchEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_SearchEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).SearchEntries(ctx, req.(*SearchEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_PruneEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).PruneEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_PruneEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).PruneEntries(ctx, req.(*PruneEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Neon_ExportOPML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportOPMLRequest)
 	if err := dec(in); err != nil {
@@ -558,6 +704,10 @@ var Neon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddFeed",
 			Handler:    _Neon_AddFeed_Handler,
 		},
+		{
+			MethodName: "DiscoverFeeds",
+			Handler:    _Neon_DiscoverFeeds_Handler,
+		},
 		{
 			MethodName: "EditFeeds",
 			Handler:    _Neon_EditFeeds_Handler,
@@ -582,6 +732,18 @@ var Neon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEntry",
 			Handler:    _Neon_GetEntry_Handler,
 		},
+		{
+			MethodName: "ExtractEntryContent",
+			Handler:    _Neon_ExtractEntryContent_Handler,
+		},
+		{
+			MethodName: "SearchEntries",
+			Handler:    _Neon_SearchEntries_Handler,
+		},
+		{
+			MethodName: "PruneEntries",
+			Handler:    _Neon_PruneEntries_Handler,
+		},
 		{
 			MethodName: "ExportOPML",
 			Handler:    _Neon_ExportOPML_Handler,
@@ -4,21 +4,48 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"golang.org/x/net/context"
 
 	"github.com/bow/neon/internal"
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
 )
 
 const (
-	dbPathKey         = "db-path"
-	defaultServerAddr = "127.0.0.1:5151"
+	dbPathKey          = "db-path"
+	pullIntervalKey    = "pull-interval"
+	pullConcurrencyKey = "pull-concurrency"
+	pullPerHostKey     = "pull-concurrency-per-host"
+	pullHostDelayKey   = "pull-host-delay"
+	keepEntriesKey     = "keep-entries"
+	keepReadKey        = "keep-read"
+	userAgentKey       = "user-agent"
+	proxyKey           = "proxy"
+	headerKey          = "header"
+	maxResponseSizeKey = "max-response-size"
+	itemSelectorKey    = "item-selector"
+	titleSelectorKey   = "title-selector"
+	linkSelectorKey    = "link-selector"
+	dateSelectorKey    = "date-selector"
+	summarySelectorKey = "summary-selector"
+	websubAddrKey      = "websub-addr"
+	websubURLKey       = "websub-url"
+	allowLocalFeedsKey = "allow-local-feeds"
+	resolversFileKey   = "resolvers-file"
+	defaultServerAddr  = "127.0.0.1:5151"
 )
 
 func newViper(cmdName string) *viper.Viper {
@@ -29,6 +56,183 @@ func newViper(cmdName string) *viper.Viper {
 	return v
 }
 
+// addRetentionFlags adds flags for setting the global entry retention policy.
+func addRetentionFlags(flags *pflag.FlagSet) {
+	flags.Uint32(
+		keepEntriesKey,
+		0,
+		"maximum number of entries kept per feed, excluding bookmarks; 0 keeps all",
+	)
+	flags.Duration(
+		keepReadKey,
+		0,
+		"how long read entries are kept for, excluding bookmarks; 0 keeps them forever",
+	)
+}
+
+// retentionFromViper creates the global entry retention policy from flags added by
+// addRetentionFlags.
+func retentionFromViper(v *viper.Viper) entity.RetentionPolicy {
+	var policy entity.RetentionPolicy
+	if value := v.GetUint32(keepEntriesKey); value > 0 {
+		policy.MaxEntries = &value
+	}
+	if value := v.GetDuration(keepReadKey); value > 0 {
+		policy.MaxReadAge = &value
+	}
+	return policy
+}
+
+// addScrapeFlags adds flags for defining the CSS selectors of scraped feeds.
+func addScrapeFlags(flags *pflag.FlagSet) {
+	flags.String(itemSelectorKey, "", "CSS selector of the elements containing the entries")
+	flags.String(titleSelectorKey, "", "CSS selector of the entry title, within the item")
+	flags.String(
+		linkSelectorKey,
+		"",
+		"CSS selector of the entry link, within the item; defaults to the first link",
+	)
+	flags.String(dateSelectorKey, "", "CSS selector of the entry date, within the item")
+	flags.String(summarySelectorKey, "", "CSS selector of the entry summary, within the item")
+}
+
+// scrapeSpecFromViper creates the scrape spec from flags added by addScrapeFlags.
+func scrapeSpecFromViper(v *viper.Viper) *entity.ScrapeSpec {
+	optional := func(key string) *string {
+		if value := v.GetString(key); value != "" {
+			return &value
+		}
+		return nil
+	}
+	return &entity.ScrapeSpec{
+		Item:    v.GetString(itemSelectorKey),
+		Title:   v.GetString(titleSelectorKey),
+		Link:    optional(linkSelectorKey),
+		Date:    optional(dateSelectorKey),
+		Summary: optional(summarySelectorKey),
+	}
+}
+
+// addFetcherFlags adds flags for configuring how feeds are fetched.
+func addFetcherFlags(flags *pflag.FlagSet) {
+	flags.String(userAgentKey, datastore.DefaultUserAgent(), "user agent sent when fetching feeds")
+	flags.String(
+		proxyKey,
+		"",
+		"HTTP or SOCKS5 proxy URL for fetching feeds; defaults to the HTTP_PROXY variables",
+	)
+	flags.StringArray(headerKey, nil, "header sent when fetching feeds, as 'Name: value'")
+	flags.Int64(
+		maxResponseSizeKey,
+		datastore.DefaultMaxResponseSize,
+		"maximum size of fetched feeds, in bytes",
+	)
+}
+
+// fetcherConfigFromViper creates the fetcher configuration from flags added by addFetcherFlags.
+func fetcherConfigFromViper(v *viper.Viper) (datastore.FetcherConfig, error) {
+	cfg := datastore.FetcherConfig{
+		UserAgent:       v.GetString(userAgentKey),
+		MaxResponseSize: v.GetInt64(maxResponseSizeKey),
+	}
+	if value := v.GetString(proxyKey); value != "" {
+		proxy, err := url.Parse(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		cfg.Proxy = proxy
+	}
+	for _, value := range v.GetStringSlice(headerKey) {
+		name, hvalue, found := strings.Cut(value, ":")
+		if name = strings.TrimSpace(name); !found || name == "" {
+			return cfg, fmt.Errorf("invalid header %q: expected 'Name: value'", value)
+		}
+		if cfg.Headers == nil {
+			cfg.Headers = make(http.Header)
+		}
+		cfg.Headers.Add(name, strings.TrimSpace(hvalue))
+	}
+	return cfg, nil
+}
+
+// addResolverFlags adds flags for configuring the URL resolvers of well-known sites.
+func addResolverFlags(flags *pflag.FlagSet) {
+	flags.String(
+		resolversFileKey,
+		defaultResolversPath,
+		"config file of custom URL resolvers; see 'feed resolvers'",
+	)
+}
+
+// resolversFromViper creates the URL resolvers from flags added by addResolverFlags: the custom
+// resolvers of the config file, if any, followed by the built-in ones they do not override.
+func resolversFromViper(v *viper.Viper) (datastore.URLResolvers, error) {
+	custom, err := customResolversFromViper(v)
+	if err != nil {
+		return nil, err
+	}
+	return datastore.DefaultURLResolvers().Override(custom...), nil
+}
+
+// customResolversFromViper reads the custom URL resolvers of the config file set by the flags
+// added by addResolverFlags. The default config file is optional.
+func customResolversFromViper(v *viper.Viper) ([]datastore.URLResolver, error) {
+	value := v.GetString(resolversFileKey)
+	path, err := resolveConfigPath(value)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) && value == defaultResolversPath {
+		return nil, nil
+	}
+	return loadRewriteRules(path)
+}
+
+// rewriteRuleConfig is an entry of the config file of custom URL resolvers.
+type rewriteRuleConfig struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Pattern     string `mapstructure:"pattern"`
+	Feed        string `mapstructure:"feed"`
+}
+
+// loadRewriteRules reads the rewrite rules in the given config file, which lists them as
+// 'resolvers' entries with a name, a description, a regular expression 'pattern' of page URLs,
+// and a 'feed' URL template.
+func loadRewriteRules(path string) ([]datastore.URLResolver, error) {
+	rv := viper.New()
+	rv.SetConfigFile(path)
+	if err := rv.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("can not read resolvers file: %w", err)
+	}
+	var entries []rewriteRuleConfig
+	if err := rv.UnmarshalKey("resolvers", &entries); err != nil {
+		return nil, fmt.Errorf("invalid resolvers file: %w", err)
+	}
+	rules := make([]datastore.URLResolver, len(entries))
+	names := make(map[string]struct{}, len(entries))
+	for i, entry := range entries {
+		if _, exists := names[entry.Name]; exists {
+			return nil, fmt.Errorf("invalid resolvers file: duplicate resolver %q", entry.Name)
+		}
+		names[entry.Name] = struct{}{}
+		rule, err := datastore.NewRewriteRule(
+			entry.Name,
+			entry.Description,
+			entry.Pattern,
+			entry.Feed,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resolvers file: %w", err)
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
 type ctxKey string
 
 func toCmdContext(cmd *cobra.Command, key string, value any) {
@@ -6,6 +6,7 @@
 package cmd
 
 import (
+	"path/filepath"
 	"strings"
 
 	"github.com/adrg/xdg"
@@ -28,3 +29,16 @@ func resolveDBPath(path string) (string, error) {
 	}
 	return path, nil
 }
+
+var defaultResolversPath = "$XDG_CONFIG_HOME/neon/resolvers.toml"
+
+var defaultKeysPath = "$XDG_CONFIG_HOME/neon/keys.toml"
+
+func resolveConfigPath(path string) (string, error) {
+	xdgDir := "$XDG_CONFIG_HOME/"
+	if strings.HasPrefix(path, xdgDir) {
+		rel := strings.TrimPrefix(path, xdgDir)
+		return filepath.Join(xdg.ConfigHome, filepath.FromSlash(rel)), nil
+	}
+	return path, nil
+}
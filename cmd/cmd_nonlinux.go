@@ -21,6 +21,16 @@ func resolveDBPath(path string) (string, error) {
 	return "", fmt.Errorf("not yet supported")
 }
 
+// FIXME: Define this for non-linux.
+var defaultResolversPath = ""
+
+// FIXME: Define this for non-linux.
+var defaultKeysPath = ""
+
+func resolveConfigPath(path string) (string, error) {
+	return path, nil
+}
+
 func stateDir() (string, error) {
 	cd, err := os.UserCacheDir()
 	if err != nil {
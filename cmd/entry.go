@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newEntryCommand() *cobra.Command {
+
+	const name = "entry"
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: makeAlias(name),
+		Short:   "View or modify entries",
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+
+			dbPath, err := resolveDBPath(v.GetString(dbPathKey))
+			if err != nil {
+				return err
+			}
+			dbPathToCmdCtx(cmd, dbPath)
+
+			return nil
+		},
+	}
+
+	pflags := command.PersistentFlags()
+
+	pflags.StringP(dbPathKey, "d", defaultDBPath, "datastore location")
+
+	if err := v.BindPFlags(pflags); err != nil {
+		panic(err)
+	}
+
+	command.AddCommand(newEntrySearchCommand())
+
+	return &command
+}
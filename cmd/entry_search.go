@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newEntrySearchCommand() *cobra.Command {
+
+	const (
+		name     = "search"
+		feedKey  = "feed"
+		tagKey   = "tag"
+		limitKey = "limit"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s QUERY", name),
+		Aliases: makeAlias(name),
+		Short:   "Search entries",
+		Long: `Search entries by their title, description, and content.
+
+The query supports phrases ("foo bar"), prefixes (foo*), and the AND, OR,
+and NOT operators. Results are ordered by relevance.`,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			if len(args) == 0 {
+				return fmt.Errorf("search query not specified")
+			} else if len(args) > 1 {
+				return fmt.Errorf("too many arguments; enclose multi-word queries in quotes")
+			}
+
+			feedIDs, err := entity.ToFeedIDs(v.GetStringSlice(feedKey))
+			if err != nil {
+				return err
+			}
+
+			var tags []string
+			if value := v.GetStringSlice(tagKey); len(value) > 0 {
+				tags = value
+			}
+
+			var limit *uint32
+			if value := v.GetUint32(limitKey); value > 0 {
+				limit = &value
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			results, err := db.SearchEntries(cmd.Context(), args[0], feedIDs, tags, limit)
+			if err != nil {
+				return err
+			}
+			for _, result := range results {
+				fmt.Printf("%s\n", fmtSearchResult(result))
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringArrayP(feedKey, "f", nil, "limit search to the given feed ID")
+	flags.StringArrayP(tagKey, "t", nil, "limit search to feeds with the given tag")
+	flags.Uint32P(limitKey, "n", 20, "maximum number of results; 0 means no limit")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func fmtSearchResult(result *entity.SearchResult) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmtListEntry(result.Entry))
+
+	snippet := strings.Join(strings.Fields(result.Snippet), " ")
+	snippet = strings.NewReplacer(
+		entity.SnippetMatchStart, "\x1b[1;33m",
+		entity.SnippetMatchEnd, "\x1b[0m",
+	).Replace(snippet)
+	fmt.Fprintf(&sb, "  %s\n", snippet)
+
+	return sb.String()
+}
@@ -39,11 +39,16 @@ func newFeedCommand() *cobra.Command {
 	}
 
 	command.AddCommand(newFeedAddCommand())
+	command.AddCommand(newFeedDiscoverCommand())
+	command.AddCommand(newFeedEditCommand())
 	command.AddCommand(newFeedExportCommand())
 	command.AddCommand(newFeedImportCommand())
 	command.AddCommand(newFeedListCommand())
 	command.AddCommand(newFeedPullCommand())
+	command.AddCommand(newFeedPruneCommand())
+	command.AddCommand(newFeedResolversCommand())
 	command.AddCommand(newFeedListEntriesCommand())
+	command.AddCommand(newFeedScrapeTestCommand())
 	command.AddCommand(newFeedShowEntryCommand())
 
 	return &command
@@ -4,11 +4,20 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/bow/neon/internal/entity"
 )
@@ -16,12 +25,20 @@ import (
 func newFeedAddCommand() *cobra.Command {
 
 	const (
-		name       = "add"
-		titleKey   = "title"
-		descKey    = "desc"
-		starKey    = "star"
-		tagKey     = "tag"
-		timeoutKey = "timeout"
+		name           = "add"
+		titleKey       = "title"
+		descKey        = "desc"
+		starKey        = "star"
+		tagKey         = "tag"
+		timeoutKey     = "timeout"
+		usernameKey    = "username"
+		passwordKey    = "password"
+		tokenKey       = "token"
+		execDirKey     = "exec-dir"
+		execTimeoutKey = "exec-timeout"
+		scrapeKey      = "scrape"
+		allKey         = "all"
+		fetchKey       = "fetch-content"
 	)
 	var v = newViper(name)
 
@@ -30,11 +47,48 @@ func newFeedAddCommand() *cobra.Command {
 		Args:    cobra.ExactArgs(1),
 		Aliases: makeAlias(name),
 		Short:   "Add a new feed",
+		Long: `Add a new feed.
+
+The input may also be the URL of a web page that advertises its feeds. If the
+page advertises more than one feed, you will be asked to choose one of them,
+unless --all is set. Pages of well-known sites, such as YouTube channels or
+GitHub repositories, are rewritten into the URLs of their feeds beforehand. Use
+'feed resolvers' to list the recognised sites.
+
+Feeds that require authentication are fetched with the given username and
+password, or with the given bearer token. The credentials are stored for
+subsequent pulls. To keep them out of the shell history, they may also be set
+through the NEON_ADD_USERNAME, NEON_ADD_PASSWORD, and NEON_ADD_TOKEN
+environment variables.
+
+Local feed files are added with file:// URLs, or simply with their paths.
+Feeds generated by a command are added with exec: URLs, e.g.
+'exec:./report.sh --atom'. The command is run by the shell, and its standard
+output is parsed as the feed. It is run in the current directory unless
+--exec-dir is set, and the directory and timeout are stored for subsequent
+pulls.
+
+Email newsletters are added with the path of a local Maildir directory or mbox
+file. The messages of a mailbox are grouped into feeds by their mailing list or,
+failing that, by their sender, and each group is added as a feed of its own.
+Use 'feed discover' to list the groups of a mailbox.
+
+Web pages without any feed are added with --scrape, along with the CSS
+selectors of their entries. Each element matched by --item-selector is an
+entry, whose title, link, date, and summary are matched within it. Use
+'feed scrape-test' to try out the selectors first.
+
+Feeds that only provide summaries of their entries are added with
+--fetch-content. After each pull, the main content of the page linked by each
+new entry is then extracted and stored as the content of the entry.`,
 
 		DisableFlagsInUseLine: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			url := args[0]
+			feedURL := args[0]
+			if value, ok := localFeedURL(feedURL); ok {
+				feedURL = value
+			}
 
 			var title *string
 			if value := v.GetString(titleKey); value != "" {
@@ -56,6 +110,34 @@ func newFeedAddCommand() *cobra.Command {
 				tags = value
 			}
 
+			var creds entity.FeedCredentials
+			if value := v.GetString(usernameKey); value != "" {
+				creds.Username = &value
+			}
+			if value := v.GetString(passwordKey); value != "" {
+				creds.Password = &value
+			}
+			if value := v.GetString(tokenKey); value != "" {
+				creds.Token = &value
+			}
+
+			var execOpts *entity.ExecOptions
+			if _, ok := entity.ExecCommand(feedURL); ok {
+				dir, err := filepath.Abs(v.GetString(execDirKey))
+				if err != nil {
+					return err
+				}
+				execOpts = &entity.ExecOptions{Dir: &dir}
+				if value := v.GetDuration(execTimeoutKey); value > 0 {
+					execOpts.Timeout = &value
+				}
+			}
+
+			var scrape *entity.ScrapeSpec
+			if v.GetBool(scrapeKey) {
+				scrape = scrapeSpecFromViper(v)
+			}
+
 			var pullTimeout *time.Duration
 			if value := v.GetDuration(timeoutKey); value > 0 {
 				pullTimeout = &value
@@ -65,16 +147,65 @@ func newFeedAddCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			fetcherConfig, err := fetcherConfigFromViper(v)
+			if err != nil {
+				return err
+			}
+			db.SetFetcherConfig(fetcherConfig)
+			resolvers, err := resolversFromViper(v)
+			if err != nil {
+				return err
+			}
+			db.SetURLResolvers(resolvers)
+
+			var credsp *entity.FeedCredentials
+			if !creds.IsEmpty() {
+				credsp = &creds
+			}
+			add := func(feedURL string) (*entity.Feed, bool, error) {
+				feed, added, err := db.AddFeed(
+					cmd.Context(),
+					feedURL,
+					title,
+					desc,
+					tags,
+					isStarred,
+					credsp,
+					execOpts,
+					scrape,
+					pullTimeout,
+				)
+				if err != nil || !v.GetBool(fetchKey) {
+					return feed, added, err
+				}
+				fetchContent := true
+				op := entity.FeedEditOp{ID: feed.ID, FetchContent: &fetchContent}
+				feeds, err := db.EditFeeds(cmd.Context(), []*entity.FeedEditOp{&op})
+				if err != nil {
+					return nil, false, err
+				}
+				return feeds[0], added, nil
+			}
 
-			feed, added, err := db.AddFeed(
-				cmd.Context(),
-				url,
-				title,
-				desc,
-				tags,
-				isStarred,
-				pullTimeout,
-			)
+			feed, added, err := add(feedURL)
+			var aerr entity.AmbiguousFeedError
+			switch {
+			case errors.As(err, &aerr) && v.GetBool(allKey):
+				for _, cand := range aerr.Candidates {
+					if feed, added, err = add(cand.URL); err != nil {
+						return err
+					}
+					logAddResult(feed, added)
+				}
+				return nil
+			// Let the user pick one if the page advertises several feeds.
+			case errors.As(err, &aerr) && term.IsTerminal(int(os.Stdin.Fd())):
+				cand, ierr := chooseFeedCandidate(os.Stdin, os.Stdout, aerr.Candidates)
+				if ierr != nil {
+					return ierr
+				}
+				feed, added, err = add(cand.URL)
+			}
 			if err != nil {
 				return err
 			}
@@ -92,6 +223,17 @@ func newFeedAddCommand() *cobra.Command {
 	flags.Bool(starKey, false, "star the feed")
 	flags.StringArray(tagKey, nil, "feed tags")
 	flags.Duration(timeoutKey, 20*time.Second, "timeout for adding the feed")
+	flags.String(usernameKey, "", "username for fetching the feed with basic authentication")
+	flags.String(passwordKey, "", "password for fetching the feed with basic authentication")
+	flags.String(tokenKey, "", "bearer token for fetching the feed")
+	flags.String(execDirKey, "", "working directory of the command of exec: feeds")
+	flags.Duration(execTimeoutKey, 0, "timeout for running the command of exec: feeds")
+	flags.Bool(scrapeKey, false, "scrape the entries of a web page without a feed")
+	flags.Bool(allKey, false, "add all feeds advertised by the input instead of choosing one")
+	flags.Bool(fetchKey, false, "extract the full content of new entries from their pages")
+	addScrapeFlags(flags)
+	addFetcherFlags(flags)
+	addResolverFlags(flags)
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
@@ -100,6 +242,96 @@ func newFeedAddCommand() *cobra.Command {
 	return &command
 }
 
+// localFeedURL returns the URL of the given input if it is the path of an existing local file or
+// Maildir directory, rather than a URL. Maildir directories and mbox files get mailbox URLs, and
+// other files get file:// URLs.
+func localFeedURL(input string) (string, bool) {
+	if strings.Contains(input, "://") {
+		return "", false
+	}
+	if _, ok := entity.ExecCommand(input); ok {
+		return "", false
+	}
+	info, err := os.Stat(input)
+	if err != nil {
+		return "", false
+	}
+	path := input
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	switch {
+	case info.IsDir():
+		if !isMaildir(path) {
+			return "", false
+		}
+		return entity.MailboxURL(entity.MaildirScheme, path, ""), true
+	case isMbox(path):
+		return entity.MailboxURL(entity.MboxScheme, path, ""), true
+	default:
+		return fileURL(path), true
+	}
+}
+
+// fileURL returns the file:// URL of the given path.
+func fileURL(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+// isMaildir checks whether the given directory is a Maildir directory.
+func isMaildir(dir string) bool {
+	for _, sub := range []string{"cur", "new"} {
+		if info, err := os.Stat(filepath.Join(dir, sub)); err != nil || !info.IsDir() {
+			return false
+		}
+	}
+	return true
+}
+
+// isMbox checks whether the given file is an mbox file, which starts with a "From " line.
+func isMbox(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	head := make([]byte, 5)
+	if _, err := io.ReadFull(f, head); err != nil {
+		return false
+	}
+	return string(head) == "From "
+}
+
+// chooseFeedCandidate prompts for one of the given feed candidates until a valid choice is made.
+func chooseFeedCandidate(
+	r io.Reader,
+	w io.Writer,
+	cands []*entity.FeedCandidate,
+) (*entity.FeedCandidate, error) {
+
+	for i, cand := range cands {
+		fmt.Fprintf(w, "%s\n", fmtFeedCandidate(i+1, cand))
+	}
+
+	scanner := bufio.NewScanner(r)
+	for {
+		fmt.Fprintf(w, "Choose a feed [1-%d]: ", len(cands))
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("no feed chosen")
+		}
+		num, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+		if err == nil && num >= 1 && num <= len(cands) {
+			return cands[num-1], nil
+		}
+	}
+}
+
 func logAddResult(feed *entity.Feed, added bool) {
 
 	var msg string
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestChooseFeedCandidate(t *testing.T) {
+	t.Parallel()
+
+	cands := []*entity.FeedCandidate{
+		{URL: "http://a.com/rss.xml"},
+		{URL: "http://a.com/atom.xml"},
+	}
+
+	var out bytes.Buffer
+	cand, err := chooseFeedCandidate(strings.NewReader("x\n3\n2\n"), &out, cands)
+	require.NoError(t, err)
+
+	assert.Equal(t, cands[1], cand)
+	assert.Equal(t, 3, strings.Count(out.String(), "Choose a feed [1-2]: "))
+}
+
+func TestChooseFeedCandidateErrNoInput(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	cand, err := chooseFeedCandidate(
+		strings.NewReader(""),
+		&out,
+		[]*entity.FeedCandidate{{URL: "http://a.com/rss.xml"}},
+	)
+
+	assert.Nil(t, cand)
+	assert.EqualError(t, err, "no feed chosen")
+}
+
+func TestLocalFeedURL(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	dir := t.TempDir()
+	maildir := filepath.Join(dir, "Mail")
+	for _, sub := range []string{"cur", "new", "tmp"} {
+		r.NoError(os.MkdirAll(filepath.Join(maildir, sub), 0o700))
+	}
+	mbox := filepath.Join(dir, "inbox.mbox")
+	r.NoError(os.WriteFile(mbox, []byte("From a@b.com Mon Oct 12 08:00:00 2026\n"), 0o600))
+	feed := filepath.Join(dir, "feed.xml")
+	r.NoError(os.WriteFile(feed, []byte("<rss/>"), 0o600))
+
+	got, ok := localFeedURL(maildir)
+	a.True(ok)
+	a.Equal("maildir://"+filepath.ToSlash(maildir), got)
+
+	got, ok = localFeedURL(mbox)
+	a.True(ok)
+	a.Equal("mbox://"+filepath.ToSlash(mbox), got)
+
+	got, ok = localFeedURL(feed)
+	a.True(ok)
+	a.Equal("file://"+filepath.ToSlash(feed), got)
+
+	for _, input := range []string{dir, "https://a.com/feed.xml", "exec:cat feed.xml"} {
+		_, ok = localFeedURL(input)
+		a.False(ok, input)
+	}
+}
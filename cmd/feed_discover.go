@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newFeedDiscoverCommand() *cobra.Command {
+
+	const (
+		name       = "discover"
+		timeoutKey = "timeout"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s URL", name),
+		Args:    cobra.ExactArgs(1),
+		Aliases: makeAlias(name),
+		Short:   "List the feeds advertised by a web page or mailbox",
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			var timeout *time.Duration
+			if value := v.GetDuration(timeoutKey); value > 0 {
+				timeout = &value
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			fetcherConfig, err := fetcherConfigFromViper(v)
+			if err != nil {
+				return err
+			}
+			db.SetFetcherConfig(fetcherConfig)
+
+			pageURL := args[0]
+			if value, ok := localFeedURL(pageURL); ok {
+				pageURL = value
+			}
+
+			cands, err := db.DiscoverFeeds(cmd.Context(), pageURL, timeout)
+			if err != nil {
+				return err
+			}
+			for i, cand := range cands {
+				fmt.Printf("%s\n", fmtFeedCandidate(i+1, cand))
+			}
+			log.Info().Int("num_found", len(cands)).Msg("Finished discovering feeds")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.Duration(timeoutKey, 20*time.Second, "timeout for discovering feeds")
+	addFetcherFlags(flags)
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func fmtFeedCandidate(num int, cand *entity.FeedCandidate) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "\x1b[36m%d.\x1b[0m \x1b[4m%s\x1b[0m\n", num, cand.URL)
+	if cand.Title != nil {
+		fmt.Fprintf(&sb, "  Title  : %s\n", capText(*cand.Title))
+	}
+	if cand.Format != nil {
+		fmt.Fprintf(&sb, "  Format : %s\n", *cand.Format)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedEditCommand() *cobra.Command {
+
+	const name = "edit"
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s FEED-ID...", name),
+		Aliases: []string{"ed"},
+		Short:   "Edit feeds",
+		Long: `Edit feeds.
+
+Only the properties of the flags given are changed. A zero --pull-interval
+removes the interval of the feeds, so that they are pulled at the interval of
+the server instead.`,
+
+		Args:                  cobra.MinimumNArgs(1),
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			ids, err := entity.ToFeedIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			var pullInterval *time.Duration
+			if cmd.Flags().Changed(pullIntervalKey) {
+				value := v.GetDuration(pullIntervalKey)
+				if value < 0 {
+					return fmt.Errorf("pull interval must not be negative")
+				}
+				pullInterval = &value
+			}
+			if pullInterval == nil {
+				return fmt.Errorf("nothing to edit")
+			}
+
+			ops := make([]*entity.FeedEditOp, len(ids))
+			for i, id := range ids {
+				ops[i] = &entity.FeedEditOp{ID: id, PullInterval: pullInterval}
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			feeds, err := db.EditFeeds(cmd.Context(), ops)
+			if err != nil {
+				return err
+			}
+			for _, feed := range feeds {
+				fmt.Printf("%s", fmtFeed(feed))
+			}
+
+			log.Info().Int("num_edited", len(feeds)).Msg("Finished editing feeds")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.Duration(pullIntervalKey, 0, "interval between scheduled pulls of the feeds")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
@@ -6,6 +6,7 @@ package cmd
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -66,6 +67,34 @@ func fmtFeed(feed *entity.Feed) string {
 		{"URL", derefOrEmpty(feed.SiteURL)},
 		{"Tags", fmtTags(feed.Tags)},
 	}
+	if pi := feed.PullInterval; pi != nil {
+		kv = append(kv, &struct{ k, v string }{"Pull interval", pi.String()})
+	}
+	if n := feed.Retention.MaxEntries; n != nil {
+		kv = append(kv, &struct{ k, v string }{"Keep entries", fmt.Sprintf("%d", *n)})
+	}
+	if age := feed.Retention.MaxReadAge; age != nil {
+		kv = append(kv, &struct{ k, v string }{"Keep read", age.String()})
+	}
+	if feed.IsPaused {
+		kv = append(kv, &struct{ k, v string }{"Paused", "yes"})
+	}
+	if feed.FetchContent {
+		kv = append(kv, &struct{ k, v string }{"Full content", "yes"})
+	}
+	if bu := feed.BackoffUntil; bu != nil && bu.After(time.Now()) {
+		kv = append(kv, &struct{ k, v string }{"Retry after", fmtTime(*bu)})
+	}
+	if np := feed.NextPullTime; np != nil && np.After(time.Now()) {
+		kv = append(kv, &struct{ k, v string }{"Next pull", fmtTime(*np)})
+	}
+	if feed.IsFailing() {
+		kv = append(
+			kv,
+			&struct{ k, v string }{"Failed pulls", fmt.Sprintf("%d", feed.ConsecutiveFailures)},
+			&struct{ k, v string }{"Last error", derefOrEmpty(feed.LastError)},
+		)
+	}
 
 	keyMaxLen := 0
 	for _, line := range kv {
@@ -75,7 +104,12 @@ func fmtFeed(feed *entity.Feed) string {
 		}
 	}
 
-	cat("\x1b[36m▶\x1b[0m \x1b[4m%s\x1b[0m\n", capText(feed.Title))
+	// Feeds whose last pull failed are flagged with a red marker.
+	marker := "\x1b[36m▶\x1b[0m"
+	if feed.IsFailing() {
+		marker = "\x1b[31m✗\x1b[0m"
+	}
+	cat("%s \x1b[4m%s\x1b[0m\n", marker, capText(feed.Title))
 	for _, line := range kv {
 		if line.v == "" {
 			continue
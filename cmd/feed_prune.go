@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedPruneCommand() *cobra.Command {
+
+	const (
+		name      = "prune"
+		dryRunKey = "dry-run"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s [FEED-ID...]", name),
+		Aliases: []string{"pr"},
+		Short:   "Remove entries outside the retention policy",
+		Long: `Remove entries outside the retention policy.
+
+The retention policy of each feed is the global policy set by the flags below,
+overridden by any feed-specific policy. Bookmarked entries are never removed.
+All feeds are pruned if no feed IDs are given.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			ids, err := entity.ToFeedIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			db.SetRetentionPolicy(retentionFromViper(v))
+
+			dryRun := v.GetBool(dryRunKey)
+			entries, err := db.PruneEntries(cmd.Context(), ids, dryRun)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				fmt.Printf("%s\n", fmtListEntry(entry))
+			}
+
+			msg := "Finished pruning entries"
+			if dryRun {
+				msg = "Finished pruning entries (dry run, nothing removed)"
+			}
+			log.Info().Int("num_pruned", len(entries)).Msg(msg)
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.BoolP(dryRunKey, "n", false, "only report entries that would be removed")
+	addRetentionFlags(flags)
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
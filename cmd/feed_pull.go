@@ -13,6 +13,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
+	"github.com/bow/neon/internal/datastore"
 	"github.com/bow/neon/internal/entity"
 	"github.com/bow/neon/internal/sliceutil"
 )
@@ -20,9 +21,13 @@ import (
 func newFeedPullCommand() *cobra.Command {
 
 	const (
-		name       = "pull"
-		timeoutKey = "timeout"
-		numMaxIDs  = 500
+		name           = "pull"
+		timeoutKey     = "timeout"
+		concurrencyKey = "concurrency"
+		perHostKey     = "concurrency-per-host"
+		hostDelayKey   = "host-delay"
+		forceKey       = "force"
+		numMaxIDs      = 500
 	)
 	var v = newViper(name)
 
@@ -30,6 +35,16 @@ func newFeedPullCommand() *cobra.Command {
 		Use:     fmt.Sprintf("%s [FEED-ID...]", name),
 		Aliases: makeAlias(name),
 		Short:   "Pull feed entries",
+		Long: `Pull feed entries.
+
+Feeds that failed to be pulled are not pulled again until their backoff time,
+which doubles with each consecutive failure, has passed. Feeds reported gone by
+their server are paused. When no feed IDs are given, feeds are also not pulled
+before their next pull time, derived from the refresh hints of the feed and the
+caching headers of its last response. Use --force to pull these feeds anyway.
+
+Feeds of the same host are fetched with limited concurrency and a minimum delay
+between requests, to avoid being rate limited by the host.`,
 
 		DisableFlagsInUseLine: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -43,6 +58,15 @@ func newFeedPullCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			db.SetPullConcurrency(v.GetInt(concurrencyKey))
+			db.SetPullConcurrencyPerHost(v.GetInt(perHostKey))
+			db.SetPullHostDelay(v.GetDuration(hostDelayKey))
+			db.SetRetentionPolicy(retentionFromViper(v))
+			fetcherConfig, err := fetcherConfigFromViper(v)
+			if err != nil {
+				return err
+			}
+			db.SetFetcherConfig(fetcherConfig)
 
 			rawIDs := sliceutil.Dedup(args)
 			ids, err := entity.ToFeedIDs(rawIDs)
@@ -56,27 +80,59 @@ func newFeedPullCommand() *cobra.Command {
 
 			var (
 				errs []error
+				prs  []entity.PullResult
 				n    int
+				nu   int
+				ns   int
+				nt   int
 				s    = newPullSpinner(rawIDs)
 				maxN = uint32(0)
-				ch   = db.PullFeeds(cmd.Context(), ids, nil, &maxN, perFeedTimeout)
+			)
+			ch := db.PullFeeds(
+				cmd.Context(),
+				ids,
+				nil,
+				&maxN,
+				perFeedTimeout,
+				v.GetBool(forceKey),
 			)
 
 			s.Start()
 			defer s.Stop()
 			for pr := range ch {
+				if pr.URL() != "" {
+					prs = append(prs, pr)
+				}
+				if pr.Throttled() > 0 {
+					nt++
+				}
 				if err := pr.Error(); err != nil {
 					errs = append(errs, fmt.Errorf("%s: %w", pr.URL(), err))
-				} else {
-					n++
+					continue
 				}
+				switch pr.Status() {
+				case entity.PullSkipped:
+					ns++
+					continue
+				case entity.PullNotModified:
+					nu++
+				}
+				n++
 			}
 			s.Stop()
 
+			for _, pr := range prs {
+				fmt.Printf("%s\n", fmtPullResult(pr))
+			}
 			if len(errs) > 0 {
 				return errors.Join(errs...)
 			}
-			log.Info().Int("num_pulled", n).Msgf("Finished pulling feeds")
+			log.Info().
+				Int("num_pulled", n).
+				Int("num_unchanged", nu).
+				Int("num_skipped", ns).
+				Int("num_throttled", nt).
+				Msgf("Finished pulling feeds")
 
 			return nil
 		},
@@ -85,6 +141,24 @@ func newFeedPullCommand() *cobra.Command {
 	flags := command.Flags()
 
 	flags.Duration(timeoutKey, 20*time.Second, "timeout for pulling each feed")
+	flags.Int(
+		concurrencyKey,
+		datastore.DefaultPullConcurrency,
+		"maximum number of feeds fetched concurrently",
+	)
+	flags.Int(
+		perHostKey,
+		datastore.DefaultPullConcurrencyPerHost,
+		"maximum number of feeds fetched concurrently from the same host",
+	)
+	flags.Duration(
+		hostDelayKey,
+		datastore.DefaultPullHostDelay,
+		"minimum delay between requests to the same host",
+	)
+	flags.BoolP(forceKey, "f", false, "pull feeds even if they are paused, backing off, or not due")
+	addRetentionFlags(flags)
+	addFetcherFlags(flags)
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
@@ -93,6 +167,14 @@ func newFeedPullCommand() *cobra.Command {
 	return &command
 }
 
+func fmtPullResult(pr entity.PullResult) string {
+	line := fmt.Sprintf("%-12s %s", pr.Status(), pr.URL())
+	if d := pr.Throttled(); d > 0 {
+		line += fmt.Sprintf(" (throttled %s)", d.Round(time.Millisecond))
+	}
+	return line
+}
+
 func newPullSpinner(rawIDs []string) *spinner.Spinner {
 	var msg string
 	if nids := len(rawIDs); nids == 0 {
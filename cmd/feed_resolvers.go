@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/datastore"
+)
+
+func newFeedResolversCommand() *cobra.Command {
+
+	const name = "resolvers"
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s [URL]", name),
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: makeAlias(name),
+		Short:   "List the URL resolvers of well-known sites",
+		Long: `List the URL resolvers of well-known sites.
+
+When a feed is added, the URLs of pages of well-known sites are rewritten into
+the URLs of their feeds by the first resolver that recognises them. If a URL is
+given, only the feed URL it resolves to is shown.
+
+Custom resolvers are read from the file set by --resolvers-file. They take
+precedence over the built-in ones, and replace those with the same name. Each
+resolver rewrites page URLs matching its regular expression pattern into the
+feed URL template, in which $1 or ${name} is replaced by the text of the
+corresponding capture group, e.g.:
+
+  [[resolvers]]
+  name = "codeberg-releases"
+  description = "Releases of Codeberg repositories"
+  pattern = '^https://codeberg\.org/(?P<owner>[^/]+)/(?P<repo>[^/?#]+)/?$'
+  feed = "https://codeberg.org/${owner}/${repo}/releases.rss"`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			custom, err := customResolversFromViper(v)
+			if err != nil {
+				return err
+			}
+			resolvers := datastore.DefaultURLResolvers().Override(custom...)
+
+			if len(args) == 0 {
+				for i, r := range resolvers {
+					fmt.Printf("%s\n", fmtURLResolver(i+1, r, i < len(custom)))
+				}
+				return nil
+			}
+
+			feedURL, r, ok := resolvers.Resolve(args[0])
+			if !ok {
+				return fmt.Errorf("no resolver recognises %q", args[0])
+			}
+			fmt.Printf("%s\n", feedURL)
+			log.Info().Str("resolver", r.Name()).Msg("Resolved feed URL")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	addResolverFlags(flags)
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func fmtURLResolver(num int, r datastore.URLResolver, isCustom bool) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "\x1b[36m%d.\x1b[0m \x1b[4m%s\x1b[0m", num, r.Name())
+	if isCustom {
+		fmt.Fprintf(&sb, " (custom)")
+	}
+	fmt.Fprintf(&sb, "\n")
+	if desc := r.Description(); desc != "" {
+		fmt.Fprintf(&sb, "  Description : %s\n", desc)
+	}
+	if rule, ok := r.(*datastore.RewriteRule); ok {
+		fmt.Fprintf(&sb, "  Pattern     : %s\n", rule.Pattern())
+		fmt.Fprintf(&sb, "  Feed        : %s\n", rule.Template())
+	}
+
+	return sb.String()
+}
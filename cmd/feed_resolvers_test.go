@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolversFromViper(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "resolvers.toml")
+	contents := `
+[[resolvers]]
+name = "codeberg-releases"
+description = "Releases of Codeberg repositories"
+pattern = '^https://codeberg\.org/(?P<owner>[^/]+)/(?P<repo>[^/?#]+)/?$'
+feed = "https://codeberg.org/${owner}/${repo}/releases.rss"
+`
+	r.NoError(os.WriteFile(path, []byte(contents), 0o600))
+
+	v := newViper("test")
+	v.Set(resolversFileKey, path)
+
+	resolvers, err := resolversFromViper(v)
+	r.NoError(err)
+
+	a.Equal("codeberg-releases", resolvers[0].Name())
+	feedURL, resolver, ok := resolvers.Resolve("https://codeberg.org/forgejo/forgejo")
+	r.True(ok)
+	a.Equal("codeberg-releases", resolver.Name())
+	a.Equal("https://codeberg.org/forgejo/forgejo/releases.rss", feedURL)
+
+	// Built-in resolvers still apply.
+	_, resolver, ok = resolvers.Resolve("https://github.com/bow/neon")
+	r.True(ok)
+	a.Equal("github-releases", resolver.Name())
+}
+
+func TestResolversFromViperErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tests := []struct {
+		name     string
+		contents string
+		errMsg   string
+	}{
+		{
+			name:   "missing file",
+			errMsg: "can not read resolvers file",
+		},
+		{
+			name: "invalid pattern",
+			contents: `
+[[resolvers]]
+name = "a"
+pattern = "("
+feed = "https://a.com/feed"
+`,
+			errMsg: `rewrite rule "a": error parsing regexp`,
+		},
+		{
+			name: "duplicate name",
+			contents: `
+[[resolvers]]
+name = "a"
+pattern = "^https://a.com/$"
+feed = "https://a.com/feed"
+
+[[resolvers]]
+name = "a"
+pattern = "^https://b.com/$"
+feed = "https://b.com/feed"
+`,
+			errMsg: `duplicate resolver "a"`,
+		},
+	}
+
+	for i, test := range tests {
+		path := filepath.Join(dir, fmt.Sprintf("resolvers%d.toml", i))
+		if test.contents != "" {
+			require.NoError(t, os.WriteFile(path, []byte(test.contents), 0o600))
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := newViper("test")
+			v.Set(resolversFileKey, path)
+
+			resolvers, err := resolversFromViper(v)
+			assert.Nil(t, resolvers)
+			assert.ErrorContains(t, err, test.errMsg)
+		})
+	}
+}
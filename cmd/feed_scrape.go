@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newFeedScrapeTestCommand() *cobra.Command {
+
+	const (
+		name       = "scrape-test"
+		timeoutKey = "timeout"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   fmt.Sprintf("%s URL", name),
+		Args:  cobra.ExactArgs(1),
+		Short: "Show the entries scraped from a web page",
+		Long: `Show the entries scraped from a web page.
+
+The page is scraped with the given CSS selectors, as it would be by a feed
+added with 'feed add --scrape', but nothing is stored.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			var timeout *time.Duration
+			if value := v.GetDuration(timeoutKey); value > 0 {
+				timeout = &value
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			fetcherConfig, err := fetcherConfigFromViper(v)
+			if err != nil {
+				return err
+			}
+			db.SetFetcherConfig(fetcherConfig)
+
+			entries, err := db.ScrapeFeed(cmd.Context(), args[0], scrapeSpecFromViper(v), timeout)
+			if err != nil {
+				return err
+			}
+			for i, entry := range entries {
+				fmt.Printf("%s\n", fmtScrapedEntry(i+1, entry))
+			}
+			log.Info().Int("num_found", len(entries)).Msg("Finished scraping entries")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.Duration(timeoutKey, 20*time.Second, "timeout for scraping the page")
+	addScrapeFlags(flags)
+	addFetcherFlags(flags)
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func fmtScrapedEntry(num int, entry *entity.Entry) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "\x1b[36m%d.\x1b[0m \x1b[4m%s\x1b[0m\n", num, entry.Title)
+	if entry.URL != nil {
+		fmt.Fprintf(&sb, "  Link    : %s\n", *entry.URL)
+	}
+	if entry.Published != nil {
+		fmt.Fprintf(&sb, "  Date    : %s\n", fmtTime(*entry.Published))
+	}
+	if entry.Description != nil {
+		fmt.Fprintf(&sb, "  Summary : %s\n", capText(*entry.Description))
+	}
+	fmt.Fprintf(&sb, "  ID      : %s\n", entry.ExtID)
+
+	return sb.String()
+}
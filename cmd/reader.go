@@ -4,8 +4,12 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"net"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -14,6 +18,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/bow/neon/internal/reader"
+	"github.com/bow/neon/internal/reader/keymap"
 	"github.com/bow/neon/internal/server"
 )
 
@@ -24,9 +29,10 @@ func newReaderCommand() *cobra.Command {
 		addrKey           = "address"
 		connectKey        = "connect"
 		connectTimeoutKey = "connect-timeout"
+		keysFileKey       = "keys-file"
 	)
 	var (
-		v                  = newViper(name)
+		v                  = newReaderViper(name)
 		defaultStartAddr   = "localhost:0"
 		defaultConnectAddr = defaultServerAddr
 	)
@@ -35,10 +41,35 @@ func newReaderCommand() *cobra.Command {
 		Use:     name,
 		Aliases: append(makeAlias(name), []string{"r"}...),
 		Short:   "Open the feed reader",
+		Long: `Open the feed reader.
+
+The keys of the reader actions can be changed in the file set by --keys-file,
+which binds the names of actions to lists of keys in its 'keys' table, e.g.:
+
+  [keys]
+  next-feed = ["j", "ctrl-n"]
+  previous-feed = ["k", "ctrl-p"]
+  pull-all = ["ctrl-r"]
+  pull-current = ["r"]
+  mark-feed-read = ["R"]
+  focus-reading = []
+
+A key is either a single character, 'space', or the name of a special key, such
+as 'enter', 'esc', 'tab', 'pgdn', 'f1', or 'ctrl-r', which may be prefixed with
+modifiers such as 'alt-'. An action with no keys is unbound. A key can not be
+bound to more than one action of a pane, and keys of global actions can not be
+bound in any pane. Actions that are not listed keep their keys. The keys in use
+are shown in the help popup of the reader.
+
+` + fmtActions(),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
+			km, err := keymapFromViper(v, keysFileKey)
+			if err != nil {
+				return err
+			}
+
 			var (
-				err            error
 				connectAddr    net.Addr
 				connectTimeout time.Duration
 				ctx            = cmd.Context()
@@ -74,6 +105,7 @@ func newReaderCommand() *cobra.Command {
 				ConnectTimeout(connectTimeout).
 				Address(connectAddr.String()).
 				DialOpts(dialOpts...).
+				Keymap(km).
 				Build()
 
 			if err != nil {
@@ -103,6 +135,7 @@ func newReaderCommand() *cobra.Command {
 		`timeout for initial server connection, ignored if "-c" is unset`,
 	)
 	flags.StringP(dbPathKey, "d", defaultDBPath, `datastore location, ignored if "-c" is set`)
+	flags.String(keysFileKey, defaultKeysPath, "config file of reader key bindings")
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
@@ -111,6 +144,65 @@ func newReaderCommand() *cobra.Command {
 	return &command
 }
 
+// newReaderViper creates the viper of the reader command. The server that the reader starts runs
+// as the same user as the reader, so it may use local feeds.
+func newReaderViper(name string) *viper.Viper {
+	v := newViper(name)
+	v.SetDefault(allowLocalFeedsKey, true)
+	return v
+}
+
+// keymapFromViper creates the keymap of the reader from the default keys, and those of the config
+// file set by the given key, if any. The default config file is optional.
+func keymapFromViper(v *viper.Viper, fileKey string) (*keymap.Keymap, error) {
+	value := v.GetString(fileKey)
+	path, err := resolveConfigPath(value)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return keymap.Default(), nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) && value == defaultKeysPath {
+		return keymap.Default(), nil
+	}
+
+	kv := viper.New()
+	kv.SetConfigFile(path)
+	if err := kv.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("can not read keys file: %w", err)
+	}
+	var bindings map[string][]string
+	if err := kv.UnmarshalKey("keys", &bindings); err != nil {
+		return nil, fmt.Errorf("invalid keys file: %w", err)
+	}
+	km, err := keymap.Default().Override(bindings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keys file: %w", err)
+	}
+	return km, nil
+}
+
+// fmtActions lists the names of the reader actions with their default keys, by scope.
+func fmtActions() string {
+	var (
+		sb strings.Builder
+		km = keymap.Default()
+	)
+	sb.WriteString("The actions and their default keys are:\n")
+	for _, scope := range keymap.Scopes {
+		fmt.Fprintf(&sb, "\n  %s\n", scope)
+		for _, binding := range km.Bindings(scope) {
+			keys := make([]string, len(binding.Keys))
+			for i, k := range binding.Keys {
+				keys[i] = k.String()
+			}
+			fmt.Fprintf(&sb, "    %-26s %s\n", binding.Action, strings.Join(keys, ", "))
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
 func resolveAddr(
 	v *viper.Viper,
 	addrKey string,
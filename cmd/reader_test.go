@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bow/neon/api"
+	"github.com/bow/neon/internal/reader/keymap"
+)
+
+func TestReaderServerLocalFeeds(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	feedPath := filepath.Join(dir, "feed.xml")
+	contents := `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Local feed</title>
+    <link>https://a.com</link>
+    <description>Local feed</description>
+    <item>
+      <title>Entry 1</title>
+      <link>https://a.com/1</link>
+      <guid>https://a.com/1</guid>
+    </item>
+  </channel>
+</rss>
+`
+	r.NoError(os.WriteFile(feedPath, []byte(contents), 0o600))
+
+	// The server is built as the reader builds it, with the viper of the reader command.
+	v := newReaderViper("reader")
+	v.Set(dbPathKey, filepath.Join(dir, "neon.db"))
+	cmd := cobra.Command{}
+	cmd.SetContext(ctx)
+
+	srv, err := makeServer(&cmd, v, "tcp://127.0.0.1:0")
+	r.NoError(err)
+	go func() {
+		_ = srv.Serve(ctx)
+	}()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(
+		srv.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	r.NoError(err)
+	defer conn.Close()
+	client := api.NewNeonClient(conn)
+
+	_, err = client.AddFeed(ctx, &api.AddFeedRequest{Url: "file://" + filepath.ToSlash(feedPath)})
+	r.NoError(err)
+
+	stream, err := client.PullFeeds(ctx, &api.PullFeedsRequest{Force: true})
+	r.NoError(err)
+	rsp, err := stream.Recv()
+	r.NoError(err)
+	a.Empty(rsp.GetError())
+	a.NotEqual(api.PullFeedsResponse_FAIL, rsp.GetStatus())
+}
+
+func TestKeymapFromViper(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "keys.toml")
+	contents := `
+[keys]
+next-feed = ["j", "ctrl-n"]
+pull-all = "ctrl-r"
+pull-current = ["r"]
+mark-feed-read = ["R"]
+focus-reading = []
+`
+	r.NoError(os.WriteFile(path, []byte(contents), 0o600))
+
+	v := newViper("test")
+	v.Set("keys-file", path)
+
+	km, err := keymapFromViper(v, "keys-file")
+	r.NoError(err)
+
+	tests := []struct {
+		event  *tcell.EventKey
+		action keymap.Action
+	}{
+		{tcell.NewEventKey(tcell.KeyCtrlN, 0, tcell.ModCtrl), keymap.NextFeed},
+		{tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModCtrl), keymap.PullAll},
+		{tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone), keymap.PullCurrent},
+		{tcell.NewEventKey(tcell.KeyRune, 'R', tcell.ModNone), keymap.MarkFeedRead},
+		// Actions that are not listed keep their keys.
+		{tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone), keymap.AddFeed},
+	}
+	for _, test := range tests {
+		action, exists := km.Action(keymap.FeedsPane, test.event)
+		a.True(exists)
+		a.Equal(test.action, action)
+	}
+	a.Empty(km.Keys(keymap.FocusReading))
+}
+
+func TestKeymapFromViperNoFile(t *testing.T) {
+	t.Parallel()
+
+	v := newViper("test")
+	v.Set("keys-file", "")
+
+	km, err := keymapFromViper(v, "keys-file")
+	require.NoError(t, err)
+	assert.Equal(t, keymap.Default(), km)
+}
+
+func TestKeymapFromViperErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tests := []struct {
+		name     string
+		contents string
+		errMsg   string
+	}{
+		{
+			name:   "missing file",
+			errMsg: "can not read keys file",
+		},
+		{
+			name: "unknown action",
+			contents: `
+[keys]
+pull-everything = ["P"]
+`,
+			errMsg: `invalid keys file: action "pull-everything" does not exist`,
+		},
+		{
+			name: "conflict",
+			contents: `
+[keys]
+pull-all = ["p"]
+`,
+			errMsg: `invalid keys file: key "p" is bound to both "pull-current" and "pull-all"`,
+		},
+	}
+
+	for i, test := range tests {
+		path := filepath.Join(dir, fmt.Sprintf("keys%d.toml", i))
+		if test.contents != "" {
+			require.NoError(t, os.WriteFile(path, []byte(test.contents), 0o600))
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := newViper("test")
+			v.Set("keys-file", path)
+
+			km, err := keymapFromViper(v, "keys-file")
+			assert.Nil(t, km)
+			assert.ErrorContains(t, err, test.errMsg)
+		})
+	}
+}
@@ -37,6 +37,7 @@ func New() *cobra.Command {
 		},
 	}
 
+	command.AddCommand(newEntryCommand())
 	command.AddCommand(newFeedCommand())
 	command.AddCommand(newReaderCommand())
 	command.AddCommand(newServerCommand())
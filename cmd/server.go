@@ -6,6 +6,7 @@ package cmd
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	zlog "github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -52,6 +53,44 @@ func newServerCommand() *cobra.Command {
 	flags.BoolP(quietKey, "q", false, "hide startup banner")
 	flags.StringP(addrKey, "a", defaultServerAddr, "listening address")
 	flags.StringP(dbPathKey, "d", defaultDBPath, "datastore location")
+	flags.Duration(
+		pullIntervalKey,
+		1*time.Hour,
+		"default interval between scheduled feed pulls; 0 disables scheduled pulls",
+	)
+	flags.Int(
+		pullConcurrencyKey,
+		datastore.DefaultPullConcurrency,
+		"maximum number of feeds fetched concurrently when pulling",
+	)
+	flags.Int(
+		pullPerHostKey,
+		datastore.DefaultPullConcurrencyPerHost,
+		"maximum number of feeds fetched concurrently from the same host when pulling",
+	)
+	flags.Duration(
+		pullHostDelayKey,
+		datastore.DefaultPullHostDelay,
+		"minimum delay between requests to the same host when pulling",
+	)
+	flags.String(
+		websubAddrKey,
+		"",
+		"address of the WebSub callback server; empty disables WebSub push subscriptions",
+	)
+	flags.String(
+		websubURLKey,
+		"",
+		"public URL of the WebSub callback server; defaults to one derived from its address",
+	)
+	flags.Bool(
+		allowLocalFeedsKey,
+		false,
+		"allow adding, pulling, and discovering local feed files, commands, and mailboxes",
+	)
+	addRetentionFlags(flags)
+	addFetcherFlags(flags)
+	addResolverFlags(flags)
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
@@ -69,13 +108,35 @@ func makeServer(cmd *cobra.Command, v *viper.Viper, addr string) (*server.Server
 		return nil, err
 	}
 
-	srv, err := server.NewBuilder().
+	fetcherConfig, err := fetcherConfigFromViper(v)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers, err := resolversFromViper(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unset pull concurrencies are zero, for which the datastore defaults are used. The host
+	// delay is only set if given, as zero disables it.
+	builder := server.NewBuilder().
 		Context(cmd.Context()).
 		Address(addr).
 		SQLite(dbPath).
-		Build()
+		PullInterval(v.GetDuration(pullIntervalKey)).
+		PullConcurrency(v.GetInt(pullConcurrencyKey)).
+		PullConcurrencyPerHost(v.GetInt(pullPerHostKey)).
+		RetentionPolicy(retentionFromViper(v)).
+		FetcherConfig(fetcherConfig).
+		URLResolvers(resolvers).
+		AllowLocalFeeds(v.GetBool(allowLocalFeedsKey)).
+		WebSub(v.GetString(websubAddrKey), v.GetString(websubURLKey))
+	if v.IsSet(pullHostDelayKey) {
+		builder = builder.PullHostDelay(v.GetDuration(pullHostDelayKey))
+	}
 
-	return srv, err
+	return builder.Build()
 }
 
 // normalizeAddr ensures the specified address has either a 'tcp' or 'file' protocol. If the
@@ -29,6 +29,9 @@ type Datastore interface {
 		desc *string,
 		tags []string,
 		isStarred *bool,
+		creds *entity.FeedCredentials,
+		exec *entity.ExecOptions,
+		scrape *entity.ScrapeSpec,
 		pullTimeout *time.Duration,
 	) (
 		feed *entity.Feed,
@@ -36,6 +39,25 @@ type Datastore interface {
 		err error,
 	)
 
+	DiscoverFeeds(
+		ctx context.Context,
+		pageURL string,
+		timeout *time.Duration,
+	) (
+		candidates []*entity.FeedCandidate,
+		err error,
+	)
+
+	ScrapeFeed(
+		ctx context.Context,
+		pageURL string,
+		spec *entity.ScrapeSpec,
+		timeout *time.Duration,
+	) (
+		entries []*entity.Entry,
+		err error,
+	)
+
 	EditFeeds(
 		ctx context.Context,
 		ops []*entity.FeedEditOp,
@@ -58,6 +80,7 @@ type Datastore interface {
 		entryReadStatus *bool,
 		maxEntriesPerFeed *uint32,
 		timeoutPerFeed *time.Duration,
+		force bool,
 	) (
 		results <-chan entity.PullResult,
 	)
@@ -94,6 +117,34 @@ type Datastore interface {
 		err error,
 	)
 
+	ExtractEntryContent(
+		ctx context.Context,
+		id entity.ID,
+	) (
+		entry *entity.Entry,
+		err error,
+	)
+
+	SearchEntries(
+		ctx context.Context,
+		query string,
+		feedIDs []entity.ID,
+		tags []string,
+		maxResults *uint32,
+	) (
+		results []*entity.SearchResult,
+		err error,
+	)
+
+	PruneEntries(
+		ctx context.Context,
+		feedIDs []entity.ID,
+		dryRun bool,
+	) (
+		entries []*entity.Entry,
+		err error,
+	)
+
 	ExportSubscription(
 		ctx context.Context,
 		title *string,
@@ -117,6 +168,29 @@ type Datastore interface {
 		stats *entity.Stats,
 		err error,
 	)
+
+	ListWebSubSubscriptions(
+		ctx context.Context,
+	) (
+		subs []*entity.WebSubSubscription,
+		err error,
+	)
+
+	UpdateWebSubSubscription(
+		ctx context.Context,
+		sub *entity.WebSubSubscription,
+	) (
+		err error,
+	)
+
+	StorePushedFeed(
+		ctx context.Context,
+		feedID entity.ID,
+		content []byte,
+	) (
+		numAdded int,
+		err error,
+	)
 }
 
 func SetLogger(logger zerolog.Logger) {
@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// feedLinkFormats maps the media types of feed <link> elements to feed formats.
+var feedLinkFormats = map[string]string{
+	"application/rss+xml":   "rss",
+	"application/atom+xml":  "atom",
+	"application/feed+json": "json",
+}
+
+// wellKnownFeedPaths are tried, in order, when a page does not advertise any feed.
+var wellKnownFeedPaths = []string{
+	"/feed",
+	"/rss",
+	"/feed.xml",
+	"/rss.xml",
+	"/atom.xml",
+	"/index.xml",
+	"/feed.json",
+}
+
+// DiscoverFeeds satisfies the Parser interface.
+func (p *feedParser) DiscoverFeeds(
+	ctx context.Context,
+	pageURL string,
+) ([]*entity.FeedCandidate, error) {
+
+	if isMailboxURL(pageURL) {
+		return p.discoverMailboxFeeds(pageURL)
+	}
+
+	body, base, err := p.fetchPage(ctx, pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if feed, perr := p.Parse(bytes.NewReader(body)); perr == nil {
+		return []*entity.FeedCandidate{feedCandidate(base.String(), feed)}, nil
+	}
+
+	if cands := linkedFeeds(body, base); len(cands) > 0 {
+		return cands, nil
+	}
+
+	for _, path := range wellKnownFeedPaths {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		target := base.ResolveReference(&url.URL{Path: path}).String()
+		pbody, _, perr := p.fetchPage(ctx, target)
+		if perr != nil {
+			continue
+		}
+		if feed, perr := p.Parse(bytes.NewReader(pbody)); perr == nil {
+			return []*entity.FeedCandidate{feedCandidate(target, feed)}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// fetchPage returns the body of the given URL and the final URL after any redirects.
+func (p *feedParser) fetchPage(ctx context.Context, pageURL string) ([]byte, *url.URL, error) {
+
+	req, err := p.fetcher.newRequest(ctx, pageURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := p.fetcher.do(req, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, gofeed.HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+
+	body, err := p.fetcher.readBody(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, resp.Request.URL, nil
+}
+
+func feedCandidate(feedURL string, feed *gofeed.Feed) *entity.FeedCandidate {
+	return &entity.FeedCandidate{
+		URL:    feedURL,
+		Title:  pointerOrNil(feed.Title),
+		Format: pointerOrNil(feed.FeedType),
+	}
+}
+
+// linkedFeeds returns the feeds advertised by <link rel="alternate"> elements in the head of the
+// given HTML document, with their URLs resolved against the given base URL.
+func linkedFeeds(body []byte, base *url.URL) []*entity.FeedCandidate {
+
+	var (
+		cands []*entity.FeedCandidate
+		seen  = make(map[string]struct{})
+		z     = html.NewTokenizer(bytes.NewReader(body))
+	)
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return cands
+		case html.StartTagToken, html.SelfClosingTagToken:
+		default:
+			continue
+		}
+
+		tok := z.Token()
+		switch tok.DataAtom {
+		case atom.Body:
+			return cands
+		case atom.Base:
+			if href := attrValue(tok, "href"); href != "" {
+				if u, err := base.Parse(href); err == nil {
+					base = u
+				}
+			}
+		case atom.Link:
+			if !hasToken(attrValue(tok, "rel"), "alternate") {
+				continue
+			}
+			mediaType := strings.ToLower(strings.TrimSpace(attrValue(tok, "type")))
+			format, ok := feedLinkFormats[mediaType]
+			if !ok {
+				continue
+			}
+			href := strings.TrimSpace(attrValue(tok, "href"))
+			if href == "" {
+				continue
+			}
+			u, err := base.Parse(href)
+			if err != nil {
+				continue
+			}
+			feedURL := u.String()
+			// Web pages may not point to local feeds.
+			if isLocalFeedURL(feedURL) {
+				continue
+			}
+			if _, dup := seen[feedURL]; dup {
+				continue
+			}
+			seen[feedURL] = struct{}{}
+			cands = append(
+				cands,
+				&entity.FeedCandidate{
+					URL:    feedURL,
+					Title:  pointerOrNil(strings.TrimSpace(attrValue(tok, "title"))),
+					Format: pointer(format),
+				},
+			)
+		}
+	}
+}
+
+func attrValue(tok html.Token, key string) string {
+	for _, attr := range tok.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// hasToken checks whether the space-separated list contains the given token, ignoring case.
+func hasToken(list, token string) bool {
+	for _, item := range strings.Fields(list) {
+		if strings.EqualFold(item, token) {
+			return true
+		}
+	}
+	return false
+}
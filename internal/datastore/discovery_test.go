@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestFeedParserDiscoverFeedsLinked(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	const page = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>Blog</title>
+    <link rel="stylesheet" href="/style.css">
+    <link rel="alternate" type="application/rss+xml" title="Posts" href="/blog/rss.xml">
+    <link rel="Alternate" type="application/atom+xml" href="atom.xml" />
+    <link rel="alternate" type="application/rss+xml" href="/blog/rss.xml">
+    <link rel="alternate" type="application/json" href="/wp-json/">
+    <link rel="alternate" type="application/rss+xml" href="file:///etc/passwd">
+    <link rel="alternate" type="application/rss+xml" href="exec:cat /etc/passwd">
+  </head>
+  <body>
+    <link rel="alternate" type="application/feed+json" href="/ignored.json">
+  </body>
+</html>`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(page))
+	}))
+	defer srv.Close()
+
+	cands, err := newFeedParser(FetcherConfig{}).DiscoverFeeds(context.Background(), srv.URL+"/blog/")
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.FeedCandidate{
+			{URL: srv.URL + "/blog/rss.xml", Title: pointer("Posts"), Format: pointer("rss")},
+			{URL: srv.URL + "/blog/atom.xml", Format: pointer("atom")},
+		},
+		cands,
+	)
+}
+
+func TestFeedParserDiscoverFeedsSelf(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	cands, err := newFeedParser(FetcherConfig{}).DiscoverFeeds(context.Background(), srv.URL+"/feed")
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.FeedCandidate{
+			{URL: srv.URL + "/feed", Title: pointer("Feed A"), Format: pointer("rss")},
+		},
+		cands,
+	)
+}
+
+func TestFeedParserDiscoverFeedsWellKnownPath(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rss.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testRSS))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write([]byte(`<html><head><title>Home</title></head><body></body></html>`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	cands, err := newFeedParser(FetcherConfig{}).DiscoverFeeds(context.Background(), srv.URL)
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.FeedCandidate{
+			{URL: srv.URL + "/rss.xml", Title: pointer("Feed A"), Format: pointer("rss")},
+		},
+		cands,
+	)
+}
+
+func TestFeedParserDiscoverFeedsNone(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write([]byte(`<html><head><title>Home</title></head><body></body></html>`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	cands, err := newFeedParser(FetcherConfig{}).DiscoverFeeds(context.Background(), srv.URL)
+	r.NoError(err)
+	a.Empty(cands)
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ExtractContent satisfies the Parser interface.
+func (p *feedParser) ExtractContent(ctx context.Context, pageURL string) (string, error) {
+
+	req, err := p.fetcher.newRequest(ctx, pageURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.1")
+
+	resp, err := p.fetcher.do(req, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", gofeed.HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+
+	body, err := p.fetcher.readBody(resp)
+	if err != nil {
+		return "", err
+	}
+	r, err := charset.NewReader(bytes.NewReader(body), resp.Header.Get("Content-Type"))
+	if err != nil {
+		return "", err
+	}
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return "", err
+	}
+
+	content, ok := extractArticle(doc, resp.Request.URL)
+	if !ok {
+		return "", entity.NoArticleContentError{URL: pageURL}
+	}
+	return content, nil
+}
+
+// Minimum lengths of the text of extracted articles and of the paragraphs that count towards the
+// scores of their containers.
+const (
+	minArticleTextLen   = 140
+	minParagraphTextLen = 25
+)
+
+var (
+	// unlikelyCandidates matches the classes and IDs of page elements that rarely contain the main
+	// content, unless they also match maybeCandidates.
+	unlikelyCandidates = regexp.MustCompile(
+		`(?i)banner|breadcrumb|combx|comment|community|cookie|disqus|footer|header|menu|modal|` +
+			`nav|newsletter|pagination|popup|promo|related|remark|share|shoutbox|sidebar|` +
+			`skip|social|sponsor|subscribe|tags|toolbar|widget|ad-break|advert`,
+	)
+	maybeCandidates = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+
+	// positiveClasses and negativeClasses adjust the scores of elements by their classes and IDs.
+	positiveClasses = regexp.MustCompile(
+		`(?i)article|body|content|entry|hentry|h-entry|main|page|post|story|text|blog`,
+	)
+	negativeClasses = regexp.MustCompile(
+		`(?i)hidden|comment|footer|footnote|masthead|meta|outbrain|promo|related|scroll|share|` +
+			`shoutbox|sidebar|skyscraper|sponsor|shopping|tags|widget|byline|author`,
+	)
+)
+
+// nonContentSelector matches elements that are never part of the main content.
+const nonContentSelector = "script, style, noscript, template, iframe, form, button, input, " +
+	"select, textarea, nav, aside, footer, header, svg, canvas, object, embed, link, meta"
+
+// extractArticle returns the main content of the given page as HTML, using readability-style
+// heuristics: paragraphs score their containers by their length and commas, and the container
+// with the best score, adjusted for its link density, is the article. Siblings of the article
+// that look like parts of it are included too. Relative URLs are resolved against the given base
+// URL. It returns false if no content of a reasonable length is found.
+func extractArticle(doc *goquery.Document, base *url.URL) (string, bool) {
+
+	doc.Find(nonContentSelector).Remove()
+	doc.Find("*").Each(func(_ int, s *goquery.Selection) {
+		if goquery.NodeName(s) == "body" || goquery.NodeName(s) == "html" {
+			return
+		}
+		match := attrOrEmpty(s, "class") + " " + attrOrEmpty(s, "id")
+		if unlikelyCandidates.MatchString(match) && !maybeCandidates.MatchString(match) {
+			s.Remove()
+		}
+	})
+
+	// Candidates are kept in document order, so that ties are broken the same way every time.
+	var (
+		scores     = make(map[*html.Node]float64)
+		candidates []*html.Node
+	)
+	addScore := func(s *goquery.Selection, score float64) {
+		if s.Length() == 0 {
+			return
+		}
+		node := s.Get(0)
+		if _, exists := scores[node]; !exists {
+			scores[node] = initialScore(s)
+			candidates = append(candidates, node)
+		}
+		scores[node] += score
+	}
+	doc.Find("p, pre, td, blockquote").Each(func(_ int, s *goquery.Selection) {
+		text := scrapedText(s)
+		if len(text) < minParagraphTextLen {
+			return
+		}
+		score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(len(text))/100, 3)
+		addScore(s.Parent(), score)
+		addScore(s.Parent().Parent(), score/2)
+	})
+
+	var (
+		top      *html.Node
+		topScore float64
+	)
+	for _, node := range candidates {
+		score := scores[node] * (1 - linkDensity(goquery.NewDocumentFromNode(node).Selection))
+		scores[node] = score
+		if top == nil || score > topScore {
+			top, topScore = node, score
+		}
+	}
+	if top == nil {
+		return "", false
+	}
+
+	article := goquery.NewDocumentFromNode(top).Selection
+	parts := []*goquery.Selection{article}
+	if parent := article.Parent(); parent.Length() > 0 {
+		threshold := math.Max(10, topScore*0.2)
+		parts = parts[:0]
+		parent.Children().Each(func(_ int, s *goquery.Selection) {
+			if s.Get(0) == top || isArticleSibling(s, scores[s.Get(0)], threshold) {
+				parts = append(parts, s)
+			}
+		})
+	}
+
+	var (
+		sb      strings.Builder
+		textLen int
+	)
+	sb.WriteString("<div>")
+	for _, s := range parts {
+		cleanArticle(s, base)
+		textLen += len(scrapedText(s))
+		content, err := goquery.OuterHtml(s)
+		if err != nil {
+			return "", false
+		}
+		sb.WriteString(content)
+	}
+	sb.WriteString("</div>")
+
+	if textLen < minArticleTextLen {
+		return "", false
+	}
+	return sb.String(), true
+}
+
+// initialScore returns the score of an element before the paragraphs it contains are counted.
+func initialScore(s *goquery.Selection) float64 {
+	var score float64
+	switch goquery.NodeName(s) {
+	case "article", "main":
+		score = 10
+	case "div":
+		score = 5
+	case "pre", "td", "blockquote":
+		score = 3
+	case "address", "ol", "ul", "dl", "dd", "dt", "li":
+		score = -3
+	case "h1", "h2", "h3", "h4", "h5", "h6", "th":
+		score = -5
+	}
+	match := attrOrEmpty(s, "class") + " " + attrOrEmpty(s, "id")
+	if negativeClasses.MatchString(match) {
+		score -= 25
+	}
+	if positiveClasses.MatchString(match) {
+		score += 25
+	}
+	return score
+}
+
+// linkDensity returns the fraction of the text of the given element that is inside links.
+func linkDensity(s *goquery.Selection) float64 {
+	textLen := len(scrapedText(s))
+	if textLen == 0 {
+		return 0
+	}
+	var linkLen int
+	s.Find("a").Each(func(_ int, a *goquery.Selection) {
+		linkLen += len(scrapedText(a))
+	})
+	return math.Min(float64(linkLen)/float64(textLen), 1)
+}
+
+// isArticleSibling checks whether the given sibling of the article element is part of the
+// article, either by its own score or by looking like a paragraph of running text.
+func isArticleSibling(s *goquery.Selection, score, threshold float64) bool {
+	if score >= threshold {
+		return true
+	}
+	if goquery.NodeName(s) != "p" {
+		return false
+	}
+	text := scrapedText(s)
+	density := linkDensity(s)
+	switch {
+	case len(text) > 80:
+		return density < 0.25
+	case len(text) > 0:
+		return density == 0 && strings.ContainsAny(text, ".!?")
+	default:
+		return false
+	}
+}
+
+// keptAttrs are the attributes kept in extracted articles, by element.
+var keptAttrs = map[string][]string{
+	"a":      {"href", "title"},
+	"img":    {"src", "alt", "title"},
+	"source": {"src", "type"},
+	"video":  {"src", "poster"},
+	"audio":  {"src"},
+	"td":     {"colspan", "rowspan"},
+	"th":     {"colspan", "rowspan"},
+}
+
+// cleanArticle strips the given article element of presentational attributes, and resolves the
+// URLs of its links and media against the given base URL. The sources of lazily loaded images are
+// restored.
+func cleanArticle(s *goquery.Selection, base *url.URL) {
+	s.Find("*").AddSelection(s).Each(func(_ int, el *goquery.Selection) {
+		name := goquery.NodeName(el)
+		if name == "img" && attrOrEmpty(el, "src") == "" {
+			for _, attr := range []string{"data-src", "data-original", "data-lazy-src"} {
+				if value := attrOrEmpty(el, attr); value != "" {
+					el.SetAttr("src", value)
+					break
+				}
+			}
+		}
+		node := el.Get(0)
+		attrs := node.Attr[:0]
+		for _, attr := range node.Attr {
+			for _, kept := range keptAttrs[name] {
+				if attr.Key != kept {
+					continue
+				}
+				if attr.Key == "href" || attr.Key == "src" || attr.Key == "poster" {
+					attr.Val = resolveScrapedURL(strings.TrimSpace(attr.Val), base)
+				}
+				attrs = append(attrs, attr)
+			}
+		}
+		node.Attr = attrs
+	})
+}
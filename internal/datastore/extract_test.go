@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+const testArticlePage = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>Post A</title>
+    <script>var tracker = "nope";</script>
+  </head>
+  <body>
+    <header><a href="/">Home</a> <a href="/about">About</a></header>
+    <div class="sidebar">
+      <p>Subscribe to the newsletter, follow us, and read the related posts below.</p>
+    </div>
+    <div id="main" class="post-content" style="color: red">
+      <h1>Post A</h1>
+      <p>The first paragraph of the post, which is long enough to count, and has commas.</p>
+      <p>The second paragraph links to <a href="/posts/b" onclick="track()">another post</a>,
+        and keeps going for a while so that it also counts towards the score.</p>
+      <img data-src="/images/a.png" alt="A">
+      <p>The third paragraph, finally, wraps up the post with a few more words.</p>
+    </div>
+    <div class="comments">
+      <p>A comment that says something about the post, at some length, with commas.</p>
+    </div>
+    <footer>Copyright, all rights reserved, and so on, and so forth.</footer>
+  </body>
+</html>`
+
+func TestExtractArticle(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(testArticlePage))
+	r.NoError(err)
+	base, err := url.Parse("http://a.com/posts/a")
+	r.NoError(err)
+
+	content, ok := extractArticle(doc, base)
+	r.True(ok)
+
+	a.Contains(content, "The first paragraph of the post")
+	a.Contains(content, "The third paragraph")
+	a.Contains(content, `<a href="http://a.com/posts/b">another post</a>`)
+	a.Contains(content, `<img alt="A" src="http://a.com/images/a.png"/>`)
+	a.NotContains(content, "style=")
+	a.NotContains(content, "tracker")
+	a.NotContains(content, "newsletter")
+	a.NotContains(content, "A comment")
+	a.NotContains(content, "Copyright")
+}
+
+func TestExtractArticleTooShort(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+
+	page := `<html><body><div><p>Too short to be an article, even with commas.</p></div></body></html>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+	r.NoError(err)
+
+	_, ok := extractArticle(doc, nil)
+	r.False(ok)
+}
+
+func TestFeedParserExtractContent(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/posts/a":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(testArticlePage))
+		case "/empty":
+			_, _ = w.Write([]byte(`<html><body></body></html>`))
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer srv.Close()
+
+	p := newFeedParser(FetcherConfig{})
+
+	content, err := p.ExtractContent(context.Background(), srv.URL+"/posts/a")
+	r.NoError(err)
+	a.Contains(content, "The first paragraph of the post")
+	a.Contains(content, `href="`+srv.URL+`/posts/b"`)
+
+	_, err = p.ExtractContent(context.Background(), srv.URL+"/empty")
+	a.ErrorAs(err, &entity.NoArticleContentError{})
+
+	_, err = p.ExtractContent(context.Background(), srv.URL+"/missing")
+	a.Error(err)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/bow/neon/internal"
+	"github.com/bow/neon/internal/entity"
+)
+
+// DefaultMaxResponseSize is the default maximum size of fetched response bodies, local feed files,
+// and command outputs, in bytes.
+const DefaultMaxResponseSize int64 = 16 << 20
+
+// FetcherConfig configures the HTTP requests made for fetching feeds and web pages.
+type FetcherConfig struct {
+	// UserAgent is the User-Agent header of requests. If empty, DefaultUserAgent is used.
+	UserAgent string
+	// Proxy is the URL of the HTTP, HTTPS, or SOCKS5 proxy requests are sent through. If nil, the
+	// proxy is taken from the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables.
+	Proxy *url.URL
+	// Headers are added to all requests, overriding the default headers.
+	Headers http.Header
+	// MaxResponseSize is the maximum size of response bodies, in bytes. If zero,
+	// DefaultMaxResponseSize is used.
+	MaxResponseSize int64
+}
+
+// DefaultUserAgent returns the default User-Agent header of requests.
+func DefaultUserAgent() string {
+	version := internal.Version()
+	if version == "" {
+		version = "dev"
+	}
+	return fmt.Sprintf("%s/%s (+%s)", internal.AppName(), version, internal.AppHomepage())
+}
+
+// ResponseTooLargeError is returned when a response body exceeds the maximum response size.
+type ResponseTooLargeError struct{ Limit int64 }
+
+func (e ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds %d bytes", e.Limit)
+}
+
+// fetcher sends the HTTP requests of the parser.
+type fetcher struct {
+	client    *http.Client
+	userAgent string
+	headers   http.Header
+	maxSize   int64
+}
+
+func newFetcher(cfg FetcherConfig) *fetcher {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Proxy != nil {
+		transport.Proxy = http.ProxyURL(cfg.Proxy)
+	}
+	f := fetcher{
+		client:    &http.Client{Transport: transport},
+		userAgent: cfg.UserAgent,
+		headers:   cfg.Headers.Clone(),
+		maxSize:   cfg.MaxResponseSize,
+	}
+	if f.userAgent == "" {
+		f.userAgent = DefaultUserAgent()
+	}
+	if f.maxSize <= 0 {
+		f.maxSize = DefaultMaxResponseSize
+	}
+	return &f
+}
+
+// newRequest creates a GET request for the given URL with the configured headers, authenticated
+// with the given credentials, if any.
+func (f *fetcher) newRequest(
+	ctx context.Context,
+	rawURL string,
+	creds *entity.FeedCredentials,
+) (*http.Request, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.userAgent)
+	for key, values := range f.headers {
+		req.Header[http.CanonicalHeaderKey(key)] = values
+	}
+
+	if creds != nil {
+		switch {
+		case creds.Token != nil:
+			req.Header.Set("Authorization", "Bearer "+*creds.Token)
+		case creds.Username != nil:
+			req.SetBasicAuth(*creds.Username, deref(creds.Password, ""))
+		}
+	}
+
+	return req, nil
+}
+
+// do sends the given request. If a tracker is given, it tracks the permanent redirects of the
+// request.
+func (f *fetcher) do(req *http.Request, tracker *redirectTracker) (*http.Response, error) {
+	client := *f.client
+	if tracker != nil {
+		client.CheckRedirect = tracker.checkRedirect(client.CheckRedirect)
+	}
+	return client.Do(req)
+}
+
+// readBody reads the body of the given response, failing if it exceeds the maximum size.
+func (f *fetcher) readBody(resp *http.Response) ([]byte, error) {
+	if resp.ContentLength > f.maxSize {
+		return nil, ResponseTooLargeError{Limit: f.maxSize}
+	}
+	return f.readAll(resp.Body)
+}
+
+// readAll reads the given reader until EOF, failing if its contents exceed the maximum size.
+func (f *fetcher) readAll(r io.Reader) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r, f.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > f.maxSize {
+		return nil, ResponseTooLargeError{Limit: f.maxSize}
+	}
+	return body, nil
+}
+
+// maxRedirects is the number of redirects followed for a single request, matching the default of
+// the net/http package.
+const maxRedirects = 10
+
+// redirectTracker tracks the URL a request has permanently moved to, through the leading
+// permanent redirects of its redirect chain.
+type redirectTracker struct {
+	permanentURL *string
+	temporary    bool
+}
+
+// checkRedirect returns a redirect policy for http.Client that tracks redirects before applying
+// the given policy.
+func (rt *redirectTracker) checkRedirect(
+	next func(*http.Request, []*http.Request) error,
+) func(*http.Request, []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if !rt.temporary && req.Response != nil {
+			switch req.Response.StatusCode {
+			case http.StatusMovedPermanently, http.StatusPermanentRedirect:
+				rt.permanentURL = pointer(req.URL.String())
+			default:
+				rt.temporary = true
+			}
+		}
+		if next != nil {
+			return next(req, via)
+		}
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		return nil
+	}
+}
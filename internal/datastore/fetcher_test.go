@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestFetcherHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cfg      FetcherConfig
+		creds    *entity.FeedCredentials
+		wantUA   string
+		wantAuth string
+		wantX    string
+	}{
+		{
+			name:   "default",
+			wantUA: DefaultUserAgent(),
+		},
+		{
+			name: "configured",
+			cfg: FetcherConfig{
+				UserAgent: "custom/1.0",
+				Headers:   http.Header{"X-Custom": {"yes"}},
+			},
+			wantUA: "custom/1.0",
+			wantX:  "yes",
+		},
+		{
+			name:     "basic auth",
+			creds:    &entity.FeedCredentials{Username: pointer("u"), Password: pointer("p")},
+			wantUA:   DefaultUserAgent(),
+			wantAuth: "Basic dTpw",
+		},
+		{
+			name:     "bearer token",
+			creds:    &entity.FeedCredentials{Token: pointer("t0k")},
+			wantUA:   DefaultUserAgent(),
+			wantAuth: "Bearer t0k",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := assert.New(t)
+			r := require.New(t)
+
+			var got http.Header
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				got = req.Header.Clone()
+				_, _ = w.Write([]byte(testRSS))
+			}))
+			defer srv.Close()
+
+			_, _, err := newFeedParser(test.cfg).ParseURLIfModified(
+				context.Background(),
+				srv.URL,
+				test.creds,
+				nil,
+			)
+			r.NoError(err)
+
+			a.Equal(test.wantUA, got.Get("User-Agent"))
+			a.Equal(test.wantAuth, got.Get("Authorization"))
+			a.Equal(test.wantX, got.Get("X-Custom"))
+		})
+	}
+}
+
+func TestFetcherProxy(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	var gotURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotURL = req.URL.String()
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	r.NoError(err)
+
+	feed, _, err := newFeedParser(FetcherConfig{Proxy: proxyURL}).ParseURLIfModified(
+		context.Background(),
+		"http://feeds.example.com/feed.xml",
+		nil,
+		nil,
+	)
+	r.NoError(err)
+	r.NotNil(feed)
+
+	a.Equal("http://feeds.example.com/feed.xml", gotURL)
+}
+
+func TestFetcherErrResponseTooLarge(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// Flushing forces a chunked response, so the size is not known in advance.
+		_, _ = w.Write([]byte(strings.Repeat(" ", 64)))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	feed, cache, err := newFeedParser(FetcherConfig{MaxResponseSize: 128}).ParseURLIfModified(
+		context.Background(),
+		srv.URL,
+		nil,
+		nil,
+	)
+
+	a.Nil(feed)
+	a.Nil(cache)
+	a.ErrorIs(err, ResponseTooLargeError{Limit: 128})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultPullConcurrencyPerHost is the default maximum number of feeds fetched concurrently
+	// from the same host by PullFeeds.
+	DefaultPullConcurrencyPerHost = 2
+	// DefaultPullHostDelay is the default minimum delay between the start of consecutive requests
+	// to the same host by PullFeeds.
+	DefaultPullHostDelay = 500 * time.Millisecond
+)
+
+// hostLimiter limits the number of concurrent requests to each host, and spaces the requests to
+// each host apart by a minimum delay.
+type hostLimiter struct {
+	maxConcurrent int
+	minDelay      time.Duration
+
+	mu    sync.Mutex
+	hosts map[string]*hostSlots
+}
+
+// hostSlots is the request state of a single host.
+type hostSlots struct {
+	sem chan struct{}
+	// next is the earliest time the next request to the host may start.
+	next time.Time
+}
+
+func newHostLimiter(maxConcurrent int, minDelay time.Duration) *hostLimiter {
+	return &hostLimiter{
+		maxConcurrent: max(maxConcurrent, 1),
+		minDelay:      max(minDelay, 0),
+		hosts:         make(map[string]*hostSlots),
+	}
+}
+
+// acquire blocks until a request to the given host may start, and returns how long it was blocked
+// for, along with the function that must be called once the request is done. Requests without a
+// host, such as those for local files, are not limited.
+func (l *hostLimiter) acquire(ctx context.Context, host string) (time.Duration, func(), error) {
+
+	if host == "" {
+		return 0, func() {}, nil
+	}
+
+	start := time.Now()
+
+	l.mu.Lock()
+	slots, ok := l.hosts[host]
+	if !ok {
+		slots = &hostSlots{sem: make(chan struct{}, l.maxConcurrent)}
+		l.hosts[host] = slots
+	}
+	l.mu.Unlock()
+
+	var waited time.Duration
+	select {
+	case slots.sem <- struct{}{}:
+	default:
+		select {
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		case slots.sem <- struct{}{}:
+		}
+		waited = time.Since(start)
+	}
+	release := func() { <-slots.sem }
+
+	l.mu.Lock()
+	now := time.Now()
+	startAt := now
+	if slots.next.After(now) {
+		startAt = slots.next
+	}
+	prev := slots.next
+	reserved := startAt.Add(l.minDelay)
+	slots.next = reserved
+	l.mu.Unlock()
+
+	if wait := startAt.Sub(now); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			// Give the reserved start time back, unless later requests have already been
+			// spaced after it.
+			l.mu.Lock()
+			if slots.next.Equal(reserved) {
+				slots.next = prev
+			}
+			l.mu.Unlock()
+			release()
+			return 0, nil, ctx.Err()
+		case <-timer.C:
+		}
+		waited = time.Since(start)
+	}
+
+	return waited, release, nil
+}
+
+// urlHost returns the lowercased host of the given URL, including its port, or an empty string if
+// the URL has no host.
+func urlHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Host)
+}
+
+// interleaveByHost reorders the given pull keys so that feeds of the same host are spread out as
+// much as possible, while keeping the relative order of feeds of each host. This keeps workers
+// busy with other hosts while a host is being throttled.
+func interleaveByHost(pks []pullKey) []pullKey {
+
+	var (
+		hosts  []string
+		groups = make(map[string][]pullKey)
+	)
+	for _, pk := range pks {
+		host := urlHost(pk.feedURL)
+		if _, seen := groups[host]; !seen {
+			hosts = append(hosts, host)
+		}
+		groups[host] = append(groups[host], pk)
+	}
+
+	interleaved := make([]pullKey, 0, len(pks))
+	for i := 0; len(interleaved) < len(pks); i++ {
+		for _, host := range hosts {
+			if group := groups[host]; i < len(group) {
+				interleaved = append(interleaved, group[i])
+			}
+		}
+	}
+
+	return interleaved
+}
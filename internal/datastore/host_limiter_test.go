@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostLimiterConcurrency(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	l := newHostLimiter(2, 0)
+
+	_, release1, err := l.acquire(context.Background(), "a.com")
+	r.NoError(err)
+	_, release2, err := l.acquire(context.Background(), "a.com")
+	r.NoError(err)
+
+	// Other hosts are not affected.
+	_, release3, err := l.acquire(context.Background(), "b.com")
+	r.NoError(err)
+	release3()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, _, err = l.acquire(ctx, "a.com")
+	a.ErrorIs(err, context.DeadlineExceeded)
+
+	release1()
+	waited, release4, err := l.acquire(context.Background(), "a.com")
+	r.NoError(err)
+	a.Less(waited, 20*time.Millisecond)
+
+	release2()
+	release4()
+}
+
+func TestHostLimiterDelay(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	l := newHostLimiter(5, 30*time.Millisecond)
+
+	waited, release, err := l.acquire(context.Background(), "a.com")
+	r.NoError(err)
+	release()
+	a.Less(waited, 30*time.Millisecond)
+
+	waited, release, err = l.acquire(context.Background(), "a.com")
+	r.NoError(err)
+	release()
+	a.GreaterOrEqual(waited, 20*time.Millisecond)
+
+	// Requests without a host are not limited.
+	for range 3 {
+		waited, release, err = l.acquire(context.Background(), "")
+		r.NoError(err)
+		release()
+		a.Zero(waited)
+	}
+}
+
+func TestHostLimiterDelayCancelled(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	l := newHostLimiter(5, 100*time.Millisecond)
+
+	_, release, err := l.acquire(context.Background(), "a.com")
+	r.NoError(err)
+	release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = l.acquire(ctx, "a.com")
+	a.ErrorIs(err, context.DeadlineExceeded)
+
+	// The cancelled request does not push back the next one.
+	waited, release, err := l.acquire(context.Background(), "a.com")
+	r.NoError(err)
+	release()
+	a.Less(waited, 150*time.Millisecond)
+}
+
+func TestInterleaveByHost(t *testing.T) {
+	t.Parallel()
+
+	pks := []pullKey{
+		{feedID: 1, feedURL: "https://a.com/1.xml"},
+		{feedID: 2, feedURL: "https://A.com/2.xml"},
+		{feedID: 3, feedURL: "https://a.com/3.xml"},
+		{feedID: 4, feedURL: "https://b.com/feed.xml"},
+		{feedID: 5, feedURL: "https://c.com/feed.xml"},
+		{feedID: 6, feedURL: "https://b.com/other.xml"},
+	}
+
+	got := make([]ID, 0, len(pks))
+	for _, pk := range interleaveByHost(pks) {
+		got = append(got, pk.feedID)
+	}
+
+	assert.Equal(t, []ID{1, 4, 5, 2, 6, 3}, got)
+}
@@ -0,0 +1,483 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html/charset"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// mailFeedType is the gofeed feed type of feeds of email messages.
+const mailFeedType = "email"
+
+// mailboxSource is a local mailbox, and the sender group of a feed of its messages.
+type mailboxSource struct {
+	scheme string
+	path   string
+	group  string
+}
+
+// isMailboxURL checks whether the given URL points to a local mailbox.
+func isMailboxURL(feedURL string) bool {
+	u, err := url.Parse(feedURL)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, entity.MaildirScheme) ||
+		strings.EqualFold(u.Scheme, entity.MboxScheme)
+}
+
+// parseMailboxURL returns the mailbox of the given maildir: or mbox: URL.
+func parseMailboxURL(mailboxURL string) (*mailboxSource, error) {
+
+	u, err := url.Parse(mailboxURL)
+	if err != nil {
+		return nil, err
+	}
+	if host := u.Hostname(); host != "" && host != "localhost" {
+		return nil, entity.InvalidFeedSourceError{
+			Reason: fmt.Sprintf("mailbox URL host %q is not local", host),
+		}
+	}
+	if u.Path == "" {
+		return nil, entity.InvalidFeedSourceError{Reason: "mailbox URL has no path"}
+	}
+
+	src := mailboxSource{
+		scheme: strings.ToLower(u.Scheme),
+		path:   filepath.FromSlash(u.Path),
+		group:  strings.ToLower(strings.TrimSpace(u.Query().Get("group"))),
+	}
+	return &src, nil
+}
+
+// parseMailboxIfModified turns the messages of the sender group of the given mailbox URL into a
+// feed, only if messages have been added to or removed from the mailbox since the given cache
+// state was recorded.
+func (p *feedParser) parseMailboxIfModified(
+	mailboxURL string,
+	cache *FetchCache,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	src, err := parseMailboxURL(mailboxURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if src.group == "" {
+		return nil, nil, entity.InvalidFeedSourceError{Reason: "mailbox URL has no sender group"}
+	}
+
+	var known *string
+	if cache != nil {
+		known = cache.ContentHash
+	}
+	msgs, digest, err := p.readMailbox(src, known)
+	if err != nil {
+		return nil, nil, err
+	}
+	newCache := FetchCache{ContentHash: &digest}
+	if known != nil && *known == digest {
+		return nil, &newCache, nil
+	}
+
+	feed := gofeed.Feed{
+		Title:       src.group,
+		Description: fmt.Sprintf("Email from %s", src.group),
+		FeedType:    mailFeedType,
+		Items:       make([]*gofeed.Item, 0),
+	}
+	var latest time.Time
+	for _, msg := range msgs {
+		group, name := mailGroup(msg.Header)
+		if group != src.group {
+			continue
+		}
+		item := mailItem(msg)
+		feed.Items = append(feed.Items, item)
+		// The feed is named after the most recent name of the group.
+		if date := item.PublishedParsed; name != "" && (date == nil || !date.Before(latest)) {
+			feed.Title = name
+			if date != nil {
+				latest = *date
+			}
+		}
+	}
+
+	return &feed, &newCache, nil
+}
+
+// discoverMailboxFeeds returns the feeds of each sender group of the given mailbox.
+func (p *feedParser) discoverMailboxFeeds(mailboxURL string) ([]*entity.FeedCandidate, error) {
+
+	src, err := parseMailboxURL(mailboxURL)
+	if err != nil {
+		return nil, err
+	}
+	msgs, _, err := p.readMailbox(src, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		groups = make([]string, 0)
+		names  = make(map[string]string)
+	)
+	for _, msg := range msgs {
+		group, name := mailGroup(msg.Header)
+		if group == "" {
+			continue
+		}
+		if _, seen := names[group]; !seen {
+			groups = append(groups, group)
+		}
+		if name != "" || names[group] == "" {
+			names[group] = name
+		}
+	}
+	if src.group != "" && slices.Contains(groups, src.group) {
+		groups = []string{src.group}
+	}
+	slices.Sort(groups)
+
+	cands := make([]*entity.FeedCandidate, 0, len(groups))
+	for _, group := range groups {
+		cands = append(cands, &entity.FeedCandidate{
+			URL:    entity.MailboxURL(src.scheme, src.path, group),
+			Title:  pointerOrNil(names[group]),
+			Format: pointer(mailFeedType),
+		})
+	}
+
+	return cands, nil
+}
+
+// readMailbox returns the parsed messages of the given mailbox, along with a digest that changes
+// whenever messages are added to or removed from it. If the digest matches the given known
+// digest, the messages are not read. Messages that cannot be read or parsed are skipped.
+func (p *feedParser) readMailbox(
+	src *mailboxSource,
+	known *string,
+) ([]*mail.Message, string, error) {
+
+	var (
+		raws   [][]byte
+		digest string
+		err    error
+	)
+	switch src.scheme {
+	case entity.MaildirScheme:
+		raws, digest, err = p.readMaildir(src.path, known)
+	default:
+		raws, digest, err = p.readMbox(src.path, known)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	msgs := make([]*mail.Message, 0, len(raws))
+	for _, raw := range raws {
+		msg, err := mail.ReadMessage(bytes.NewReader(raw))
+		if err != nil {
+			pkgLogger.Warn().Err(err).Str("mailbox", src.path).Msg("skipped unparsable message")
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, digest, nil
+}
+
+// readMaildir returns the raw messages of the given Maildir directory. Its digest is computed from
+// the unique names of its messages, which do not change when the messages are flagged as read.
+func (p *feedParser) readMaildir(dir string, known *string) ([][]byte, string, error) {
+
+	var (
+		paths []string
+		keys  []string
+	)
+	for _, sub := range []string{"new", "cur"} {
+		entries, err := os.ReadDir(filepath.Join(dir, sub))
+		if err != nil {
+			return nil, "", err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			paths = append(paths, filepath.Join(dir, sub, entry.Name()))
+			key, _, _ := strings.Cut(entry.Name(), ":")
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	digest := hex.EncodeToString(sum[:])
+	if known != nil && *known == digest {
+		return nil, digest, nil
+	}
+
+	raws := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		raw, err := p.readMailFile(path)
+		if err != nil {
+			// Messages may be moved from new/ to cur/ while they are read.
+			pkgLogger.Warn().Err(err).Str("path", path).Msg("skipped unreadable message")
+			continue
+		}
+		raws = append(raws, raw)
+	}
+
+	return raws, digest, nil
+}
+
+func (p *feedParser) readMailFile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return p.fetcher.readAll(f)
+}
+
+// readMbox returns the raw messages of the given mbox file. Its digest is computed from the size
+// and modification time of the file, so that unchanged files are not read. Lines of message
+// bodies quoted with ">" in the mboxrd format are unquoted, and messages larger than the maximum
+// response size are skipped.
+func (p *feedParser) readMbox(path string, known *string) ([][]byte, string, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, "", err
+	}
+	digest := fmt.Sprintf("mbox:%d:%d", info.Size(), info.ModTime().UnixNano())
+	if known != nil && *known == digest {
+		return nil, digest, nil
+	}
+
+	var (
+		raws      [][]byte
+		cur       *bytes.Buffer
+		tooLarge  bool
+		prevBlank = true
+		r         = bufio.NewReader(f)
+		fromLine  = []byte("From ")
+	)
+	flush := func() {
+		if cur != nil && !tooLarge {
+			raws = append(raws, cur.Bytes())
+		}
+	}
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			switch {
+			case prevBlank && bytes.HasPrefix(line, fromLine):
+				flush()
+				cur, tooLarge = &bytes.Buffer{}, false
+			case cur != nil && !tooLarge:
+				if unquoted := bytes.TrimLeft(line, ">"); len(unquoted) < len(line) &&
+					bytes.HasPrefix(unquoted, fromLine) {
+					line = line[1:]
+				}
+				if int64(cur.Len()+len(line)) > p.fetcher.maxSize {
+					pkgLogger.Warn().Str("mbox", path).Msg("skipped message that is too large")
+					tooLarge = true
+					break
+				}
+				cur.Write(line)
+			}
+			prevBlank = len(bytes.TrimRight(line, "\r\n")) == 0
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	flush()
+
+	return raws, digest, nil
+}
+
+// mailWordDecoder decodes RFC 2047 encoded words in any charset known to the html package.
+var mailWordDecoder = &mime.WordDecoder{CharsetReader: charset.NewReaderLabel}
+
+// mailAddressParser parses addresses whose names are RFC 2047 encoded words in any charset.
+var mailAddressParser = &mail.AddressParser{WordDecoder: mailWordDecoder}
+
+// decodeMailHeader returns the given header value with its encoded words decoded.
+func decodeMailHeader(value string) string {
+	decoded, err := mailWordDecoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+// mailGroup returns the sender group of the message with the given header, which is the ID of
+// its mailing list or, failing that, the address of its sender, and the name of the group.
+func mailGroup(header mail.Header) (group, name string) {
+
+	if listID := decodeMailHeader(header.Get("List-Id")); listID != "" {
+		start, end := strings.LastIndex(listID, "<"), strings.LastIndex(listID, ">")
+		if start >= 0 && end > start {
+			group = listID[start+1 : end]
+			name = strings.Trim(strings.TrimSpace(listID[:start]), `"`)
+		} else {
+			group = listID
+		}
+		if group = strings.ToLower(strings.TrimSpace(group)); group != "" {
+			return group, name
+		}
+	}
+
+	from, err := mailAddressParser.Parse(header.Get("From"))
+	if err != nil {
+		return "", ""
+	}
+	return strings.ToLower(from.Address), from.Name
+}
+
+// mailItem maps the given message to a feed item. The Message-ID is the item GUID, the subject is
+// its title, and the HTML or, failing that, the text body of the message is its content.
+func mailItem(msg *mail.Message) *gofeed.Item {
+
+	header := msg.Header
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		body = nil
+	}
+
+	item := gofeed.Item{
+		Title: decodeMailHeader(header.Get("Subject")),
+		GUID:  strings.Trim(strings.TrimSpace(header.Get("Message-Id")), "<>"),
+		Link:  mailArchiveURL(header.Get("Archived-At")),
+	}
+	if item.GUID == "" {
+		seed := header.Get("From") + "\n" + header.Get("Date") + "\n" + header.Get("Subject") + "\n"
+		sum := sha256.Sum256(append([]byte(seed), body...))
+		item.GUID = "mail:" + hex.EncodeToString(sum[:16])
+	}
+	if date, err := header.Date(); err == nil {
+		item.PublishedParsed = &date
+		item.Published = date.Format(time.RFC1123Z)
+	}
+	if from, err := mailAddressParser.Parse(header.Get("From")); err == nil {
+		item.Authors = []*gofeed.Person{{Name: from.Name, Email: from.Address}}
+	}
+
+	htmlBody, textBody := mailBody(header, body)
+	switch {
+	case htmlBody != "":
+		item.Content = htmlBody
+	case textBody != "":
+		item.Content = "<pre>" + html.EscapeString(textBody) + "</pre>"
+	}
+
+	return &item
+}
+
+// mailArchiveURL returns the web URL of an Archived-At header value, if it has one.
+func mailArchiveURL(value string) string {
+	value = strings.Trim(strings.TrimSpace(value), "<>")
+	if u, err := url.Parse(value); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return value
+	}
+	return ""
+}
+
+// mimeHeader is the header of a message or of one of its MIME parts.
+type mimeHeader interface {
+	Get(key string) string
+}
+
+// mailBody returns the first HTML and the first text body of a message or MIME part with the given
+// header, decoded to UTF-8. Attachments are ignored.
+func mailBody(header mimeHeader, body []byte) (htmlBody, textBody string) {
+
+	if strings.HasPrefix(strings.ToLower(header.Get("Content-Disposition")), "attachment") {
+		return "", ""
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", nil
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if err != nil {
+				break
+			}
+			partBody, err := io.ReadAll(part)
+			if err != nil {
+				break
+			}
+			partHTML, partText := mailBody(part.Header, partBody)
+			if htmlBody == "" {
+				htmlBody = partHTML
+			}
+			if textBody == "" {
+				textBody = partText
+			}
+		}
+		return htmlBody, textBody
+	}
+
+	if mediaType != "text/html" && mediaType != "text/plain" {
+		return "", ""
+	}
+
+	var r io.Reader = bytes.NewReader(body)
+	switch strings.ToLower(strings.TrimSpace(header.Get("Content-Transfer-Encoding"))) {
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+	if label := params["charset"]; label != "" {
+		if cr, err := charset.NewReaderLabel(label, r); err == nil {
+			r = cr
+		}
+	}
+	decoded, err := io.ReadAll(r)
+	if err != nil {
+		return "", ""
+	}
+
+	if mediaType == "text/html" {
+		return strings.TrimSpace(string(decoded)), ""
+	}
+	return "", strings.TrimSpace(string(decoded))
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+const testMailIssue1 = `From: "Weekly News" <news@weekly.example.com>
+To: me@example.com
+Subject: =?UTF-8?Q?Issue_#1_=E2=80=94_Hello?=
+Date: Mon, 12 Oct 2026 08:00:00 +0000
+Message-ID: <issue1@weekly.example.com>
+List-Id: Weekly News <weekly.example.com>
+Archived-At: <https://weekly.example.com/1>
+MIME-Version: 1.0
+Content-Type: multipart/alternative; boundary="b1"
+
+--b1
+Content-Type: text/plain; charset=utf-8
+
+Hello in text
+--b1
+Content-Type: text/html; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+<p>Hello =E2=80=94 in HTML</p>
+--b1--
+`
+
+// testMailIssue2 has a base64-encoded Latin-1 body, "Café <b>".
+const testMailIssue2 = `From: news@weekly.example.com
+Subject: Issue 2
+Date: Mon, 19 Oct 2026 08:00:00 +0000
+Message-ID: <issue2@weekly.example.com>
+List-Id: <Weekly.Example.com>
+Content-Type: text/plain; charset=iso-8859-1
+Content-Transfer-Encoding: base64
+
+Q2Fm6SA8Yj4=
+`
+
+const testMailAlice = `From: Alice <alice@example.com>
+Subject: Letter
+Date: Tue, 13 Oct 2026 10:00:00 +0000
+
+From the desk of Alice.
+`
+
+func writeTestMaildir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for _, sub := range []string{"cur", "new", "tmp"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, sub), 0o700))
+	}
+	for name, msg := range map[string]string{
+		"new/1000.a.host":     testMailIssue1,
+		"cur/1001.b.host:2,S": testMailIssue2,
+		"new/1002.c.host":     testMailAlice,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(msg), 0o600))
+	}
+
+	return dir
+}
+
+func TestFeedParserParseMaildir(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	dir := writeTestMaildir(t)
+	p := newFeedParser(FetcherConfig{})
+	feedURL := entity.MailboxURL(entity.MaildirScheme, dir, "weekly.example.com")
+
+	feed, cache, err := p.ParseURLIfModified(context.Background(), feedURL, nil, nil)
+	r.NoError(err)
+	r.NotNil(feed)
+	r.NotNil(cache.ContentHash)
+
+	a.Equal("Weekly News", feed.Title)
+	r.Len(feed.Items, 2)
+	items := feed.Items
+	if items[0].GUID != "issue1@weekly.example.com" {
+		items[0], items[1] = items[1], items[0]
+	}
+
+	a.Equal("issue1@weekly.example.com", items[0].GUID)
+	a.Equal("Issue #1 — Hello", items[0].Title)
+	a.Equal("https://weekly.example.com/1", items[0].Link)
+	a.Equal("<p>Hello — in HTML</p>", items[0].Content)
+	a.Equal(time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC), items[0].PublishedParsed.UTC())
+	r.Len(items[0].Authors, 1)
+	a.Equal("Weekly News", items[0].Authors[0].Name)
+	a.Equal("news@weekly.example.com", items[0].Authors[0].Email)
+
+	a.Equal("issue2@weekly.example.com", items[1].GUID)
+	a.Equal("Issue 2", items[1].Title)
+	a.Equal("<pre>Café &lt;b&gt;</pre>", items[1].Content)
+
+	// Reading messages, which renames them, does not change the mailbox.
+	r.NoError(os.Rename(
+		filepath.Join(dir, "new", "1000.a.host"),
+		filepath.Join(dir, "cur", "1000.a.host:2,S"),
+	))
+	feed, ncache, err := p.ParseURLIfModified(context.Background(), feedURL, nil, cache)
+	r.NoError(err)
+	a.Nil(feed)
+	a.Equal(cache.ContentHash, ncache.ContentHash)
+
+	// New messages do.
+	r.NoError(os.WriteFile(filepath.Join(dir, "new", "1003.d.host"), []byte(testMailAlice), 0o600))
+	feed, ncache, err = p.ParseURLIfModified(context.Background(), feedURL, nil, cache)
+	r.NoError(err)
+	a.NotNil(feed)
+	a.NotEqual(cache.ContentHash, ncache.ContentHash)
+}
+
+func TestFeedParserParseMbox(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	// Lines starting with "From " are quoted in message bodies.
+	mbox := "From alice@example.com Tue Oct 13 10:00:00 2026\n" +
+		strings.Replace(testMailAlice, "\nFrom the desk", "\n>From the desk", 1) +
+		">>From the archive.\n\n" +
+		"From news@weekly.example.com Mon Oct 12 08:00:00 2026\n" + testMailIssue1 + "\n"
+	path := filepath.Join(t.TempDir(), "inbox.mbox")
+	r.NoError(os.WriteFile(path, []byte(mbox), 0o600))
+
+	p := newFeedParser(FetcherConfig{})
+	feedURL := entity.MailboxURL(entity.MboxScheme, path, "alice@example.com")
+
+	feed, cache, err := p.ParseURLIfModified(context.Background(), feedURL, nil, nil)
+	r.NoError(err)
+	r.NotNil(feed)
+
+	a.Equal("Alice", feed.Title)
+	r.Len(feed.Items, 1)
+	a.Equal("Letter", feed.Items[0].Title)
+	a.Equal("<pre>From the desk of Alice.\n&gt;From the archive.</pre>", feed.Items[0].Content)
+	// Messages without a Message-ID get a GUID derived from their contents.
+	a.Regexp("^mail:[0-9a-f]{32}$", feed.Items[0].GUID)
+
+	feed, _, err = p.ParseURLIfModified(context.Background(), feedURL, nil, cache)
+	r.NoError(err)
+	a.Nil(feed)
+}
+
+func TestFeedParserParseMailboxErr(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTestMaildir(t)
+	p := newFeedParser(FetcherConfig{})
+
+	for _, feedURL := range []string{
+		entity.MailboxURL(entity.MaildirScheme, dir, ""),
+		"maildir://remote.com/Mail?group=a.com",
+	} {
+		_, _, err := p.ParseURLIfModified(context.Background(), feedURL, nil, nil)
+		assert.ErrorAs(t, err, &entity.InvalidFeedSourceError{}, feedURL)
+	}
+
+	_, _, err := p.ParseURLIfModified(
+		context.Background(),
+		entity.MailboxURL(entity.MaildirScheme, filepath.Join(dir, "missing"), "a.com"),
+		nil,
+		nil,
+	)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFeedParserDiscoverMailboxFeeds(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	dir := writeTestMaildir(t)
+	p := newFeedParser(FetcherConfig{})
+
+	cands, err := p.DiscoverFeeds(
+		context.Background(),
+		entity.MailboxURL(entity.MaildirScheme, dir, ""),
+	)
+	r.NoError(err)
+	a.Equal(
+		[]*entity.FeedCandidate{
+			{
+				URL:    entity.MailboxURL(entity.MaildirScheme, dir, "alice@example.com"),
+				Title:  pointer("Alice"),
+				Format: pointer("email"),
+			},
+			{
+				URL:    entity.MailboxURL(entity.MaildirScheme, dir, "weekly.example.com"),
+				Title:  pointer("Weekly News"),
+				Format: pointer("email"),
+			},
+		},
+		cands,
+	)
+}
@@ -0,0 +1,38 @@
+CREATE VIRTUAL TABLE IF NOT EXISTS
+  -- entries_fts is the full-text search index over entries. It is an external content table,
+  -- meaning it stores only the index and reads the actual column values from entries.
+  entries_fts
+  USING fts5
+  -- title is the indexed entry title.
+  ( title
+  -- description is the indexed entry description.
+  , description
+  -- content is the indexed entry content.
+  , content
+  , content = 'entries'
+  , content_rowid = 'id'
+  , tokenize = 'porter unicode61 remove_diacritics 2'
+  );
+
+-- Populate the index with all existing entries.
+INSERT INTO entries_fts(entries_fts) VALUES ('rebuild');
+
+-- The triggers below keep the index in sync with the entries table.
+CREATE TRIGGER IF NOT EXISTS entries_fts_after_insert AFTER INSERT ON entries BEGIN
+  INSERT INTO entries_fts(rowid, title, description, content)
+    VALUES (new.id, new.title, new.description, new.content);
+END;
+
+CREATE TRIGGER IF NOT EXISTS entries_fts_after_delete AFTER DELETE ON entries BEGIN
+  INSERT INTO entries_fts(entries_fts, rowid, title, description, content)
+    VALUES ('delete', old.id, old.title, old.description, old.content);
+END;
+
+CREATE TRIGGER IF NOT EXISTS entries_fts_after_update
+  AFTER UPDATE OF title, description, content ON entries
+BEGIN
+  INSERT INTO entries_fts(entries_fts, rowid, title, description, content)
+    VALUES ('delete', old.id, old.title, old.description, old.content);
+  INSERT INTO entries_fts(rowid, title, description, content)
+    VALUES (new.id, new.title, new.description, new.content);
+END;
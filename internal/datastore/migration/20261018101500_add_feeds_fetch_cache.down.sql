@@ -0,0 +1,3 @@
+ALTER TABLE feeds DROP COLUMN content_hash;
+ALTER TABLE feeds DROP COLUMN http_last_modified;
+ALTER TABLE feeds DROP COLUMN http_etag;
@@ -0,0 +1,8 @@
+-- http_etag is the ETag header value returned by the last successful feed fetch.
+ALTER TABLE feeds ADD COLUMN http_etag TEXT NULL CHECK(http_etag IS NULL or length(http_etag) > 0);
+-- http_last_modified is the Last-Modified header value returned by the last successful feed fetch.
+ALTER TABLE feeds ADD COLUMN http_last_modified TEXT NULL
+  CHECK(http_last_modified IS NULL or length(http_last_modified) > 0);
+-- content_hash is the SHA-256 digest of the feed body returned by the last successful feed fetch.
+ALTER TABLE feeds ADD COLUMN content_hash TEXT NULL
+  CHECK(content_hash IS NULL or length(content_hash) > 0);
@@ -0,0 +1,4 @@
+-- pull_interval is the number of seconds between scheduled pulls of the feed. It overrides the
+-- server-wide default interval when set.
+ALTER TABLE feeds ADD COLUMN pull_interval INTEGER NULL
+  CHECK(pull_interval IS NULL or pull_interval > 0);
@@ -0,0 +1,2 @@
+ALTER TABLE feeds DROP COLUMN retain_read_age;
+ALTER TABLE feeds DROP COLUMN retain_max_entries;
@@ -0,0 +1,8 @@
+-- retain_max_entries is the maximum number of most recent entries kept for the feed. It overrides
+-- the global retention policy when set.
+ALTER TABLE feeds ADD COLUMN retain_max_entries INTEGER NULL
+  CHECK(retain_max_entries IS NULL or retain_max_entries > 0);
+-- retain_read_age is the number of seconds read entries of the feed are kept for. It overrides the
+-- global retention policy when set.
+ALTER TABLE feeds ADD COLUMN retain_read_age INTEGER NULL
+  CHECK(retain_read_age IS NULL or retain_read_age > 0);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS entry_enclosures_entry_id;
+DROP TABLE IF EXISTS entry_enclosures;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS
+  -- entry_enclosures contains media files attached to entries, such as podcast episodes.
+  entry_enclosures
+  -- id is the internal database ID of the enclosure.
+  ( id INTEGER PRIMARY KEY AUTOINCREMENT
+  -- entry_id is the internal database ID of the entry to which the enclosure belongs.
+  , entry_id INTEGER NOT NULL
+  -- url is the location of the enclosure.
+  , url TEXT NOT NULL CHECK(length(url) > 0)
+  -- mime_type is the declared MIME type of the enclosure.
+  , mime_type TEXT NULL CHECK(mime_type IS NULL or length(mime_type) > 0)
+  -- length is the declared size of the enclosure in bytes.
+  , length INTEGER NULL CHECK(length IS NULL or length > 0)
+  -- enclosures are unique by their URL, for a specific entry.
+  , UNIQUE(entry_id, url)
+  , FOREIGN KEY(entry_id) REFERENCES entries(id) ON DELETE CASCADE
+  );
+CREATE INDEX IF NOT EXISTS entry_enclosures_entry_id ON entry_enclosures(entry_id);
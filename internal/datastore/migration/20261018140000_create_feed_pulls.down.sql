@@ -0,0 +1,4 @@
+ALTER TABLE feeds DROP COLUMN last_error;
+ALTER TABLE feeds DROP COLUMN consecutive_failures;
+DROP INDEX IF EXISTS feed_pulls_feed_id;
+DROP TABLE IF EXISTS feed_pulls;
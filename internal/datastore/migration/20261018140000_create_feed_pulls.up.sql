@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS
+  -- feed_pulls contains the history of pull attempts of feeds.
+  feed_pulls
+  -- id is the internal database ID of the pull attempt.
+  ( id INTEGER PRIMARY KEY AUTOINCREMENT
+  -- feed_id is the internal database ID of the pulled feed.
+  , feed_id INTEGER NOT NULL
+  -- pull_time is when the pull was started.
+  , pull_time TIMESTAMP NOT NULL
+  -- duration is the number of milliseconds the pull took.
+  , duration INTEGER NOT NULL CHECK(duration >= 0)
+  -- http_status is the HTTP status code of the feed response, if any was received.
+  , http_status INTEGER NULL
+  -- num_entries is the number of entries in the pulled feed; NULL if the feed was not parsed.
+  , num_entries INTEGER NULL CHECK(num_entries IS NULL or num_entries >= 0)
+  -- num_new_entries is the number of entries added by the pull.
+  , num_new_entries INTEGER NULL CHECK(num_new_entries IS NULL or num_new_entries >= 0)
+  -- error is the error message of a failed pull; NULL if the pull succeeded.
+  , error TEXT NULL
+  , FOREIGN KEY(feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+  );
+CREATE INDEX IF NOT EXISTS feed_pulls_feed_id ON feed_pulls(feed_id);
+
+-- consecutive_failures is the number of pulls of the feed that have failed since the last
+-- successful one.
+ALTER TABLE feeds ADD COLUMN consecutive_failures INTEGER NOT NULL DEFAULT 0
+  CHECK(consecutive_failures >= 0);
+-- last_error is the error message of the last pull of the feed, if it failed.
+ALTER TABLE feeds ADD COLUMN last_error TEXT NULL;
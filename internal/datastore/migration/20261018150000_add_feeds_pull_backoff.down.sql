@@ -0,0 +1,2 @@
+ALTER TABLE feeds DROP COLUMN is_paused;
+ALTER TABLE feeds DROP COLUMN backoff_until;
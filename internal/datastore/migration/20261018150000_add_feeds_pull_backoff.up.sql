@@ -0,0 +1,4 @@
+-- backoff_until is the time before which the feed is not pulled, after failed pulls.
+ALTER TABLE feeds ADD COLUMN backoff_until TIMESTAMP NULL;
+-- is_paused is whether pulls of the feed are paused, e.g. after the feed is reported gone.
+ALTER TABLE feeds ADD COLUMN is_paused BOOLEAN NOT NULL DEFAULT false;
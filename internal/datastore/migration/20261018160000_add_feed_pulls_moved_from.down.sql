@@ -0,0 +1 @@
+ALTER TABLE feed_pulls DROP COLUMN moved_from;
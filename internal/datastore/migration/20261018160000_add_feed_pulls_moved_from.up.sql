@@ -0,0 +1,3 @@
+-- moved_from is the previous URL of the feed, if the pull followed a permanent redirect to a new
+-- URL of the feed.
+ALTER TABLE feed_pulls ADD COLUMN moved_from TEXT NULL;
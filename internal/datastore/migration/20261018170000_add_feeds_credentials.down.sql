@@ -0,0 +1,3 @@
+ALTER TABLE feeds DROP COLUMN auth_token;
+ALTER TABLE feeds DROP COLUMN auth_password;
+ALTER TABLE feeds DROP COLUMN auth_username;
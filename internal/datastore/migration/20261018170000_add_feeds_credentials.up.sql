@@ -0,0 +1,6 @@
+-- auth_username is the username for HTTP basic authentication when fetching the feed.
+ALTER TABLE feeds ADD COLUMN auth_username TEXT NULL;
+-- auth_password is the password for HTTP basic authentication when fetching the feed.
+ALTER TABLE feeds ADD COLUMN auth_password TEXT NULL;
+-- auth_token is the bearer token sent when fetching the feed.
+ALTER TABLE feeds ADD COLUMN auth_token TEXT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE feeds DROP COLUMN next_pull_time;
+ALTER TABLE feeds DROP COLUMN cache_expire_time;
+ALTER TABLE feeds DROP COLUMN skip_days;
+ALTER TABLE feeds DROP COLUMN skip_hours;
+ALTER TABLE feeds DROP COLUMN refresh_ttl;
@@ -0,0 +1,15 @@
+-- refresh_ttl is the number of seconds the feed declares it may be cached for, through the RSS
+-- <ttl> element or the syndication module.
+ALTER TABLE feeds ADD COLUMN refresh_ttl INTEGER NULL
+    CHECK(refresh_ttl IS NULL OR refresh_ttl > 0);
+-- skip_hours is the JSON array of the GMT hours in which the feed declares it should not be pulled.
+ALTER TABLE feeds ADD COLUMN skip_hours TEXT NULL;
+-- skip_days is the JSON array of the days, as numbers from 0 for Sunday, on which the feed
+-- declares it should not be pulled.
+ALTER TABLE feeds ADD COLUMN skip_days TEXT NULL;
+-- cache_expire_time is when the last feed response expires, according to its HTTP Cache-Control
+-- or Expires header.
+ALTER TABLE feeds ADD COLUMN cache_expire_time TIMESTAMP NULL;
+-- next_pull_time is the time before which the feed is not due a pull, according to its refresh
+-- hints.
+ALTER TABLE feeds ADD COLUMN next_pull_time TIMESTAMP NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE feeds DROP COLUMN exec_timeout;
+ALTER TABLE feeds DROP COLUMN exec_dir;
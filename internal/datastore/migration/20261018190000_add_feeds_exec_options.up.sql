@@ -0,0 +1,5 @@
+-- exec_dir is the working directory of the command of exec: feeds.
+ALTER TABLE feeds ADD COLUMN exec_dir TEXT NULL;
+-- exec_timeout is the maximum number of seconds the command of exec: feeds may run for.
+ALTER TABLE feeds ADD COLUMN exec_timeout INTEGER NULL
+    CHECK(exec_timeout IS NULL OR exec_timeout > 0);
@@ -0,0 +1,3 @@
+-- scrape_spec is the JSON object of the CSS selectors with which entries are scraped from the
+-- web page of feeds without a feed document.
+ALTER TABLE feeds ADD COLUMN scrape_spec TEXT NULL;
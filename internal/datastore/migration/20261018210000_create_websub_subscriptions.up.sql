@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS
+  -- websub_subscriptions contains the WebSub subscriptions of feeds that advertise a hub.
+  websub_subscriptions
+  -- feed_id is the internal database ID of the subscribed feed.
+  ( feed_id INTEGER PRIMARY KEY
+  -- hub_url is the URL of the hub advertised by the feed.
+  , hub_url TEXT NOT NULL
+  -- topic_url is the URL the feed advertises as its own, which is subscribed to at the hub.
+  , topic_url TEXT NOT NULL
+  -- state is the state of the subscription.
+  , state TEXT NOT NULL DEFAULT 'unsubscribed'
+    CHECK(state IN ('unsubscribed', 'pending', 'active', 'denied'))
+  -- secret is the secret the hub signs pushed contents with; NULL if not yet requested.
+  , secret TEXT NULL
+  -- lease_expire_time is when the subscription expires; NULL if it is not active.
+  , lease_expire_time TIMESTAMP NULL
+  , FOREIGN KEY(feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+  );
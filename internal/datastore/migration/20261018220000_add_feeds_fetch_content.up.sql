@@ -0,0 +1,3 @@
+-- fetch_content is whether the full content of new entries of the feed is extracted from the
+-- pages they link to after each pull, for feeds that only provide summaries.
+ALTER TABLE feeds ADD COLUMN fetch_content BOOLEAN NOT NULL DEFAULT false;
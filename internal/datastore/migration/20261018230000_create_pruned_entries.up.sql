@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS
+  -- pruned_entries contains the entries removed by pruning, so that they are not added again when
+  -- their feed is pulled while they are still listed in it.
+  pruned_entries
+  -- feed_id is the internal database ID of the feed to which the entry belonged.
+  ( feed_id INTEGER NOT NULL
+  -- external_id is the externally-defined ID value of the entry.
+  , external_id TEXT NOT NULL
+  -- prune_time is when the entry was pruned.
+  , prune_time TIMESTAMP NOT NULL
+  , PRIMARY KEY(feed_id, external_id)
+  , FOREIGN KEY(feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+  );
@@ -4,12 +4,252 @@
 package datastore
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mmcdole/gofeed"
+
+	"github.com/bow/neon/internal/entity"
 )
 
 // Parser captures the gofeed parser as a pluggable interface.
 type Parser interface {
-	ParseURLWithContext(feedURL string, ctx context.Context) (feed *gofeed.Feed, err error)
+	// ParseURLIfModified parses the feed at the given URL only if it has changed since the given
+	// cache state was recorded. The returned feed is nil when the feed is unchanged. The returned
+	// cache state is always non-nil and should be stored for subsequent calls. A nil cache state
+	// may be given to parse the feed unconditionally. The feed is fetched with the given
+	// credentials, if any. Local feed files may be given as file:// URLs, and the messages of a
+	// sender group of a local mailbox as maildir: or mbox: URLs.
+	ParseURLIfModified(
+		ctx context.Context,
+		feedURL string,
+		creds *entity.FeedCredentials,
+		cache *FetchCache,
+	) (feed *gofeed.Feed, newCache *FetchCache, err error)
+
+	// ParseCommandIfModified runs the given command of an exec: feed with the given options, and
+	// parses its standard output like ParseURLIfModified does the contents of a feed URL.
+	ParseCommandIfModified(
+		ctx context.Context,
+		command string,
+		opts *entity.ExecOptions,
+		cache *FetchCache,
+	) (feed *gofeed.Feed, newCache *FetchCache, err error)
+
+	// ScrapeURLIfModified extracts the entries of the web page at the given URL with the given
+	// scrape spec, like ParseURLIfModified does the entries of a feed URL.
+	ScrapeURLIfModified(
+		ctx context.Context,
+		pageURL string,
+		creds *entity.FeedCredentials,
+		spec *entity.ScrapeSpec,
+		cache *FetchCache,
+	) (feed *gofeed.Feed, newCache *FetchCache, err error)
+
+	// DiscoverFeeds returns the feeds advertised by the web page at the given URL, either through
+	// <link rel="alternate"> elements or at well-known paths of the site. If the URL points to a
+	// feed itself, it is returned as the only candidate. For local mailboxes, the feeds of each of
+	// their sender groups are returned.
+	DiscoverFeeds(ctx context.Context, pageURL string) (candidates []*entity.FeedCandidate, err error)
+
+	// Parse parses the given feed document, such as the contents pushed by a WebSub hub.
+	Parse(feed io.Reader) (*gofeed.Feed, error)
+
+	// ExtractContent returns the main content of the web page at the given URL as HTML, without
+	// the navigation, sidebars, and other clutter around it.
+	ExtractContent(ctx context.Context, pageURL string) (content string, err error)
+}
+
+// FetchCache contains the HTTP cache validators and the content digest of a fetched feed.
+type FetchCache struct {
+	ETag         *string
+	LastModified *string
+	ContentHash  *string
+
+	// StatusCode is the HTTP status code of the response the cache state was taken from. It is
+	// not stored, and is zero for cache states that were not just fetched.
+	StatusCode int
+	// PermanentURL is the URL the feed has permanently moved to, if the request was answered with
+	// permanent redirects. Like StatusCode, it is not stored.
+	PermanentURL *string
+	// Expires is when the response expires according to its Cache-Control or Expires header.
+	// Unlike the cache validators, it is stored as a refresh hint of the feed.
+	Expires *time.Time
+}
+
+// RetryAfterError is returned for HTTP error responses that specify how long to wait before the
+// request is retried.
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or
+// an HTTP date, into the duration to wait from the given time. It returns nil for invalid values.
+func parseRetryAfter(value string, now time.Time) *time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if secs < 0 {
+			return nil
+		}
+		secs = min(secs, int64(math.MaxInt64/time.Second))
+		return pointer(time.Duration(secs) * time.Second)
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return pointer(max(t.Sub(now), 0))
+	}
+	return nil
+}
+
+// feedParser is the default Parser implementation, which fetches feeds over HTTP, reads local feed
+// files, runs the commands of exec: feeds, and scrapes web pages.
+type feedParser struct {
+	*gofeed.Parser
+	fetcher *fetcher
+}
+
+func newFeedParser(cfg FetcherConfig) *feedParser {
+	parser := gofeed.NewParser()
+	parser.RSSTranslator = &rssTranslator{}
+	parser.AtomTranslator = &atomTranslator{}
+	return &feedParser{Parser: parser, fetcher: newFetcher(cfg)}
+}
+
+// ParseURLIfModified satisfies the Parser interface.
+func (p *feedParser) ParseURLIfModified(
+	ctx context.Context,
+	feedURL string,
+	creds *entity.FeedCredentials,
+	cache *FetchCache,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	if isFileURL(feedURL) {
+		return p.parseFileIfModified(feedURL, cache)
+	}
+	if isMailboxURL(feedURL) {
+		return p.parseMailboxIfModified(feedURL, cache)
+	}
+
+	return p.fetchIfModified(ctx, feedURL, creds, cache, p.parseBody)
+}
+
+// bodyParser turns fetched contents into a feed, resolving relative URLs against the given base
+// URL, if any.
+type bodyParser func(body []byte, base *url.URL) (*gofeed.Feed, error)
+
+// parseBody is the bodyParser of feed documents.
+func (p *feedParser) parseBody(body []byte, _ *url.URL) (*gofeed.Feed, error) {
+	return p.Parse(bytes.NewReader(body))
+}
+
+// fetchIfModified fetches the given URL only if it has changed since the given cache state was
+// recorded, and turns the fetched contents into a feed with the given parser.
+func (p *feedParser) fetchIfModified(
+	ctx context.Context,
+	rawURL string,
+	creds *entity.FeedCredentials,
+	cache *FetchCache,
+	parse bodyParser,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	if cache == nil {
+		cache = &FetchCache{}
+	}
+
+	req, err := p.fetcher.newRequest(ctx, rawURL, creds)
+	if err != nil {
+		return nil, nil, err
+	}
+	if v := cache.ETag; v != nil {
+		req.Header.Set("If-None-Match", *v)
+	}
+	if v := cache.LastModified; v != nil {
+		req.Header.Set("If-Modified-Since", *v)
+	}
+
+	var tracker redirectTracker
+	resp, err := p.fetcher.do(req, &tracker)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		newCache := *cache
+		newCache.StatusCode = resp.StatusCode
+		newCache.PermanentURL = tracker.permanentURL
+		newCache.Expires = responseExpireTime(resp.Header, time.Now())
+		if v := pointerOrNil(resp.Header.Get("ETag")); v != nil {
+			newCache.ETag = v
+		}
+		return nil, &newCache, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		herr := gofeed.HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
+		if d := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); d != nil {
+			return nil, nil, RetryAfterError{Err: herr, RetryAfter: *d}
+		}
+		return nil, nil, herr
+	}
+
+	body, err := p.fetcher.readBody(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newCache := FetchCache{
+		ETag:         pointerOrNil(resp.Header.Get("ETag")),
+		LastModified: pointerOrNil(resp.Header.Get("Last-Modified")),
+		StatusCode:   resp.StatusCode,
+		PermanentURL: tracker.permanentURL,
+		Expires:      responseExpireTime(resp.Header, time.Now()),
+	}
+	// Some servers do not support conditional requests, so we also compare the content itself.
+	return parseIfChanged(body, resp.Request.URL, cache, &newCache, parse)
+}
+
+// parseIfChanged turns the given contents into a feed with the given parser, unless their digest
+// is the same as the one in the given cache state. The digest is set in the given new cache state,
+// which is returned.
+func parseIfChanged(
+	body []byte,
+	base *url.URL,
+	cache *FetchCache,
+	newCache *FetchCache,
+	parse bodyParser,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	digest := sha256.Sum256(body)
+	newCache.ContentHash = pointer(hex.EncodeToString(digest[:]))
+	if cache != nil && cache.ContentHash != nil && *cache.ContentHash == *newCache.ContentHash {
+		return nil, newCache, nil
+	}
+
+	feed, err := parse(body, base)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return feed, newCache, nil
 }
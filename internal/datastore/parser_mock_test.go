@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=internal/datastore/parser.go -package=datastore Parser
+//	mockgen -source=internal/datastore/parser.go -package=datastore -self_package=github.com/bow/neon/internal/datastore Parser
 //
 
 // Package datastore is a generated GoMock package.
@@ -11,8 +11,10 @@ package datastore
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
+	entity "github.com/bow/neon/internal/entity"
 	gofeed "github.com/mmcdole/gofeed"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,17 +43,95 @@ func (m *MockParser) EXPECT() *MockParserMockRecorder {
 	return m.recorder
 }
 
-// ParseURLWithContext mocks base method.
-func (m *MockParser) ParseURLWithContext(feedURL string, ctx context.Context) (*gofeed.Feed, error) {
+// DiscoverFeeds mocks base method.
+func (m *MockParser) DiscoverFeeds(ctx context.Context, pageURL string) ([]*entity.FeedCandidate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ParseURLWithContext", feedURL, ctx)
+	ret := m.ctrl.Call(m, "DiscoverFeeds", ctx, pageURL)
+	ret0, _ := ret[0].([]*entity.FeedCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverFeeds indicates an expected call of DiscoverFeeds.
+func (mr *MockParserMockRecorder) DiscoverFeeds(ctx, pageURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverFeeds", reflect.TypeOf((*MockParser)(nil).DiscoverFeeds), ctx, pageURL)
+}
+
+// ExtractContent mocks base method.
+func (m *MockParser) ExtractContent(ctx context.Context, pageURL string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractContent", ctx, pageURL)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractContent indicates an expected call of ExtractContent.
+func (mr *MockParserMockRecorder) ExtractContent(ctx, pageURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractContent", reflect.TypeOf((*MockParser)(nil).ExtractContent), ctx, pageURL)
+}
+
+// Parse mocks base method.
+func (m *MockParser) Parse(feed io.Reader) (*gofeed.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", feed)
 	ret0, _ := ret[0].(*gofeed.Feed)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ParseURLWithContext indicates an expected call of ParseURLWithContext.
-func (mr *MockParserMockRecorder) ParseURLWithContext(feedURL, ctx any) *gomock.Call {
+// Parse indicates an expected call of Parse.
+func (mr *MockParserMockRecorder) Parse(feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockParser)(nil).Parse), feed)
+}
+
+// ParseCommandIfModified mocks base method.
+func (m *MockParser) ParseCommandIfModified(ctx context.Context, command string, opts *entity.ExecOptions, cache *FetchCache) (*gofeed.Feed, *FetchCache, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseCommandIfModified", ctx, command, opts, cache)
+	ret0, _ := ret[0].(*gofeed.Feed)
+	ret1, _ := ret[1].(*FetchCache)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ParseCommandIfModified indicates an expected call of ParseCommandIfModified.
+func (mr *MockParserMockRecorder) ParseCommandIfModified(ctx, command, opts, cache any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseCommandIfModified", reflect.TypeOf((*MockParser)(nil).ParseCommandIfModified), ctx, command, opts, cache)
+}
+
+// ParseURLIfModified mocks base method.
+func (m *MockParser) ParseURLIfModified(ctx context.Context, feedURL string, creds *entity.FeedCredentials, cache *FetchCache) (*gofeed.Feed, *FetchCache, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseURLIfModified", ctx, feedURL, creds, cache)
+	ret0, _ := ret[0].(*gofeed.Feed)
+	ret1, _ := ret[1].(*FetchCache)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ParseURLIfModified indicates an expected call of ParseURLIfModified.
+func (mr *MockParserMockRecorder) ParseURLIfModified(ctx, feedURL, creds, cache any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseURLIfModified", reflect.TypeOf((*MockParser)(nil).ParseURLIfModified), ctx, feedURL, creds, cache)
+}
+
+// ScrapeURLIfModified mocks base method.
+func (m *MockParser) ScrapeURLIfModified(ctx context.Context, pageURL string, creds *entity.FeedCredentials, spec *entity.ScrapeSpec, cache *FetchCache) (*gofeed.Feed, *FetchCache, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScrapeURLIfModified", ctx, pageURL, creds, spec, cache)
+	ret0, _ := ret[0].(*gofeed.Feed)
+	ret1, _ := ret[1].(*FetchCache)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ScrapeURLIfModified indicates an expected call of ScrapeURLIfModified.
+func (mr *MockParserMockRecorder) ScrapeURLIfModified(ctx, pageURL, creds, spec, cache any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseURLWithContext", reflect.TypeOf((*MockParser)(nil).ParseURLWithContext), feedURL, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrapeURLIfModified", reflect.TypeOf((*MockParser)(nil).ScrapeURLIfModified), ctx, pageURL, creds, spec, cache)
 }
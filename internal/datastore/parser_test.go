@@ -0,0 +1,237 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Feed A</title>
+    <link>http://a.com</link>
+    <item>
+      <title>Entry A1</title>
+      <guid>A1</guid>
+    </item>
+  </channel>
+</rss>`
+
+func TestFeedParserParseURLIfModified(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	const (
+		etag    = `"v1"`
+		lastMod = "Wed, 21 Oct 2015 07:28:00 GMT"
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=600")
+		if req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastMod)
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	p := newFeedParser(FetcherConfig{})
+	start := time.Now()
+
+	feed, cache, err := p.ParseURLIfModified(context.Background(), srv.URL, nil, nil)
+	r.NoError(err)
+	r.NotNil(feed)
+	r.NotNil(cache)
+	a.Equal("Feed A", feed.Title)
+	a.Equal(etag, *cache.ETag)
+	a.Equal(lastMod, *cache.LastModified)
+	a.NotNil(cache.ContentHash)
+	a.Equal(http.StatusOK, cache.StatusCode)
+	r.NotNil(cache.Expires)
+	a.WithinRange(*cache.Expires, start.Add(10*time.Minute), time.Now().Add(10*time.Minute))
+
+	feed, ncache, err := p.ParseURLIfModified(context.Background(), srv.URL, nil, cache)
+	r.NoError(err)
+	a.Nil(feed)
+	a.Equal(cache.ETag, ncache.ETag)
+	a.Equal(cache.LastModified, ncache.LastModified)
+	a.Equal(cache.ContentHash, ncache.ContentHash)
+	a.Equal(http.StatusNotModified, ncache.StatusCode)
+	a.NotNil(ncache.Expires)
+}
+
+func TestFeedParserParseURLIfModifiedSameContent(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	p := newFeedParser(FetcherConfig{})
+
+	feed, cache, err := p.ParseURLIfModified(context.Background(), srv.URL, nil, nil)
+	r.NoError(err)
+	r.NotNil(feed)
+	a.Nil(cache.ETag)
+	a.Nil(cache.LastModified)
+
+	feed, ncache, err := p.ParseURLIfModified(context.Background(), srv.URL, nil, cache)
+	r.NoError(err)
+	a.Nil(feed)
+	a.Equal(cache.ContentHash, ncache.ContentHash)
+}
+
+func TestFeedParserParseURLIfModifiedErrStatus(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	p := newFeedParser(FetcherConfig{})
+	feed, cache, err := p.ParseURLIfModified(context.Background(), srv.URL, nil, nil)
+	a.Nil(feed)
+	a.Nil(cache)
+	a.ErrorAs(err, &gofeed.HTTPError{})
+}
+
+func TestFeedParserParseURLIfModifiedErrRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	p := newFeedParser(FetcherConfig{})
+	feed, cache, err := p.ParseURLIfModified(context.Background(), srv.URL, nil, nil)
+	a.Nil(feed)
+	a.Nil(cache)
+
+	var rerr RetryAfterError
+	a.ErrorAs(err, &rerr)
+	a.Equal(2*time.Minute, rerr.RetryAfter)
+	a.ErrorAs(err, &gofeed.HTTPError{})
+}
+
+func TestFeedParserParseURLIfModifiedPermanentRedirect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		codes []int
+		want  string
+	}{
+		{"none", nil, ""},
+		{"moved permanently", []int{http.StatusMovedPermanently}, "/r1"},
+		{"permanent redirect", []int{http.StatusPermanentRedirect}, "/r1"},
+		{"temporary", []int{http.StatusFound}, ""},
+		{
+			"permanent then temporary",
+			[]int{http.StatusMovedPermanently, http.StatusTemporaryRedirect},
+			"/r1",
+		},
+		{
+			"temporary then permanent",
+			[]int{http.StatusFound, http.StatusMovedPermanently},
+			"",
+		},
+		{
+			"permanent chain",
+			[]int{http.StatusMovedPermanently, http.StatusPermanentRedirect},
+			"/r2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := assert.New(t)
+			r := require.New(t)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/r0", func(w http.ResponseWriter, req *http.Request) {
+				http.Redirect(w, req, "/r1", test.codes[0])
+			})
+			mux.HandleFunc("/r1", func(w http.ResponseWriter, req *http.Request) {
+				if len(test.codes) > 1 {
+					http.Redirect(w, req, "/r2", test.codes[1])
+					return
+				}
+				_, _ = w.Write([]byte(testRSS))
+			})
+			mux.HandleFunc("/r2", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(testRSS))
+			})
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			start := "/r0"
+			if len(test.codes) == 0 {
+				start = "/r1"
+			}
+
+			feed, cache, err := newFeedParser(FetcherConfig{}).ParseURLIfModified(
+				context.Background(),
+				srv.URL+start,
+				nil,
+				nil,
+			)
+			r.NoError(err)
+			r.NotNil(feed)
+			if test.want == "" {
+				a.Nil(cache.PermanentURL)
+			} else {
+				a.Equal(pointer(srv.URL+test.want), cache.PermanentURL)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  *time.Duration
+	}{
+		{"", nil},
+		{"30", pointer(30 * time.Second)},
+		{"-5", nil},
+		{"Wed, 21 Oct 2015 07:30:00 GMT", pointer(2 * time.Minute)},
+		{"Wed, 21 Oct 2015 07:00:00 GMT", pointer(time.Duration(0))},
+		{"soon", nil},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, parseRetryAfter(test.value, now), "value %q", test.value)
+	}
+}
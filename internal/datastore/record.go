@@ -28,6 +28,18 @@ type feedRecord struct {
 	isStarred   bool
 	tags        jsonArrayString
 	entries     []*entryRecord
+
+	pullInterval     sql.NullInt64
+	retainMaxEntries sql.NullInt64
+	retainReadAge    sql.NullInt64
+
+	consecutiveFailures uint32
+	lastError           sql.NullString
+	backoffUntil        sql.NullTime
+	isPaused            bool
+	nextPullTime        sql.NullTime
+
+	fetchContent bool
 }
 
 func (rec *feedRecord) feed() *entity.Feed {
@@ -43,6 +55,20 @@ func (rec *feedRecord) feed() *entity.Feed {
 		IsStarred:   rec.isStarred,
 		Tags:        []string(rec.tags),
 		Entries:     entryRecords(rec.entries).entriesMap(),
+
+		PullInterval: fromNullSeconds(rec.pullInterval),
+		Retention: entity.RetentionPolicy{
+			MaxEntries: fromNullUint32(rec.retainMaxEntries),
+			MaxReadAge: fromNullSeconds(rec.retainReadAge),
+		},
+
+		ConsecutiveFailures: rec.consecutiveFailures,
+		LastError:           fromNullString(rec.lastError),
+		BackoffUntil:        fromNullTime(rec.backoffUntil),
+		IsPaused:            rec.isPaused,
+		NextPullTime:        fromNullTime(rec.nextPullTime),
+
+		FetchContent: rec.fetchContent,
 	}
 }
 
@@ -70,6 +96,9 @@ type entryRecord struct {
 	description  sql.NullString
 	content      sql.NullString
 	url          sql.NullString
+	authors      jsonArrayString
+	categories   jsonArrayString
+	enclosures   []*enclosureRecord
 }
 
 func (rec *entryRecord) entry() *entity.Entry {
@@ -85,6 +114,9 @@ func (rec *entryRecord) entry() *entity.Entry {
 		Description:  fromNullString(rec.description),
 		Content:      fromNullString(rec.content),
 		URL:          fromNullString(rec.url),
+		Authors:      rec.authors.orNil(),
+		Categories:   rec.categories.orNil(),
+		Enclosures:   enclosureRecords(rec.enclosures).enclosures(),
 	}
 }
 
@@ -119,6 +151,67 @@ func (recs entryRecords) entriesSlice() []*entity.Entry {
 	return entries
 }
 
+type enclosureRecord struct {
+	entryID  ID
+	url      string
+	mimeType sql.NullString
+	length   sql.NullInt64
+}
+
+func (rec *enclosureRecord) enclosure() *entity.Enclosure {
+	var length *uint64
+	if rec.length.Valid {
+		length = pointer(uint64(rec.length.Int64)) // #nosec G115
+	}
+	return &entity.Enclosure{
+		URL:      rec.url,
+		MIMEType: fromNullString(rec.mimeType),
+		Length:   length,
+	}
+}
+
+type enclosureRecords []*enclosureRecord
+
+func (recs enclosureRecords) enclosures() []*entity.Enclosure {
+
+	if len(recs) == 0 {
+		return nil
+	}
+
+	enclosures := make([]*entity.Enclosure, len(recs))
+	for i, rec := range recs {
+		enclosures[i] = rec.enclosure()
+	}
+
+	return enclosures
+}
+
+type searchResultRecord struct {
+	entry   *entryRecord
+	snippet string
+	rank    float64
+}
+
+func (rec *searchResultRecord) result() *entity.SearchResult {
+	return &entity.SearchResult{
+		Entry:   rec.entry.entry(),
+		Snippet: rec.snippet,
+		Rank:    rec.rank,
+	}
+}
+
+type searchResultRecords []*searchResultRecord
+
+func (recs searchResultRecords) results() []*entity.SearchResult {
+
+	results := make([]*entity.SearchResult, len(recs))
+	for i, rec := range recs {
+		results[i] = rec.result()
+	}
+
+	return results
+}
+
 type statsAggregateRecord struct {
 	numFeeds             uint32
 	numEntries           uint32
@@ -157,6 +250,14 @@ func (arr *jsonArrayString) Value() (driver.Value, error) {
 	return json.Marshal([]string(*arr))
 }
 
+// orNil returns the array as a string slice, or nil if it is empty.
+func (arr jsonArrayString) orNil() []string {
+	if len(arr) == 0 {
+		return nil
+	}
+	return []string(arr)
+}
+
 // Scan implements the database scanner interface for deserialization out of the database.
 func (arr *jsonArrayString) Scan(value any) error {
 	var bv []byte
@@ -203,6 +304,32 @@ func resolveEntryUpdateTime(entry *gofeed.Item) *time.Time {
 	return entry.PublishedParsed
 }
 
+// resolveEntryAuthors returns the authors of the entry, formatted as "name <email>" when both
+// values are present.
+func resolveEntryAuthors(entry *gofeed.Item) []string {
+	people := entry.Authors
+	if len(people) == 0 && entry.Author != nil { // nolint:staticcheck
+		people = []*gofeed.Person{entry.Author} // nolint:staticcheck
+	}
+
+	authors := make([]string, 0, len(people))
+	for _, person := range people {
+		if person == nil {
+			continue
+		}
+		name, email := strings.TrimSpace(person.Name), strings.TrimSpace(person.Email)
+		switch {
+		case name != "" && email != "":
+			authors = append(authors, fmt.Sprintf("%s <%s>", name, email))
+		case name != "":
+			authors = append(authors, name)
+		case email != "":
+			authors = append(authors, email)
+		}
+	}
+	return authors
+}
+
 func resolveEntryPublishedTime(entry *gofeed.Item) *time.Time {
 	// Use value if defined.
 	if tv := entry.PublishedParsed; tv != nil {
@@ -228,6 +355,22 @@ func fromNullTime(v sql.NullTime) *time.Time {
 	return &v.Time
 }
 
+func fromNullSeconds(v sql.NullInt64) *time.Duration {
+	if !v.Valid {
+		return nil
+	}
+	d := time.Duration(v.Int64) * time.Second
+	return &d
+}
+
+func fromNullUint32(v sql.NullInt64) *uint32 {
+	if !v.Valid {
+		return nil
+	}
+	u := uint32(v.Int64) // #nosec G115
+	return &u
+}
+
 func pointerOrNil(v string) *string {
 	if v == "" || strings.TrimSpace(v) == "" {
 		return nil
@@ -0,0 +1,331 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/rss"
+)
+
+// Keys of the custom fields in which rssTranslator keeps the refresh hints of RSS feeds.
+const (
+	customKeyTTL       = "neon:ttl"
+	customKeySkipHours = "neon:skipHours"
+	customKeySkipDays  = "neon:skipDays"
+)
+
+// maxRefreshDelay is the longest a feed is not pulled for due to its refresh hints, guarding
+// against hints that are far too long, e.g. a misconfigured max-age.
+const maxRefreshDelay = 7 * 24 * time.Hour
+
+// rssTranslator is the default gofeed RSS translator, which additionally keeps the refresh hints
+// of the feed, as these are not part of the universal feed.
+type rssTranslator struct {
+	gofeed.DefaultRSSTranslator
+}
+
+func (t *rssTranslator) Translate(feed any) (*gofeed.Feed, error) {
+	result, err := t.DefaultRSSTranslator.Translate(feed)
+	if err != nil {
+		return nil, err
+	}
+	rfeed, ok := feed.(*rss.Feed)
+	if !ok {
+		return result, nil
+	}
+	if result.Custom == nil {
+		result.Custom = make(map[string]string)
+	}
+	if v := strings.TrimSpace(rfeed.TTL); v != "" {
+		result.Custom[customKeyTTL] = v
+	}
+	if len(rfeed.SkipHours) > 0 {
+		result.Custom[customKeySkipHours] = strings.Join(rfeed.SkipHours, ",")
+	}
+	if len(rfeed.SkipDays) > 0 {
+		result.Custom[customKeySkipDays] = strings.Join(rfeed.SkipDays, ",")
+	}
+	return result, nil
+}
+
+// refreshHints are the hints declared by a feed on how often it should be pulled.
+type refreshHints struct {
+	// ttl is how long the feed may be cached for.
+	ttl *time.Duration
+	// skipHours are the GMT hours in which the feed should not be pulled.
+	skipHours []int
+	// skipDays are the days on which the feed should not be pulled.
+	skipDays []time.Weekday
+}
+
+// syUpdatePeriods maps the update periods of the syndication module to their durations.
+var syUpdatePeriods = map[string]time.Duration{
+	"hourly":  time.Hour,
+	"daily":   24 * time.Hour,
+	"weekly":  7 * 24 * time.Hour,
+	"monthly": 30 * 24 * time.Hour,
+	"yearly":  365 * 24 * time.Hour,
+}
+
+// feedRefreshHints returns the refresh hints declared by the given feed, through the RSS <ttl>,
+// <skipHours>, and <skipDays> elements, and the <sy:updatePeriod> and <sy:updateFrequency>
+// elements of the syndication module. The longest of the declared intervals is used as the TTL.
+func feedRefreshHints(feed *gofeed.Feed) refreshHints {
+
+	var hints refreshHints
+
+	if v, ok := feed.Custom[customKeyTTL]; ok {
+		if mins, err := strconv.Atoi(v); err == nil && mins > 0 {
+			hints.ttl = pointer(time.Duration(mins) * time.Minute)
+		}
+	}
+
+	if period, ok := syUpdatePeriods[strings.ToLower(extValue(feed, "sy", "updatePeriod"))]; ok {
+		freq := 1
+		if v, err := strconv.Atoi(extValue(feed, "sy", "updateFrequency")); err == nil && v > 0 {
+			freq = v
+		}
+		interval := period / time.Duration(freq)
+		if hints.ttl == nil || interval > *hints.ttl {
+			hints.ttl = &interval
+		}
+	}
+
+	for _, v := range strings.Split(feed.Custom[customKeySkipHours], ",") {
+		if hour, err := strconv.Atoi(strings.TrimSpace(v)); err == nil && hour >= 0 && hour < 24 {
+			if !slices.Contains(hints.skipHours, hour) {
+				hints.skipHours = append(hints.skipHours, hour)
+			}
+		}
+	}
+
+	for _, v := range strings.Split(feed.Custom[customKeySkipDays], ",") {
+		if day, ok := parseWeekday(v); ok && !slices.Contains(hints.skipDays, day) {
+			hints.skipDays = append(hints.skipDays, day)
+		}
+	}
+
+	return hints
+}
+
+// extValue returns the value of the first feed extension element with the given namespace prefix
+// and name, or an empty string if there is none.
+func extValue(feed *gofeed.Feed, prefix, name string) string {
+	if elems := feed.Extensions[prefix][name]; len(elems) > 0 {
+		return strings.TrimSpace(elems[0].Value)
+	}
+	return ""
+}
+
+func parseWeekday(value string) (time.Weekday, bool) {
+	value = strings.TrimSpace(value)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(value, day.String()) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// responseExpireTime returns when the given response expires according to its Cache-Control
+// max-age directive or, failing that, its Expires header. It returns nil if neither is set or if
+// the response must not be cached.
+func responseExpireTime(header http.Header, now time.Time) *time.Time {
+
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-cache", "no-store":
+			return nil
+		case "max-age":
+			secs, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+			if err != nil || secs <= 0 {
+				return nil
+			}
+			secs = min(secs, int64(maxRefreshDelay/time.Second))
+			return pointer(now.Add(time.Duration(secs) * time.Second))
+		}
+	}
+
+	if v := header.Get("Expires"); v != "" {
+		if t, err := http.ParseTime(v); err == nil && t.After(now) {
+			return &t
+		}
+	}
+
+	return nil
+}
+
+// nextPullTime returns the time before which a feed pulled at the given time is not due another
+// pull, according to its refresh hints and the expiry time of its last response. It returns nil
+// if the feed may be pulled again at any time.
+func nextPullTime(pullTime time.Time, hints refreshHints, expires *time.Time) *time.Time {
+
+	next := pullTime
+	if ttl := hints.ttl; ttl != nil {
+		next = pullTime.Add(*ttl)
+	}
+	if expires != nil && expires.After(next) {
+		next = *expires
+	}
+	next = minTime(next, pullTime.Add(maxRefreshDelay))
+
+	// Skipped hours and days are given in GMT, so the next pull is moved to the first hour that
+	// is not skipped. Hints that skip every hour are ignored.
+	if len(hints.skipHours) > 0 || len(hints.skipDays) > 0 {
+		cand := next.UTC()
+		for range 7 * 24 {
+			if !slices.Contains(hints.skipHours, cand.Hour()) &&
+				!slices.Contains(hints.skipDays, cand.Weekday()) {
+				next = cand
+				break
+			}
+			cand = cand.Truncate(time.Hour).Add(time.Hour)
+		}
+	}
+
+	if !next.After(pullTime) {
+		return nil
+	}
+	return &next
+}
+
+func minTime(t1, t2 time.Time) time.Time {
+	if t2.Before(t1) {
+		return t2
+	}
+	return t1
+}
+
+var (
+	setFeedCacheExpireTime = tableFieldSetter[sql.NullTime](feedsTable, "cache_expire_time")
+	setFeedNextPullTime    = tableFieldSetter[sql.NullTime](feedsTable, "next_pull_time")
+)
+
+// setFeedRefreshHints stores the refresh hints declared by a feed.
+func setFeedRefreshHints(ctx context.Context, tx *sql.Tx, feedID ID, hints refreshHints) error {
+
+	var ttl sql.NullInt64
+	if v := hints.ttl; v != nil && *v > 0 {
+		ttl = sql.NullInt64{Int64: int64(math.Ceil(v.Seconds())), Valid: true}
+	}
+
+	sql1 := `
+		UPDATE
+			feeds
+		SET
+			refresh_ttl = ?
+			, skip_hours = ?
+			, skip_days = ?
+		WHERE
+			id = ?
+`
+	_, err := tx.ExecContext(
+		ctx,
+		sql1,
+		ttl,
+		toNullJSON(hints.skipHours),
+		toNullJSON(hints.skipDays),
+		feedID,
+	)
+	return err
+}
+
+// updateFeedNextPullTime sets the next pull time of a feed pulled at the given time, from its
+// stored refresh hints and response expiry time.
+func updateFeedNextPullTime(ctx context.Context, tx *sql.Tx, feedID ID, pullTime time.Time) error {
+
+	sql1 := `
+		SELECT
+			refresh_ttl
+			, skip_hours
+			, skip_days
+			, cache_expire_time
+		FROM
+			feeds
+		WHERE
+			id = ?
+`
+	var (
+		ttl                 sql.NullInt64
+		skipHours, skipDays sql.NullString
+		expires             sql.NullTime
+		hints               refreshHints
+	)
+	err := tx.QueryRowContext(ctx, sql1, feedID).Scan(&ttl, &skipHours, &skipDays, &expires)
+	if err != nil {
+		return err
+	}
+	hints.ttl = fromNullSeconds(ttl)
+	if err := fromNullJSON(skipHours, &hints.skipHours); err != nil {
+		return err
+	}
+	if err := fromNullJSON(skipDays, &hints.skipDays); err != nil {
+		return err
+	}
+
+	var next sql.NullTime
+	if v := nextPullTime(pullTime, hints, fromNullTime(expires)); v != nil {
+		next = sql.NullTime{Time: *v, Valid: true}
+	}
+
+	return setFeedNextPullTime(ctx, tx, feedID, &next)
+}
+
+// storeFeedRefreshHints stores the refresh hints of a feed pulled at the given time, and updates
+// its next pull time accordingly. The feed hints are left untouched if the feed is nil, e.g. when
+// it has not been modified.
+func storeFeedRefreshHints(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	gfeed *gofeed.Feed,
+	cache *FetchCache,
+	pullTime time.Time,
+) error {
+
+	if gfeed != nil {
+		if err := setFeedRefreshHints(ctx, tx, feedID, feedRefreshHints(gfeed)); err != nil {
+			return err
+		}
+	}
+
+	var expires sql.NullTime
+	if cache != nil && cache.Expires != nil {
+		expires = sql.NullTime{Time: *cache.Expires, Valid: true}
+	}
+	if err := setFeedCacheExpireTime(ctx, tx, feedID, &expires); err != nil {
+		return err
+	}
+
+	return updateFeedNextPullTime(ctx, tx, feedID, pullTime)
+}
+
+func toNullJSON[T any](values []T) sql.NullString {
+	if len(values) == 0 {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func fromNullJSON[T any](v sql.NullString, dest *[]T) error {
+	if !v.Valid {
+		return nil
+	}
+	return json.Unmarshal([]byte(v.String), dest)
+}
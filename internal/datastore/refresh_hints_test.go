@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedRefreshHints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		feed string
+		want refreshHints
+	}{
+		{
+			name: "none",
+			feed: testRSS,
+		},
+		{
+			name: "rss",
+			feed: `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Feed A</title>
+    <ttl>90</ttl>
+    <skipHours><hour>0</hour><hour>23</hour><hour>24</hour><hour>0</hour></skipHours>
+    <skipDays><day>Saturday</day><day>sunday</day><day>Caturday</day></skipDays>
+  </channel>
+</rss>`,
+			want: refreshHints{
+				ttl:       pointer(90 * time.Minute),
+				skipHours: []int{0, 23},
+				skipDays:  []time.Weekday{time.Saturday, time.Sunday},
+			},
+		},
+		{
+			name: "syndication module",
+			feed: `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+  <channel>
+    <title>Feed A</title>
+    <ttl>10</ttl>
+    <sy:updatePeriod>daily</sy:updatePeriod>
+    <sy:updateFrequency>4</sy:updateFrequency>
+  </channel>
+</rss>`,
+			want: refreshHints{ttl: pointer(6 * time.Hour)},
+		},
+		{
+			name: "atom syndication module",
+			feed: `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+  <title>Feed A</title>
+  <sy:updatePeriod>hourly</sy:updatePeriod>
+</feed>`,
+			want: refreshHints{ttl: pointer(time.Hour)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed, err := newFeedParser(FetcherConfig{}).ParseString(test.feed)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, feedRefreshHints(feed))
+		})
+	}
+}
+
+func TestResponseExpireTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   *time.Time
+	}{
+		{
+			name:   "none",
+			header: http.Header{},
+		},
+		{
+			name:   "max-age",
+			header: http.Header{"Cache-Control": {"public, max-age=600"}},
+			want:   pointer(now.Add(10 * time.Minute)),
+		},
+		{
+			name:   "max-age capped",
+			header: http.Header{"Cache-Control": {"max-age=31536000"}},
+			want:   pointer(now.Add(maxRefreshDelay)),
+		},
+		{
+			name: "max-age over expires",
+			header: http.Header{
+				"Cache-Control": {"max-age=60"},
+				"Expires":       {"Sun, 18 Oct 2026 14:00:00 GMT"},
+			},
+			want: pointer(now.Add(time.Minute)),
+		},
+		{
+			name:   "expires",
+			header: http.Header{"Expires": {"Sun, 18 Oct 2026 14:00:00 GMT"}},
+			want:   pointer(now.Add(2 * time.Hour)),
+		},
+		{
+			name:   "expires in the past",
+			header: http.Header{"Expires": {"Sun, 18 Oct 2026 10:00:00 GMT"}},
+		},
+		{
+			name: "no-cache",
+			header: http.Header{
+				"Cache-Control": {"no-cache"},
+				"Expires":       {"Sun, 18 Oct 2026 14:00:00 GMT"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := responseExpireTime(test.header, now)
+			if test.want == nil {
+				assert.Nil(t, got)
+			} else if assert.NotNil(t, got) {
+				assert.True(t, test.want.Equal(*got), "want %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNextPullTime(t *testing.T) {
+	t.Parallel()
+
+	// 2026-10-18 is a Sunday.
+	pullTime := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		hints   refreshHints
+		expires *time.Time
+		want    *time.Time
+	}{
+		{
+			name: "no hints",
+		},
+		{
+			name:  "ttl",
+			hints: refreshHints{ttl: pointer(time.Hour)},
+			want:  pointer(pullTime.Add(time.Hour)),
+		},
+		{
+			name:    "expires after ttl",
+			hints:   refreshHints{ttl: pointer(time.Hour)},
+			expires: pointer(pullTime.Add(2 * time.Hour)),
+			want:    pointer(pullTime.Add(2 * time.Hour)),
+		},
+		{
+			name:  "ttl capped",
+			hints: refreshHints{ttl: pointer(30 * 24 * time.Hour)},
+			want:  pointer(pullTime.Add(maxRefreshDelay)),
+		},
+		{
+			name:  "skip hours",
+			hints: refreshHints{skipHours: []int{12, 13}},
+			want:  pointer(time.Date(2026, 10, 18, 14, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "skip days",
+			hints: refreshHints{ttl: pointer(time.Hour), skipDays: []time.Weekday{time.Sunday}},
+			want:  pointer(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "skip all hours",
+			hints: refreshHints{
+				skipHours: []int{
+					0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+					12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := nextPullTime(pullTime, test.hints, test.expires)
+			if test.want == nil {
+				assert.Nil(t, got)
+			} else if assert.NotNil(t, got) {
+				assert.True(t, test.want.Equal(*got), "want %s, got %s", test.want, got)
+			}
+		})
+	}
+}
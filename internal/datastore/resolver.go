@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// URLResolver rewrites the URLs of pages of a well-known site into the URLs of their feeds,
+// without any network requests.
+type URLResolver interface {
+	// Name returns the unique name of the resolver.
+	Name() string
+	// Description returns a description of the pages recognised by the resolver.
+	Description() string
+	// Resolve returns the feed URL of the given page URL. It returns false if the resolver does
+	// not recognise the page.
+	Resolve(pageURL string) (feedURL string, ok bool)
+}
+
+// RewriteRule is a URLResolver that rewrites page URLs matching a regular expression into feed
+// URLs with a template, in which $1 or ${name} is replaced by the text of the corresponding
+// capture group.
+type RewriteRule struct {
+	name        string
+	description string
+	pattern     *regexp.Regexp
+	template    string
+}
+
+// NewRewriteRule creates a rewrite rule with the given name, description, regular expression, and
+// feed URL template.
+func NewRewriteRule(name, description, pattern, template string) (*RewriteRule, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("rewrite rule has no name")
+	}
+	if strings.TrimSpace(template) == "" {
+		return nil, fmt.Errorf("rewrite rule %q has no feed URL template", name)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("rewrite rule %q: %w", name, err)
+	}
+	return &RewriteRule{name: name, description: description, pattern: re, template: template}, nil
+}
+
+func mustRewriteRule(name, description, pattern, template string) *RewriteRule {
+	rule, err := NewRewriteRule(name, description, pattern, template)
+	if err != nil {
+		panic(err)
+	}
+	return rule
+}
+
+// Name satisfies the URLResolver interface.
+func (r *RewriteRule) Name() string { return r.name }
+
+// Description satisfies the URLResolver interface.
+func (r *RewriteRule) Description() string { return r.description }
+
+// Pattern returns the regular expression of the page URLs rewritten by the rule.
+func (r *RewriteRule) Pattern() string { return r.pattern.String() }
+
+// Template returns the feed URL template of the rule.
+func (r *RewriteRule) Template() string { return r.template }
+
+// Resolve satisfies the URLResolver interface.
+func (r *RewriteRule) Resolve(pageURL string) (string, bool) {
+	pageURL = strings.TrimSpace(pageURL)
+	match := r.pattern.FindStringSubmatchIndex(pageURL)
+	if match == nil {
+		return "", false
+	}
+	feedURL := string(r.pattern.ExpandString(nil, r.template, pageURL, match))
+	if feedURL == "" || feedURL == pageURL {
+		return "", false
+	}
+	return feedURL, true
+}
+
+// URLResolvers is an ordered list of resolvers, the first of which that recognises a page URL
+// resolves it.
+type URLResolvers []URLResolver
+
+// Resolve returns the feed URL of the given page URL, along with the resolver that recognised
+// the page. It returns false if no resolver recognises the page.
+func (rs URLResolvers) Resolve(pageURL string) (string, URLResolver, bool) {
+	for _, r := range rs {
+		if feedURL, ok := r.Resolve(pageURL); ok {
+			return feedURL, r, true
+		}
+	}
+	return "", nil, false
+}
+
+// Override returns the given resolvers followed by those of the list, except for the ones that
+// share their names with any of the given resolvers.
+func (rs URLResolvers) Override(resolvers ...URLResolver) URLResolvers {
+	names := make(map[string]struct{}, len(resolvers))
+	merged := make(URLResolvers, 0, len(resolvers)+len(rs))
+	for _, r := range resolvers {
+		names[r.Name()] = struct{}{}
+		merged = append(merged, r)
+	}
+	for _, r := range rs {
+		if _, exists := names[r.Name()]; !exists {
+			merged = append(merged, r)
+		}
+	}
+	return merged
+}
+
+// DefaultURLResolvers returns the built-in resolvers of well-known sites.
+func DefaultURLResolvers() URLResolvers {
+	return URLResolvers{
+		mustRewriteRule(
+			"youtube-channel",
+			"YouTube channels, e.g. https://www.youtube.com/channel/UC...",
+			`(?i)^https?://(?:www\.|m\.)?youtube\.com/channel/(?P<id>UC[\w-]+)`+
+				`(?:/[^?#]*)?(?:[?#].*)?$`,
+			"https://www.youtube.com/feeds/videos.xml?channel_id=${id}",
+		),
+		mustRewriteRule(
+			"youtube-playlist",
+			"YouTube playlists, e.g. https://www.youtube.com/playlist?list=PL...",
+			`(?i)^https?://(?:www\.|m\.)?youtube\.com/playlist\?(?:[^#]*&)?list=(?P<id>[\w-]+)`,
+			"https://www.youtube.com/feeds/videos.xml?playlist_id=${id}",
+		),
+		mustRewriteRule(
+			"reddit",
+			"Subreddits, e.g. https://www.reddit.com/r/golang",
+			`(?i)^https?://(?:www\.|old\.|new\.)?reddit\.com/r/(?P<sub>\w+)/?(?:[?#].*)?$`,
+			"https://www.reddit.com/r/${sub}/.rss",
+		),
+		mustRewriteRule(
+			"github-releases",
+			"Releases of GitHub repositories, e.g. https://github.com/golang/go",
+			`(?i)^https?://(?:www\.)?github\.com/(?P<owner>[\w.-]+)/(?P<repo>[\w.-]+?)(?:\.git)?`+
+				`(?:/releases)?/?(?:[?#].*)?$`,
+			"https://github.com/${owner}/${repo}/releases.atom",
+		),
+		mustRewriteRule(
+			"medium",
+			"Medium profiles, e.g. https://medium.com/@user",
+			`(?i)^https?://(?:www\.)?medium\.com/@(?P<user>[\w.-]+)/?(?:[?#].*)?$`,
+			"https://medium.com/feed/@${user}",
+		),
+		mustRewriteRule(
+			"mastodon",
+			"Mastodon profiles, e.g. https://mastodon.social/@user",
+			`(?i)^https?://(?P<host>[^/@?#]+)/@(?P<user>\w+)/?(?:[?#].*)?$`,
+			"https://${host}/@${user}.rss",
+		),
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultURLResolvers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		pageURL  string
+		resolver string
+		feedURL  string
+	}{
+		{
+			name:     "youtube channel",
+			pageURL:  "https://www.youtube.com/channel/UCabc-123_x/videos",
+			resolver: "youtube-channel",
+			feedURL:  "https://www.youtube.com/feeds/videos.xml?channel_id=UCabc-123_x",
+		},
+		{
+			name:     "youtube playlist",
+			pageURL:  "https://youtube.com/playlist?list=PLxyz_1&si=abc",
+			resolver: "youtube-playlist",
+			feedURL:  "https://www.youtube.com/feeds/videos.xml?playlist_id=PLxyz_1",
+		},
+		{
+			name:     "subreddit",
+			pageURL:  "https://old.reddit.com/r/golang/",
+			resolver: "reddit",
+			feedURL:  "https://www.reddit.com/r/golang/.rss",
+		},
+		{
+			name:     "github repository",
+			pageURL:  "https://github.com/golang/go",
+			resolver: "github-releases",
+			feedURL:  "https://github.com/golang/go/releases.atom",
+		},
+		{
+			name:     "github releases",
+			pageURL:  "https://github.com/bow/neon.git/releases",
+			resolver: "github-releases",
+			feedURL:  "https://github.com/bow/neon/releases.atom",
+		},
+		{
+			name:     "medium profile",
+			pageURL:  "https://medium.com/@someone",
+			resolver: "medium",
+			feedURL:  "https://medium.com/feed/@someone",
+		},
+		{
+			name:     "mastodon profile",
+			pageURL:  "https://fosstodon.org/@someone/",
+			resolver: "mastodon",
+			feedURL:  "https://fosstodon.org/@someone.rss",
+		},
+	}
+
+	resolvers := DefaultURLResolvers()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := assert.New(t)
+			r := require.New(t)
+
+			feedURL, resolver, ok := resolvers.Resolve(test.pageURL)
+			r.True(ok)
+			a.Equal(test.resolver, resolver.Name())
+			a.Equal(test.feedURL, feedURL)
+		})
+	}
+}
+
+func TestDefaultURLResolversNoMatch(t *testing.T) {
+	t.Parallel()
+
+	resolvers := DefaultURLResolvers()
+	for _, pageURL := range []string{
+		"https://bar.com/feed.xml",
+		"https://github.com/golang/go/releases.atom",
+		"https://github.com/golang/go/issues/1",
+		"https://www.reddit.com/r/golang/.rss",
+		"https://fosstodon.org/@someone.rss",
+		"https://www.youtube.com/feeds/videos.xml?channel_id=UCabc",
+		"file:///home/me/feed.xml",
+	} {
+		_, _, ok := resolvers.Resolve(pageURL)
+		assert.False(t, ok, pageURL)
+	}
+}
+
+func TestURLResolversOverride(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	custom, err := NewRewriteRule(
+		"github-releases",
+		"Commits of GitHub repositories",
+		`^https://github\.com/([^/]+)/([^/]+)$`,
+		"https://github.com/$1/$2/commits.atom",
+	)
+	r.NoError(err)
+
+	defaults := DefaultURLResolvers()
+	resolvers := defaults.Override(custom)
+	r.Len(resolvers, len(defaults))
+	a.Equal(custom, resolvers[0])
+
+	feedURL, resolver, ok := resolvers.Resolve("https://github.com/golang/go")
+	r.True(ok)
+	a.Equal(custom, resolver)
+	a.Equal("https://github.com/golang/go/commits.atom", feedURL)
+}
+
+func TestNewRewriteRuleErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rname    string
+		pattern  string
+		template string
+		errMsg   string
+	}{
+		{"no name", " ", ".*", "https://a.com", "rewrite rule has no name"},
+		{"no template", "a", ".*", "", `rewrite rule "a" has no feed URL template`},
+		{"invalid pattern", "a", "(", "https://a.com", `rewrite rule "a": error parsing regexp`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := NewRewriteRule(test.rname, "", test.pattern, test.template)
+			assert.Nil(t, rule)
+			assert.ErrorContains(t, err, test.errMsg)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
+	"github.com/mmcdole/gofeed"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// NoScrapedItemsError is returned when the item selector of a scraped feed matches nothing, which
+// usually means the page layout has changed.
+type NoScrapedItemsError struct{ Selector string }
+
+func (e NoScrapedItemsError) Error() string {
+	return fmt.Sprintf("no items match selector %q", e.Selector)
+}
+
+// ScrapeURLIfModified satisfies the Parser interface.
+func (p *feedParser) ScrapeURLIfModified(
+	ctx context.Context,
+	pageURL string,
+	creds *entity.FeedCredentials,
+	spec *entity.ScrapeSpec,
+	cache *FetchCache,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	sels, err := compileScrapeSpec(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	scrape := func(body []byte, base *url.URL) (*gofeed.Feed, error) {
+		return scrapeFeed(body, base, sels)
+	}
+
+	return p.fetchIfModified(ctx, pageURL, creds, cache, scrape)
+}
+
+// scrapeSelectors are the compiled selectors of a scrape spec. Optional selectors are nil if they
+// are not set.
+type scrapeSelectors struct {
+	item, title, link, date, summary cascadia.Selector
+	itemSelector                     string
+}
+
+// compileScrapeSpec validates the given scrape spec and compiles its selectors.
+func compileScrapeSpec(spec *entity.ScrapeSpec) (*scrapeSelectors, error) {
+
+	if spec == nil {
+		return nil, entity.InvalidFeedSourceError{Reason: "missing scrape spec"}
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	compile := func(name string, selector *string) (cascadia.Selector, error) {
+		if selector == nil {
+			return nil, nil
+		}
+		sel, err := cascadia.Compile(*selector)
+		if err != nil {
+			return nil, entity.InvalidFeedSourceError{
+				Reason: fmt.Sprintf("invalid scrape %s selector %q: %s", name, *selector, err),
+			}
+		}
+		return sel, nil
+	}
+
+	var (
+		sels = scrapeSelectors{itemSelector: spec.Item}
+		err  error
+	)
+	if sels.item, err = compile("item", &spec.Item); err != nil {
+		return nil, err
+	}
+	if sels.title, err = compile("title", &spec.Title); err != nil {
+		return nil, err
+	}
+	if sels.link, err = compile("link", spec.Link); err != nil {
+		return nil, err
+	}
+	if sels.date, err = compile("date", spec.Date); err != nil {
+		return nil, err
+	}
+	if sels.summary, err = compile("summary", spec.Summary); err != nil {
+		return nil, err
+	}
+
+	return &sels, nil
+}
+
+// scrapeFeed extracts the entries of a scraped feed from the given page. Relative entry URLs are
+// resolved against the given base URL.
+func scrapeFeed(body []byte, base *url.URL, sels *scrapeSelectors) (*gofeed.Feed, error) {
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	feed := gofeed.Feed{
+		Title:       scrapedText(doc.Find("title").First()),
+		Description: attrOrEmpty(doc.Find(`meta[name="description"]`).First(), "content"),
+	}
+	if base != nil {
+		feed.Link = base.String()
+		if feed.Title == "" {
+			feed.Title = base.Host
+		}
+	}
+
+	items := doc.FindMatcher(sels.item)
+	if items.Length() == 0 {
+		return nil, NoScrapedItemsError{Selector: sels.itemSelector}
+	}
+
+	seen := make(map[string]struct{})
+	items.Each(func(_ int, s *goquery.Selection) {
+		item := scrapeItem(s, base, sels)
+		if item == nil {
+			return
+		}
+		if _, exists := seen[item.GUID]; exists {
+			return
+		}
+		seen[item.GUID] = struct{}{}
+		feed.Items = append(feed.Items, item)
+	})
+
+	return &feed, nil
+}
+
+// scrapeItem extracts a single entry from the given item element. It returns nil if the item has
+// neither a title nor a link.
+func scrapeItem(s *goquery.Selection, base *url.URL, sels *scrapeSelectors) *gofeed.Item {
+
+	var item gofeed.Item
+
+	item.Title = scrapedText(s.FindMatcher(sels.title).First())
+
+	var link *goquery.Selection
+	switch {
+	case sels.link != nil:
+		link = s.FindMatcher(sels.link).First()
+	case goquery.NodeName(s) == "a":
+		link = s
+	default:
+		link = s.Find("a[href]").First()
+	}
+	if href := strings.TrimSpace(attrOrEmpty(link, "href")); href != "" {
+		item.Link = resolveScrapedURL(href, base)
+	} else if text := scrapedText(link); text != "" {
+		item.Link = resolveScrapedURL(text, base)
+	}
+
+	if item.Title == "" && item.Link == "" {
+		return nil
+	}
+
+	if sels.date != nil {
+		date := s.FindMatcher(sels.date).First()
+		value := strings.TrimSpace(attrOrEmpty(date, "datetime"))
+		if value == "" {
+			value = scrapedText(date)
+		}
+		if t := parseScrapedTime(value); t != nil {
+			item.Published = value
+			item.PublishedParsed = t
+		}
+	}
+
+	if sels.summary != nil {
+		item.Description = scrapedText(s.FindMatcher(sels.summary).First())
+	}
+
+	item.GUID = scrapedItemID(&item)
+
+	return &item
+}
+
+// scrapedItemID returns a stable external ID for a scraped entry, derived from its link, or from
+// its title if it has no link, since scraped pages do not provide IDs of their own.
+func scrapedItemID(item *gofeed.Item) string {
+	key := item.Link
+	if key == "" {
+		key = item.Title
+	}
+	digest := sha256.Sum256([]byte(key))
+	return "scrape:" + hex.EncodeToString(digest[:16])
+}
+
+// scrapedText returns the text of the given selection with its whitespace collapsed.
+func scrapedText(s *goquery.Selection) string {
+	return strings.Join(strings.Fields(s.Text()), " ")
+}
+
+func attrOrEmpty(s *goquery.Selection, name string) string {
+	value, _ := s.Attr(name)
+	return value
+}
+
+func resolveScrapedURL(ref string, base *url.URL) string {
+	if base == nil {
+		return ref
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return u.String()
+}
+
+// scrapedTimeLayouts are the layouts tried, in order, for parsing scraped entry times.
+var scrapedTimeLayouts = []string{
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+	"2 Jan 2006",
+	"Monday, January 2, 2006",
+	"Mon, Jan 2, 2006",
+}
+
+// parseScrapedTime parses the given scraped time, returning nil if it matches none of the known
+// layouts. Times without a zone are taken to be in UTC.
+func parseScrapedTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	for _, layout := range scrapedTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// scrapeSpecRecord is the stored form of a scrape spec.
+type scrapeSpecRecord struct {
+	Item    string  `json:"item"`
+	Title   string  `json:"title"`
+	Link    *string `json:"link,omitempty"`
+	Date    *string `json:"date,omitempty"`
+	Summary *string `json:"summary,omitempty"`
+}
+
+var setFeedScrapeSpecJSON = tableFieldSetter[sql.NullString](feedsTable, "scrape_spec")
+
+// setFeedScrapeSpec stores the scrape spec of a feed. A nil spec leaves the stored spec untouched.
+func setFeedScrapeSpec(ctx context.Context, tx *sql.Tx, feedID ID, spec *entity.ScrapeSpec) error {
+	if spec == nil {
+		return nil
+	}
+	raw, err := json.Marshal(scrapeSpecRecord(*spec))
+	if err != nil {
+		return err
+	}
+	value := sql.NullString{String: string(raw), Valid: true}
+	return setFeedScrapeSpecJSON(ctx, tx, feedID, &value)
+}
+
+func fromNullScrapeSpec(v sql.NullString) (*entity.ScrapeSpec, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	var rec scrapeSpecRecord
+	if err := json.Unmarshal([]byte(v.String), &rec); err != nil {
+		return nil, err
+	}
+	spec := entity.ScrapeSpec(rec)
+	return &spec, nil
+}
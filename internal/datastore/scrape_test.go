@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+const testScrapePage = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>News A</title>
+    <meta name="description" content="The news of A">
+  </head>
+  <body>
+    <article class="post">
+      <h2>  First
+        post </h2>
+      <a href="/posts/1">Read more</a>
+      <time datetime="2026-10-01T08:00:00Z">1 October</time>
+      <p class="summary">The first post.</p>
+    </article>
+    <article class="post">
+      <h2>Second post</h2>
+      <a href="https://b.com/posts/2">Read more</a>
+      <time>October 2, 2026</time>
+    </article>
+    <article class="post">
+      <h2>First post, again</h2>
+      <a href="/posts/1">Read more</a>
+    </article>
+    <article class="post">
+      <h2>No link</h2>
+      <time>sometime</time>
+    </article>
+    <article class="post"></article>
+  </body>
+</html>`
+
+func TestFeedParserScrapeURLIfModified(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testScrapePage))
+	}))
+	defer srv.Close()
+
+	p := newFeedParser(FetcherConfig{})
+	spec := entity.ScrapeSpec{
+		Item:    "article.post",
+		Title:   "h2",
+		Date:    pointer("time"),
+		Summary: pointer(".summary"),
+	}
+
+	feed, cache, err := p.ScrapeURLIfModified(context.Background(), srv.URL, nil, &spec, nil)
+	r.NoError(err)
+	r.NotNil(feed)
+
+	a.Equal("News A", feed.Title)
+	a.Equal("The news of A", feed.Description)
+	a.Equal(srv.URL, feed.Link)
+	r.Len(feed.Items, 3)
+
+	a.Equal("First post", feed.Items[0].Title)
+	a.Equal(srv.URL+"/posts/1", feed.Items[0].Link)
+	a.Equal("The first post.", feed.Items[0].Description)
+	r.NotNil(feed.Items[0].PublishedParsed)
+	a.True(time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC).Equal(*feed.Items[0].PublishedParsed))
+
+	a.Equal("Second post", feed.Items[1].Title)
+	a.Equal("https://b.com/posts/2", feed.Items[1].Link)
+	r.NotNil(feed.Items[1].PublishedParsed)
+	a.True(time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC).Equal(*feed.Items[1].PublishedParsed))
+
+	a.Equal("No link", feed.Items[2].Title)
+	a.Empty(feed.Items[2].Link)
+	a.Nil(feed.Items[2].PublishedParsed)
+
+	// External IDs are stable across pulls and unique per entry.
+	again, _, err := p.ScrapeURLIfModified(context.Background(), srv.URL, nil, &spec, nil)
+	r.NoError(err)
+	ids := make(map[string]struct{})
+	for i, item := range feed.Items {
+		a.Equal(item.GUID, again.Items[i].GUID)
+		ids[item.GUID] = struct{}{}
+	}
+	a.Len(ids, 3)
+
+	// Unchanged pages are not scraped again.
+	feed, _, err = p.ScrapeURLIfModified(context.Background(), srv.URL, nil, &spec, cache)
+	r.NoError(err)
+	a.Nil(feed)
+}
+
+func TestFeedParserScrapeURLIfModifiedErr(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testScrapePage))
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name    string
+		spec    *entity.ScrapeSpec
+		wantErr error
+	}{
+		{
+			name:    "no spec",
+			wantErr: entity.InvalidFeedSourceError{Reason: "missing scrape spec"},
+		},
+		{
+			name:    "no title selector",
+			spec:    &entity.ScrapeSpec{Item: "article"},
+			wantErr: entity.InvalidFeedSourceError{Reason: "scrape title selector is required"},
+		},
+		{
+			name:    "no items",
+			spec:    &entity.ScrapeSpec{Item: "li.post", Title: "h2"},
+			wantErr: NoScrapedItemsError{Selector: "li.post"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed, _, err := newFeedParser(FetcherConfig{}).ScrapeURLIfModified(
+				context.Background(),
+				srv.URL,
+				nil,
+				test.spec,
+				nil,
+			)
+			assert.Nil(t, feed)
+			assert.ErrorIs(t, err, test.wantErr)
+		})
+	}
+
+	_, _, err := newFeedParser(FetcherConfig{}).ScrapeURLIfModified(
+		context.Background(),
+		srv.URL,
+		nil,
+		&entity.ScrapeSpec{Item: "article[", Title: "h2"},
+		nil,
+	)
+	assert.ErrorAs(t, err, &entity.InvalidFeedSourceError{})
+}
+
+func TestParseScrapedTime(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]*time.Time{
+		"2026-10-18T12:30:00+02:00": pointer(time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)),
+		"2026-10-18":                pointer(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)),
+		"18 Oct 2026":               pointer(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)),
+		"Sunday, October 18, 2026":  pointer(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)),
+		"yesterday":                 nil,
+		"":                          nil,
+	}
+
+	for value, want := range tests {
+		got := parseScrapedTime(value)
+		if want == nil {
+			assert.Nil(t, got, value)
+		} else if assert.NotNil(t, got, value) {
+			assert.True(t, want.Equal(*got), value)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// DefaultExecTimeout is the default maximum time the command of an exec: feed may run for.
+const DefaultExecTimeout = time.Minute
+
+// maxCommandStderr is the maximum number of bytes of the standard error of a failed command that
+// is kept in its error.
+const maxCommandStderr = 1024
+
+// CommandError is returned when the command of an exec: feed fails.
+type CommandError struct {
+	Err    error
+	Stderr string
+}
+
+func (e CommandError) Error() string {
+	if e.Stderr == "" {
+		return fmt.Sprintf("command failed: %s", e.Err)
+	}
+	return fmt.Sprintf("command failed: %s: %s", e.Err, e.Stderr)
+}
+
+func (e CommandError) Unwrap() error {
+	return e.Err
+}
+
+// isLocalFeedURL checks whether the given URL points to a local feed file, command, or mailbox,
+// which are read without any network requests.
+func isLocalFeedURL(feedURL string) bool {
+	if _, ok := entity.ExecCommand(feedURL); ok {
+		return true
+	}
+	return isFileURL(feedURL) || isMailboxURL(feedURL)
+}
+
+// checkLocalFeedURL returns an error if the given URL points to a local feed, and local feeds are
+// not allowed.
+func (db *SQLite) checkLocalFeedURL(feedURL string) error {
+	if !db.allowLocalFeeds && isLocalFeedURL(feedURL) {
+		return entity.InvalidFeedSourceError{Reason: "local feeds are not allowed"}
+	}
+	return nil
+}
+
+// isFileURL checks whether the given URL is a file:// URL.
+func isFileURL(feedURL string) bool {
+	u, err := url.Parse(feedURL)
+	return err == nil && strings.EqualFold(u.Scheme, "file")
+}
+
+// parseFeed parses the feed at the given URL only if it has changed since the given cache state
+// was recorded. The command of exec: feeds is run with the given options, pages with a scrape spec
+// are scraped, and other feeds are parsed. Feeds are fetched with the given credentials.
+func parseFeed(
+	ctx context.Context,
+	parser Parser,
+	feedURL string,
+	creds *entity.FeedCredentials,
+	execOpts *entity.ExecOptions,
+	scrape *entity.ScrapeSpec,
+	cache *FetchCache,
+) (*gofeed.Feed, *FetchCache, error) {
+	if command, ok := entity.ExecCommand(feedURL); ok {
+		return parser.ParseCommandIfModified(ctx, command, execOpts, cache)
+	}
+	if scrape != nil {
+		return parser.ScrapeURLIfModified(ctx, feedURL, creds, scrape, cache)
+	}
+	return parser.ParseURLIfModified(ctx, feedURL, creds, cache)
+}
+
+// parseFileIfModified parses the feed file at the given file:// URL only if its contents have
+// changed since the given cache state was recorded.
+func (p *feedParser) parseFileIfModified(
+	fileURL string,
+	cache *FetchCache,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if host := u.Hostname(); host != "" && host != "localhost" {
+		return nil, nil, entity.InvalidFeedSourceError{
+			Reason: fmt.Sprintf("file URL host %q is not local", host),
+		}
+	}
+
+	f, err := os.Open(u.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := p.fetcher.readAll(f)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newCache := FetchCache{
+		LastModified: pointer(info.ModTime().UTC().Format(http.TimeFormat)),
+	}
+
+	return parseIfChanged(body, u, cache, &newCache, p.parseBody)
+}
+
+// ParseCommandIfModified satisfies the Parser interface.
+func (p *feedParser) ParseCommandIfModified(
+	ctx context.Context,
+	command string,
+	opts *entity.ExecOptions,
+	cache *FetchCache,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	if command == "" {
+		return nil, nil, entity.InvalidFeedSourceError{Reason: "empty command"}
+	}
+	if opts == nil {
+		opts = &entity.ExecOptions{}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+	timeout := deref(opts.Timeout, DefaultExecTimeout)
+
+	cctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// #nosec G204: running the command of the feed is the point.
+	cmd := exec.CommandContext(cctx, "sh", "-c", command)
+	cmd.Dir = deref(opts.Dir, "")
+	// Commands may leave behind child processes holding on to the output pipes.
+	cmd.WaitDelay = time.Second
+
+	stderr := cappedBuffer{size: maxCommandStderr}
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = cmd.Start(); err != nil {
+		return nil, nil, CommandError{Err: err}
+	}
+	body, rerr := p.fetcher.readAll(stdout)
+	if rerr != nil {
+		cancel()
+	}
+	werr := cmd.Wait()
+
+	switch {
+	case ctx.Err() != nil:
+		return nil, nil, ctx.Err()
+	case errors.Is(cctx.Err(), context.DeadlineExceeded):
+		return nil, nil, CommandError{Err: fmt.Errorf("timed out after %s", timeout)}
+	case rerr != nil:
+		return nil, nil, rerr
+	case werr != nil:
+		return nil, nil, CommandError{Err: werr, Stderr: strings.TrimSpace(string(stderr.buf))}
+	}
+
+	return parseIfChanged(body, nil, cache, &FetchCache{}, p.parseBody)
+}
+
+// cappedBuffer keeps the first bytes written to it, up to its size, and discards the rest.
+type cappedBuffer struct {
+	buf  []byte
+	size int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if n := b.size - len(b.buf); n > 0 {
+		b.buf = append(b.buf, p[:min(n, len(p))]...)
+	}
+	return len(p), nil
+}
+
+var (
+	setFeedExecDir     = tableFieldSetter[sql.NullString](feedsTable, "exec_dir")
+	setFeedExecTimeout = tableFieldSetter[sql.NullInt64](feedsTable, "exec_timeout")
+)
+
+// setFeedExecOptions stores the options of an exec: feed. Options that are not set are left
+// untouched.
+func setFeedExecOptions(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	opts *entity.ExecOptions,
+) error {
+
+	if opts == nil {
+		return nil
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	if opts.Dir != nil {
+		dir := sql.NullString{String: *opts.Dir, Valid: true}
+		if err := setFeedExecDir(ctx, tx, feedID, &dir); err != nil {
+			return err
+		}
+	}
+	if opts.Timeout != nil {
+		timeout := sql.NullInt64{Int64: int64(math.Ceil(opts.Timeout.Seconds())), Valid: true}
+		if err := setFeedExecTimeout(ctx, tx, feedID, &timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestFeedParserParseFileURL(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "feed.xml")
+	r.NoError(os.WriteFile(path, []byte(testRSS), 0o600))
+
+	p := newFeedParser(FetcherConfig{})
+	fileURL := "file://" + filepath.ToSlash(path)
+
+	feed, cache, err := p.ParseURLIfModified(context.Background(), fileURL, nil, nil)
+	r.NoError(err)
+	r.NotNil(feed)
+	a.Equal("Feed A", feed.Title)
+	a.NotNil(cache.ContentHash)
+	a.NotNil(cache.LastModified)
+	a.Zero(cache.StatusCode)
+
+	// Unchanged files are not parsed again.
+	feed, ncache, err := p.ParseURLIfModified(context.Background(), fileURL, nil, cache)
+	r.NoError(err)
+	a.Nil(feed)
+	a.Equal(cache.ContentHash, ncache.ContentHash)
+
+	_, _, err = p.ParseURLIfModified(context.Background(), fileURL+".missing", nil, nil)
+	a.ErrorIs(err, os.ErrNotExist)
+
+	_, _, err = p.ParseURLIfModified(context.Background(), "file://remote.com/feed.xml", nil, nil)
+	a.ErrorAs(err, &entity.InvalidFeedSourceError{})
+}
+
+func TestFeedParserParseCommand(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	dir := t.TempDir()
+	r.NoError(os.WriteFile(filepath.Join(dir, "feed.xml"), []byte(testRSS), 0o600))
+
+	p := newFeedParser(FetcherConfig{})
+	opts := &entity.ExecOptions{Dir: &dir}
+
+	feed, cache, err := p.ParseCommandIfModified(context.Background(), "cat feed.xml", opts, nil)
+	r.NoError(err)
+	r.NotNil(feed)
+	a.Equal("Feed A", feed.Title)
+	a.NotNil(cache.ContentHash)
+
+	feed, ncache, err := p.ParseCommandIfModified(context.Background(), "cat feed.xml", opts, cache)
+	r.NoError(err)
+	a.Nil(feed)
+	a.Equal(cache.ContentHash, ncache.ContentHash)
+}
+
+func TestFeedParserParseCommandErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		command string
+		opts    *entity.ExecOptions
+		cfg     FetcherConfig
+		wantErr string
+	}{
+		{
+			name:    "exit status",
+			command: "echo oh no >&2; exit 3",
+			wantErr: "command failed: exit status 3: oh no",
+		},
+		{
+			name:    "timeout",
+			command: "sleep 5",
+			opts:    &entity.ExecOptions{Timeout: pointer(50 * time.Millisecond)},
+			wantErr: "command failed: timed out after 50ms",
+		},
+		{
+			name:    "output too large",
+			command: "yes",
+			cfg:     FetcherConfig{MaxResponseSize: 1024},
+			wantErr: "response body exceeds 1024 bytes",
+		},
+		{
+			name:    "relative dir",
+			command: "true",
+			opts:    &entity.ExecOptions{Dir: pointer("reports")},
+			wantErr: "invalid feed source: command directory must be an absolute path",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := assert.New(t)
+
+			start := time.Now()
+			feed, cache, err := newFeedParser(test.cfg).ParseCommandIfModified(
+				context.Background(),
+				test.command,
+				test.opts,
+				nil,
+			)
+			a.Nil(feed)
+			a.Nil(cache)
+			a.EqualError(err, test.wantErr)
+			a.Less(time.Since(start), 3*time.Second)
+		})
+	}
+}
+
+func TestIsLocalFeedURL(t *testing.T) {
+	t.Parallel()
+
+	for feedURL, want := range map[string]bool{
+		"file:///tmp/feed.xml":    true,
+		"FILE:///tmp/feed.xml":    true,
+		"exec:./report.sh":        true,
+		"Exec: cat feed.xml":      true,
+		"https://a.com/feed.xml":  false,
+		"http://a.com/exec:x.xml": false,
+		"a.com/feed.xml":          false,
+	} {
+		assert.Equal(t, want, isLocalFeedURL(feedURL), feedURL)
+	}
+}
@@ -9,24 +9,85 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
-	"github.com/mmcdole/gofeed"
 
 	"github.com/bow/neon/internal/datastore/migration"
+	"github.com/bow/neon/internal/entity"
 )
 
+// DefaultPullConcurrency is the default maximum number of feeds fetched concurrently by PullFeeds.
+const DefaultPullConcurrency = 8
+
 type SQLite struct {
 	mu     sync.RWMutex
 	handle *sql.DB
 	parser Parser
+
+	pullConcurrency int
+	hosts           *hostLimiter
+	retention       entity.RetentionPolicy
+	resolvers       URLResolvers
+	allowLocalFeeds bool
 }
 
 // Ensure SQLite implements Datastore.
 var _ Datastore = new(SQLite)
 
 func NewSQLite(filename string) (*SQLite, error) {
-	return newSQLiteWithParser(filename, gofeed.NewParser())
+	return newSQLiteWithParser(filename, newFeedParser(FetcherConfig{}))
+}
+
+// SetPullConcurrency sets the maximum number of feeds fetched concurrently by PullFeeds. Values
+// less than 1 are ignored.
+func (db *SQLite) SetPullConcurrency(n int) {
+	if n < 1 {
+		return
+	}
+	db.pullConcurrency = n
+}
+
+// SetPullConcurrencyPerHost sets the maximum number of feeds fetched concurrently from the same
+// host by PullFeeds. Values less than 1 are ignored.
+func (db *SQLite) SetPullConcurrencyPerHost(n int) {
+	if n < 1 {
+		return
+	}
+	db.hosts = newHostLimiter(n, db.hosts.minDelay)
+}
+
+// SetPullHostDelay sets the minimum delay between the start of consecutive requests to the same
+// host by PullFeeds. Negative values are ignored.
+func (db *SQLite) SetPullHostDelay(delay time.Duration) {
+	if delay < 0 {
+		return
+	}
+	db.hosts = newHostLimiter(db.hosts.maxConcurrent, delay)
+}
+
+// SetRetentionPolicy sets the global retention policy, which is enforced after each pull and by
+// PruneEntries.
+func (db *SQLite) SetRetentionPolicy(policy entity.RetentionPolicy) {
+	db.retention = policy
+}
+
+// SetFetcherConfig sets how feeds and web pages are fetched, replacing the parser of the
+// datastore.
+func (db *SQLite) SetFetcherConfig(cfg FetcherConfig) {
+	db.parser = newFeedParser(cfg)
+}
+
+// SetURLResolvers sets the resolvers with which AddFeed rewrites the URLs of pages of well-known
+// sites into the URLs of their feeds, replacing the default resolvers.
+func (db *SQLite) SetURLResolvers(resolvers URLResolvers) {
+	db.resolvers = resolvers
+}
+
+// SetAllowLocalFeeds sets whether local feed files, commands, and mailboxes may be added, pulled,
+// and discovered. They are allowed by default.
+func (db *SQLite) SetAllowLocalFeeds(allow bool) {
+	db.allowLocalFeeds = allow
 }
 
 func newSQLiteWithParser(filename string, parser Parser) (*SQLite, error) {
@@ -63,7 +124,14 @@ func newSQLiteWithParser(filename string, parser Parser) (*SQLite, error) {
 		return nil, fail(err)
 	}
 
-	db := SQLite{handle: handle, parser: parser}
+	db := SQLite{
+		handle:          handle,
+		parser:          parser,
+		pullConcurrency: DefaultPullConcurrency,
+		hosts:           newHostLimiter(DefaultPullConcurrencyPerHost, DefaultPullHostDelay),
+		resolvers:       DefaultURLResolvers(),
+		allowLocalFeeds: true,
+	}
 
 	return &db, nil
 }
@@ -6,6 +6,9 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mmcdole/gofeed"
@@ -13,7 +16,9 @@ import (
 	"github.com/bow/neon/internal/entity"
 )
 
-// AddFeed adds the given feed into the database.
+// AddFeed adds the given feed into the database. The feed is fetched with the given credentials,
+// generated with the given command options for exec: feeds, or scraped with the given scrape spec
+// for web pages without feeds. These are stored for subsequent pulls.
 func (db *SQLite) AddFeed(
 	ctx context.Context,
 	feedURL string,
@@ -21,11 +26,60 @@ func (db *SQLite) AddFeed(
 	desc *string,
 	tags []string,
 	isStarred *bool,
+	creds *entity.FeedCredentials,
+	execOpts *entity.ExecOptions,
+	scrape *entity.ScrapeSpec,
 	pullTimeout *time.Duration,
 ) (*entity.Feed, bool, error) {
 
 	fail := failF("SQLite.AddFeed")
 
+	if err := db.checkLocalFeedURL(feedURL); err != nil {
+		return nil, false, fail(err)
+	}
+	if creds != nil {
+		if err := creds.Validate(); err != nil {
+			return nil, false, fail(err)
+		}
+	}
+	if command, ok := entity.ExecCommand(feedURL); ok {
+		if command == "" {
+			return nil, false, fail(entity.InvalidFeedSourceError{Reason: "empty command"})
+		}
+		feedURL = entity.ExecScheme + ":" + command
+	} else if execOpts != nil && !execOpts.IsEmpty() {
+		return nil, false, fail(
+			entity.InvalidFeedSourceError{Reason: "command options require an exec: feed URL"},
+		)
+	}
+	if execOpts != nil {
+		if err := execOpts.Validate(); err != nil {
+			return nil, false, fail(err)
+		}
+	}
+	if scrape != nil {
+		if isLocalFeedURL(feedURL) {
+			return nil, false, fail(
+				entity.InvalidFeedSourceError{Reason: "only web pages can be scraped"},
+			)
+		}
+		if _, err := compileScrapeSpec(scrape); err != nil {
+			return nil, false, fail(err)
+		}
+	}
+
+	// Pages of well-known sites are added through the feeds they are known to have.
+	if scrape == nil && !isLocalFeedURL(feedURL) {
+		if resolved, resolver, ok := db.resolvers.Resolve(feedURL); ok {
+			pkgLogger.Debug().
+				Str("resolver", resolver.Name()).
+				Str("page_url", feedURL).
+				Str("feed_url", resolved).
+				Msg("resolved feed URL")
+			feedURL = resolved
+		}
+	}
+
 	var (
 		actx   = ctx
 		cancel context.CancelFunc
@@ -35,13 +89,35 @@ func (db *SQLite) AddFeed(
 		defer cancel()
 	}
 
-	feed, err := db.parser.ParseURLWithContext(feedURL, actx)
+	// Mailboxes are split into the feeds of their sender groups, so a whole mailbox is added
+	// through the feed of its only group.
+	if isMailboxURL(feedURL) {
+		src, err := parseMailboxURL(feedURL)
+		if err != nil {
+			return nil, false, fail(err)
+		}
+		if src.group == "" {
+			if feedURL, err = db.discoverFeedURL(actx, feedURL); err != nil {
+				return nil, false, fail(err)
+			}
+		}
+	}
+
+	feed, cache, err := parseFeed(actx, db.parser, feedURL, creds, execOpts, scrape, nil)
+	// The URL may point to a web page instead, in which case we look for the feeds it advertises.
+	if errors.Is(err, gofeed.ErrFeedTypeNotDetected) && !isLocalFeedURL(feedURL) && scrape == nil {
+		if feedURL, err = db.discoverFeedURL(actx, feedURL); err != nil {
+			return nil, false, fail(err)
+		}
+		feed, cache, err = db.parser.ParseURLIfModified(actx, feedURL, creds, nil)
+	}
 	if err != nil {
 		return nil, false, err
 	}
-	// Handle possible specs deviations.
-	if feed.FeedLink == "" {
-		feed.FeedLink = feedURL
+	// Feeds are stored under the URL they are fetched from, instead of the URL they report, since
+	// the latter may be stale.
+	if v := cache.PermanentURL; v != nil {
+		feedURL = *v
 	}
 
 	var (
@@ -55,7 +131,7 @@ func (db *SQLite) AddFeed(
 		feedID, feedAdded, ierr := upsertFeed(
 			ctx,
 			tx,
-			feed.FeedLink,
+			feedURL,
 			pointerOrNil(deref(title, feed.Title)),
 			pointerOrNil(deref(desc, feed.Description)),
 			pointerOrNil(feed.Link),
@@ -67,7 +143,31 @@ func (db *SQLite) AddFeed(
 			return ierr
 		}
 
-		if ierr = upsertEntries(ctx, tx, feedID, feed.Items); ierr != nil {
+		if ierr = setFeedFetchCache(ctx, tx, feedID, cache); ierr != nil {
+			return ierr
+		}
+
+		if ierr = storeFeedRefreshHints(ctx, tx, feedID, feed, cache, now); ierr != nil {
+			return ierr
+		}
+
+		if ierr = storeFeedWebSub(ctx, tx, feedID, feedURL, feed); ierr != nil {
+			return ierr
+		}
+
+		if ierr = setFeedCredentials(ctx, tx, feedID, creds); ierr != nil {
+			return ierr
+		}
+
+		if ierr = setFeedExecOptions(ctx, tx, feedID, execOpts); ierr != nil {
+			return ierr
+		}
+
+		if ierr = setFeedScrapeSpec(ctx, tx, feedID, scrape); ierr != nil {
+			return ierr
+		}
+
+		if _, ierr = upsertEntries(ctx, tx, feedID, feed.Items); ierr != nil {
 			return ierr
 		}
 
@@ -100,6 +200,22 @@ func (db *SQLite) AddFeed(
 	return record.feed(), *added, nil
 }
 
+// discoverFeedURL returns the URL of the only feed advertised by the given page URL.
+func (db *SQLite) discoverFeedURL(ctx context.Context, pageURL string) (string, error) {
+	cands, err := db.parser.DiscoverFeeds(ctx, pageURL)
+	if err != nil {
+		return "", err
+	}
+	switch len(cands) {
+	case 0:
+		return "", entity.NoFeedFoundError{URL: pageURL}
+	case 1:
+		return cands[0].URL, nil
+	default:
+		return "", entity.AmbiguousFeedError{URL: pageURL, Candidates: cands}
+	}
+}
+
 func upsertFeed(
 	ctx context.Context,
 	tx *sql.Tx,
@@ -209,12 +325,14 @@ func editFeedWithFeedURL(
 	return feedID, nil
 }
 
+// upsertEntries adds the given entries to a feed, or updates them if they already exist, and
+// returns the IDs of the added entries. Entries that were pruned from the feed are skipped.
 func upsertEntries(
 	ctx context.Context,
 	tx *sql.Tx,
 	feedID ID,
 	entries []*gofeed.Item,
-) error {
+) (added []ID, err error) {
 
 	sql1 := `
 		INSERT INTO
@@ -225,14 +343,18 @@ func upsertEntries(
 				, title
 				, description
 				, content
+				, authors
+				, tags
 				, pub_time
 				, update_time
 			)
-			VALUES(?, ?, ?, ?, ?, ?, ?, ?)
+			VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING
+			id
 `
 	stmt1, err := tx.PrepareContext(ctx, sql1)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer stmt1.Close()
 
@@ -254,45 +376,146 @@ func upsertEntries(
 `
 	stmt2, err := tx.PrepareContext(ctx, sql2)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer stmt2.Close()
 
-	upsert := func(entry *gofeed.Item, insertStmt, updateStmt *sql.Stmt) error {
-		updateTime := resolveEntryUpdateTime(entry)
-		_, err := insertStmt.ExecContext(
+	sql3 := `
+		UPDATE
+			entries
+		SET
+			authors = $1
+			, tags = $2
+		WHERE
+			feed_id = $3
+			AND external_id = $4
+		RETURNING
+			id
+`
+	stmt3, err := tx.PrepareContext(ctx, sql3)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt3.Close()
+
+	sql4 := `
+		INSERT INTO
+			entry_enclosures(
+				entry_id
+				, url
+				, mime_type
+				, length
+			)
+			VALUES(?, ?, ?, ?)
+		ON CONFLICT(entry_id, url) DO UPDATE SET
+			mime_type = excluded.mime_type
+			, length = excluded.length
+`
+	stmt4, err := tx.PrepareContext(ctx, sql4)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt4.Close()
+
+	sql5 := `SELECT EXISTS (SELECT 1 FROM pruned_entries WHERE feed_id = ? AND external_id = ?)`
+	stmt5, err := tx.PrepareContext(ctx, sql5)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt5.Close()
+
+	upsert := func(entry *gofeed.Item) (entryID ID, added bool, err error) {
+		var (
+			updateTime = resolveEntryUpdateTime(entry)
+			authors    = jsonArrayString(resolveEntryAuthors(entry))
+			categories = jsonArrayString(entry.Categories)
+		)
+		if categories == nil {
+			categories = jsonArrayString{}
+		}
+		err = stmt1.QueryRowContext(
 			ctx,
 			feedID,
 			entry.GUID,
-			entry.Link,
+			pointerOrNil(entry.Link),
 			entry.Title,
 			pointerOrNil(entry.Description),
 			pointerOrNil(entry.Content),
+			&authors,
+			&categories,
 			resolveEntryPublishedTime(entry),
 			updateTime,
-		)
+		).Scan(&entryID)
 		if err != nil {
 			if !isUniqueErr(err, "UNIQUE constraint failed: entries.feed_id, entries.external_id") {
-				return err
+				return 0, false, err
 			}
-			if _, ierr := updateStmt.ExecContext(
+			if _, ierr := stmt2.ExecContext(
 				ctx,
 				updateTime,
 				feedID,
 				entry.GUID,
 			); ierr != nil {
-				return ierr
+				return 0, false, ierr
+			}
+			if ierr := stmt3.QueryRowContext(
+				ctx,
+				&authors,
+				&categories,
+				feedID,
+				entry.GUID,
+			).Scan(&entryID); ierr != nil {
+				return 0, false, ierr
+			}
+		} else {
+			added = true
+		}
+
+		for _, enc := range entry.Enclosures {
+			if enc == nil || strings.TrimSpace(enc.URL) == "" {
+				continue
+			}
+			if _, err := stmt4.ExecContext(
+				ctx,
+				entryID,
+				enc.URL,
+				pointerOrNil(enc.Type),
+				parseEnclosureLength(enc.Length),
+			); err != nil {
+				return 0, false, err
 			}
 		}
-		return nil
+
+		return entryID, added, nil
 	}
 
 	for _, entry := range entries {
-		if err := upsert(entry, stmt1, stmt2); err != nil {
-			return err
+		var isPruned bool
+		if err := stmt5.QueryRowContext(ctx, feedID, entry.GUID).Scan(&isPruned); err != nil {
+			return nil, err
+		}
+		if isPruned {
+			continue
+		}
+		entryID, isAdded, err := upsert(entry)
+		if err != nil {
+			return nil, err
+		}
+		if isAdded {
+			added = append(added, entryID)
 		}
 	}
-	return nil
+	return added, nil
+}
+
+// parseEnclosureLength parses the declared length of an enclosure, returning nil if it is not a
+// positive integer.
+func parseEnclosureLength(raw string) *int64 {
+	n, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil || n <= 0 {
+		return nil
+	}
+	return &n
 }
 
 func addFeedTags(
@@ -6,11 +6,14 @@ package datastore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/mmcdole/gofeed"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
 )
 
 func TestAddFeedOkMinimal(t *testing.T) {
@@ -28,8 +31,8 @@ func TestAddFeedOkMinimal(t *testing.T) {
 	}
 
 	db.parser.EXPECT().
-		ParseURLWithContext(feed.Link, gomock.Any()).
-		Return(&feed, nil)
+		ParseURLIfModified(gomock.Any(), feed.FeedLink, nil, nil).
+		Return(&feed, &FetchCache{}, nil)
 
 	existf := func() bool {
 		return db.rowExists(
@@ -47,7 +50,18 @@ func TestAddFeedOkMinimal(t *testing.T) {
 	a.Equal(0, db.countFeedTags())
 	a.False(existf())
 
-	record, added, err := db.AddFeed(context.Background(), feed.Link, nil, nil, nil, nil, nil)
+	record, added, err := db.AddFeed(
+		context.Background(),
+		feed.FeedLink,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
 	r.NoError(err)
 
 	a.True(added)
@@ -103,8 +117,8 @@ func TestAddFeedOkExtended(t *testing.T) {
 	)
 
 	db.parser.EXPECT().
-		ParseURLWithContext(feed.Link, gomock.Any()).
-		Return(&feed, nil)
+		ParseURLIfModified(gomock.Any(), feed.FeedLink, nil, nil).
+		Return(&feed, &FetchCache{}, nil)
 
 	existf1 := func() bool {
 		return db.rowExists(
@@ -133,12 +147,15 @@ func TestAddFeedOkExtended(t *testing.T) {
 
 	record, added, err := db.AddFeed(
 		context.Background(),
-		feed.Link,
+		feed.FeedLink,
 		&title,
 		&description,
 		tags,
 		&isStarred,
 		nil,
+		nil,
+		nil,
+		nil,
 	)
 	r.NoError(err)
 
@@ -196,8 +213,8 @@ func TestAddFeedOkURLExists(t *testing.T) {
 	)
 
 	db.parser.EXPECT().
-		ParseURLWithContext(feed.Link, gomock.Any()).
-		Return(&feed, nil)
+		ParseURLIfModified(gomock.Any(), feed.FeedLink, nil, nil).
+		Return(&feed, &FetchCache{}, nil)
 
 	db.addFeedWithURL(feed.FeedLink)
 
@@ -224,12 +241,15 @@ func TestAddFeedOkURLExists(t *testing.T) {
 
 	record, added, err := db.AddFeed(
 		context.Background(),
-		feed.Link,
+		feed.FeedLink,
 		nil,
 		nil,
 		tags,
 		pointer(true),
 		nil,
+		nil,
+		nil,
+		nil,
 	)
 	r.NoError(err)
 
@@ -276,3 +296,493 @@ const feedEntryExistSQL = `
 		AND e.title = ?
 		AND e.url = ?
 `
+
+func TestAddFeedOkPermanentRedirect(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	var (
+		oldURL = "http://bar.com/feed.xml"
+		newURL = "https://bar.com/rss"
+	)
+	feed := gofeed.Feed{
+		Title:    "feed-title",
+		Link:     "https://bar.com",
+		FeedLink: "https://bar.com/feed.xml",
+	}
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), oldURL, nil, nil).
+		Return(&feed, &FetchCache{ETag: pointer(`"v1"`), PermanentURL: &newURL}, nil)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		oldURL,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal(newURL, record.FeedURL)
+	a.True(db.rowExists(
+		`SELECT * FROM feeds WHERE feed_url = ? AND http_etag = ?`,
+		newURL,
+		`"v1"`,
+	))
+}
+
+func TestAddFeedOkCredentials(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	feed := gofeed.Feed{Title: "feed-title", FeedLink: "https://bar.com/feed.xml"}
+	creds := &entity.FeedCredentials{Token: pointer("t0k")}
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), feed.FeedLink, creds, nil).
+		Return(&feed, &FetchCache{}, nil)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		feed.FeedLink,
+		nil,
+		nil,
+		nil,
+		nil,
+		creds,
+		nil,
+		nil,
+		nil,
+	)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal(feed.Title, record.Title)
+	a.True(db.rowExists(
+		`SELECT * FROM feeds WHERE id = ? AND auth_token = 't0k' AND auth_username IS NULL`,
+		record.ID,
+	))
+}
+
+func TestAddFeedOkExec(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	feed := gofeed.Feed{Title: "Reports"}
+	opts := &entity.ExecOptions{Dir: pointer("/srv/reports"), Timeout: pointer(time.Minute)}
+
+	db.parser.EXPECT().
+		ParseCommandIfModified(gomock.Any(), "./report.sh --atom", opts, nil).
+		Return(&feed, &FetchCache{}, nil)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		"exec: ./report.sh --atom",
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		opts,
+		nil,
+		nil,
+	)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal(feed.Title, record.Title)
+	a.Equal("exec:./report.sh --atom", record.FeedURL)
+	a.True(db.rowExists(
+		`SELECT * FROM feeds WHERE id = ? AND exec_dir = '/srv/reports' AND exec_timeout = 60`,
+		record.ID,
+	))
+
+	// The stored options are used for pulling the feed.
+	db.parser.EXPECT().
+		ParseCommandIfModified(gomock.Any(), "./report.sh --atom", opts, gomock.Any()).
+		Return(nil, &FetchCache{}, nil)
+
+	for res := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		a.Equal(entity.PullNotModified, res.Status())
+	}
+}
+
+func TestAddFeedOkMailbox(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	mailboxURL := "maildir:///home/me/Mail"
+	groupURL := mailboxURL + "?group=weekly.example.com"
+	feed := gofeed.Feed{
+		Title: "Weekly News",
+		Items: []*gofeed.Item{{GUID: "issue1@weekly.example.com", Title: "Issue 1"}},
+	}
+
+	// A whole mailbox is added through the feed of its only sender group.
+	gomock.InOrder(
+		db.parser.EXPECT().
+			DiscoverFeeds(gomock.Any(), mailboxURL).
+			Return([]*entity.FeedCandidate{{URL: groupURL}}, nil),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), groupURL, nil, nil).
+			Return(&feed, &FetchCache{ContentHash: pointer("abc")}, nil),
+	)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		mailboxURL,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal("Weekly News", record.Title)
+	a.Equal(groupURL, record.FeedURL)
+	a.Equal(1, db.countEntries(groupURL))
+}
+
+func TestAddFeedOkResolvedURL(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	pageURL := "https://github.com/bow/neon"
+	feedURL := "https://github.com/bow/neon/releases.atom"
+	feed := gofeed.Feed{Title: "Release notes from neon", Link: pageURL}
+
+	// The page URL is rewritten before anything is fetched.
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), feedURL, nil, nil).
+		Return(&feed, &FetchCache{}, nil)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		pageURL,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal(feedURL, record.FeedURL)
+	a.Equal(pointer(pageURL), record.SiteURL)
+}
+
+func TestAddFeedErrInvalidExec(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		feedURL string
+		opts    *entity.ExecOptions
+		reason  string
+	}{
+		{
+			name:    "empty command",
+			feedURL: "exec: ",
+			reason:  "empty command",
+		},
+		{
+			name:    "not an exec feed",
+			feedURL: "https://bar.com/feed.xml",
+			opts:    &entity.ExecOptions{Timeout: pointer(time.Minute)},
+			reason:  "command options require an exec: feed URL",
+		},
+		{
+			name:    "relative dir",
+			feedURL: "exec:./report.sh",
+			opts:    &entity.ExecOptions{Dir: pointer("reports")},
+			reason:  "command directory must be an absolute path",
+		},
+	}
+
+	db := newTestSQLiteDB(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			_, _, err := db.AddFeed(
+				context.Background(),
+				test.feedURL,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				test.opts,
+				nil,
+				nil,
+			)
+			a.ErrorIs(err, entity.InvalidFeedSourceError{Reason: test.reason})
+			a.Equal(0, db.countFeeds())
+		})
+	}
+}
+
+func TestAddFeedErrLocalFeedsNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetAllowLocalFeeds(false)
+
+	for _, feedURL := range []string{
+		"exec:./report.sh",
+		"file:///home/user/feed.xml",
+		"maildir:/home/user/Mail",
+		"mbox:/home/user/inbox.mbox",
+	} {
+		_, _, err := db.AddFeed(
+			context.Background(),
+			feedURL,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		a.ErrorIs(err, entity.InvalidFeedSourceError{Reason: "local feeds are not allowed"}, feedURL)
+	}
+	a.Equal(0, db.countFeeds())
+}
+
+func TestAddFeedOkScrape(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	pageURL := "https://bar.com/news"
+	feed := gofeed.Feed{Title: "News", Items: []*gofeed.Item{{GUID: "scrape:1", Title: "Post"}}}
+	spec := &entity.ScrapeSpec{Item: "article", Title: "h2", Date: pointer("time")}
+
+	db.parser.EXPECT().
+		ScrapeURLIfModified(gomock.Any(), pageURL, nil, spec, nil).
+		Return(&feed, &FetchCache{}, nil)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		pageURL,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		spec,
+		nil,
+	)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal(feed.Title, record.Title)
+	a.Equal(1, db.countEntries(pageURL))
+	a.True(db.rowExists(
+		`SELECT * FROM feeds WHERE id = ? AND json_extract(scrape_spec, '$.date') = 'time'`,
+		record.ID,
+	))
+
+	// The stored spec is used for pulling the feed.
+	db.parser.EXPECT().
+		ScrapeURLIfModified(gomock.Any(), pageURL, nil, spec, gomock.Any()).
+		Return(nil, &FetchCache{}, nil)
+
+	for res := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		a.Equal(entity.PullNotModified, res.Status())
+	}
+}
+
+func TestAddFeedErrInvalidScrape(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	_, _, err := db.AddFeed(
+		context.Background(),
+		"exec:./news.sh",
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		&entity.ScrapeSpec{Item: "article", Title: "h2"},
+		nil,
+	)
+	a.ErrorIs(err, entity.InvalidFeedSourceError{Reason: "only web pages can be scraped"})
+
+	_, _, err = db.AddFeed(
+		context.Background(),
+		"https://bar.com/news",
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		&entity.ScrapeSpec{Item: "article"},
+		nil,
+	)
+	a.ErrorIs(err, entity.InvalidFeedSourceError{Reason: "scrape title selector is required"})
+	a.Equal(0, db.countFeeds())
+}
+
+func TestAddFeedOkDiscovered(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	feed := gofeed.Feed{
+		Title:    "feed-title",
+		Link:     "https://bar.com/blog",
+		FeedLink: "https://bar.com/blog/feed.xml",
+	}
+
+	gomock.InOrder(
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), feed.Link, nil, nil).
+			Return(nil, nil, gofeed.ErrFeedTypeNotDetected),
+		db.parser.EXPECT().
+			DiscoverFeeds(gomock.Any(), feed.Link).
+			Return([]*entity.FeedCandidate{{URL: feed.FeedLink}}, nil),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), feed.FeedLink, nil, nil).
+			Return(&feed, &FetchCache{}, nil),
+	)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		feed.Link,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal(feed.Title, record.Title)
+	a.Equal(feed.FeedLink, record.FeedURL)
+	a.Equal(1, db.countFeeds())
+}
+
+func TestAddFeedErrDiscoveredAmbiguous(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	const pageURL = "https://bar.com/blog"
+	cands := []*entity.FeedCandidate{
+		{URL: "https://bar.com/blog/rss.xml", Format: pointer("rss")},
+		{URL: "https://bar.com/blog/atom.xml", Format: pointer("atom")},
+	}
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), pageURL, nil, nil).
+		Return(nil, nil, gofeed.ErrFeedTypeNotDetected)
+	db.parser.EXPECT().
+		DiscoverFeeds(gomock.Any(), pageURL).
+		Return(cands, nil)
+
+	record, added, err := db.AddFeed(
+		context.Background(),
+		pageURL,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	r.Error(err)
+	a.Nil(record)
+	a.False(added)
+
+	var aerr entity.AmbiguousFeedError
+	r.ErrorAs(err, &aerr)
+	a.Equal(cands, aerr.Candidates)
+	a.Equal(0, db.countFeeds())
+}
+
+func TestAddFeedErrNoFeedDiscovered(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	const pageURL = "https://bar.com/blog"
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), pageURL, nil, nil).
+		Return(nil, nil, gofeed.ErrFeedTypeNotDetected)
+	db.parser.EXPECT().
+		DiscoverFeeds(gomock.Any(), pageURL).
+		Return(nil, nil)
+
+	_, _, err := db.AddFeed(
+		context.Background(),
+		pageURL,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	r.Error(err)
+	a.ErrorIs(err, entity.NoFeedFoundError{URL: pageURL})
+	a.Equal(0, db.countFeeds())
+}
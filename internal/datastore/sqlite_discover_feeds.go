@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// DiscoverFeeds returns the feeds advertised by the web page at the given URL. If the URL points
+// to a feed itself, it is returned as the only candidate.
+func (db *SQLite) DiscoverFeeds(
+	ctx context.Context,
+	pageURL string,
+	timeout *time.Duration,
+) ([]*entity.FeedCandidate, error) {
+
+	fail := failF("SQLite.DiscoverFeeds")
+
+	if err := db.checkLocalFeedURL(pageURL); err != nil {
+		return nil, fail(err)
+	}
+
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	cands, err := db.parser.DiscoverFeeds(ctx, pageURL)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return cands, nil
+}
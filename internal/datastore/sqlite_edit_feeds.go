@@ -6,6 +6,8 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"math"
+	"time"
 
 	"github.com/bow/neon/internal/entity"
 )
@@ -32,6 +34,21 @@ func (db *SQLite) EditFeeds(
 		if err := setFeedIsStarred(ctx, tx, op.ID, op.IsStarred); err != nil {
 			return nil, err
 		}
+		if err := setFeedPullInterval(ctx, tx, op.ID, op.PullInterval); err != nil {
+			return nil, err
+		}
+		if err := setFeedRetention(ctx, tx, op.ID, op.Retention); err != nil {
+			return nil, err
+		}
+		if err := setFeedIsPaused(ctx, tx, op.ID, op.IsPaused); err != nil {
+			return nil, err
+		}
+		if err := setFeedCredentials(ctx, tx, op.ID, op.Credentials); err != nil {
+			return nil, err
+		}
+		if err := setFeedFetchContent(ctx, tx, op.ID, op.FetchContent); err != nil {
+			return nil, err
+		}
 		return getFeed(ctx, tx, op.ID)
 	}
 
@@ -73,6 +90,15 @@ func getFeed(ctx context.Context, tx *sql.Tx, feedID ID) (*feedRecord, error) {
 			, f.sub_time AS sub_time
 			, f.update_time AS update_time
 			, f.last_pull_time AS last_pull_time
+			, f.pull_interval AS pull_interval
+			, f.retain_max_entries AS retain_max_entries
+			, f.retain_read_age AS retain_read_age
+			, f.consecutive_failures AS consecutive_failures
+			, f.last_error AS last_error
+			, f.backoff_until AS backoff_until
+			, f.is_paused AS is_paused
+			, f.next_pull_time AS next_pull_time
+			, f.fetch_content AS fetch_content
 			, json_group_array(fc.name) FILTER (WHERE fc.name IS NOT NULL) AS tags
 		FROM
 			feeds f
@@ -97,6 +123,15 @@ func getFeed(ctx context.Context, tx *sql.Tx, feedID ID) (*feedRecord, error) {
 			&feed.subscribed,
 			&feed.updated,
 			&feed.lastPulled,
+			&feed.pullInterval,
+			&feed.retainMaxEntries,
+			&feed.retainReadAge,
+			&feed.consecutiveFailures,
+			&feed.lastError,
+			&feed.backoffUntil,
+			&feed.isPaused,
+			&feed.nextPullTime,
+			&feed.fetchContent,
 			&feed.tags,
 		); err != nil {
 			return nil, err
@@ -117,10 +152,112 @@ func getFeed(ctx context.Context, tx *sql.Tx, feedID ID) (*feedRecord, error) {
 }
 
 var (
-	setFeedTitle       = tableFieldSetter[string](feedsTable, "title")
-	setFeedDescription = tableFieldSetter[string](feedsTable, "description")
-	setFeedIsStarred   = tableFieldSetter[bool](feedsTable, "is_starred")
-	setFeedSiteURL     = tableFieldSetter[string](feedsTable, "site_url")
+	setFeedTitle        = tableFieldSetter[string](feedsTable, "title")
+	setFeedDescription  = tableFieldSetter[string](feedsTable, "description")
+	setFeedIsStarred    = tableFieldSetter[bool](feedsTable, "is_starred")
+	setFeedIsPaused     = tableFieldSetter[bool](feedsTable, "is_paused")
+	setFeedFetchContent = tableFieldSetter[bool](feedsTable, "fetch_content")
+	setFeedSiteURL      = tableFieldSetter[string](feedsTable, "site_url")
+)
+
+// setFeedPullInterval sets the pull interval of a feed, with a zero value removing the override.
+func setFeedPullInterval(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	interval *time.Duration,
+) error {
+
+	if interval == nil {
+		return nil
+	}
+
+	var seconds sql.NullInt64
+	if *interval > 0 {
+		seconds = sql.NullInt64{Int64: int64(math.Ceil(interval.Seconds())), Valid: true}
+	}
+
+	return setFeedPullIntervalSeconds(ctx, tx, feedID, &seconds)
+}
+
+// setFeedRetention sets the retention policy fields of a feed, with zero values removing the
+// respective overrides.
+func setFeedRetention(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	policy *entity.RetentionPolicy,
+) error {
+
+	if policy == nil {
+		return nil
+	}
+
+	if v := policy.MaxEntries; v != nil {
+		var maxEntries sql.NullInt64
+		if *v > 0 {
+			maxEntries = sql.NullInt64{Int64: int64(*v), Valid: true}
+		}
+		if err := setFeedRetainMaxEntries(ctx, tx, feedID, &maxEntries); err != nil {
+			return err
+		}
+	}
+
+	if v := policy.MaxReadAge; v != nil {
+		var readAge sql.NullInt64
+		if *v > 0 {
+			readAge = sql.NullInt64{Int64: int64(math.Ceil(v.Seconds())), Valid: true}
+		}
+		if err := setFeedRetainReadAge(ctx, tx, feedID, &readAge); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setFeedCredentials replaces the credentials of a feed, with empty credentials removing them.
+func setFeedCredentials(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	creds *entity.FeedCredentials,
+) error {
+
+	if creds == nil {
+		return nil
+	}
+	if err := creds.Validate(); err != nil {
+		return err
+	}
+
+	sql1 := `
+		UPDATE
+			feeds
+		SET
+			auth_username = ?
+			, auth_password = ?
+			, auth_token = ?
+		WHERE
+			id = ?
+		RETURNING
+			id
+`
+	var updatedID ID
+	return tx.QueryRowContext(
+		ctx,
+		sql1,
+		creds.Username,
+		creds.Password,
+		creds.Token,
+		feedID,
+	).Scan(&updatedID)
+}
+
+var (
+	setFeedPullIntervalSeconds = tableFieldSetter[sql.NullInt64](feedsTable, "pull_interval")
+	setFeedRetainMaxEntries    = tableFieldSetter[sql.NullInt64](feedsTable, "retain_max_entries")
+	setFeedRetainReadAge       = tableFieldSetter[sql.NullInt64](feedsTable, "retain_read_age")
 )
 
 func setFeedTags(
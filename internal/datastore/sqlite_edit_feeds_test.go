@@ -6,9 +6,11 @@ package datastore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"github.com/bow/neon/internal/entity"
 )
@@ -67,3 +69,102 @@ func TestEditFeedsOkExtended(t *testing.T) {
 	a.False(existf("Feed A", false))
 	a.True(existf("Feed X", true))
 }
+
+func TestEditFeedsOkPullInterval(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+	id := keys["Feed A"].ID
+
+	ops := []*entity.FeedEditOp{{ID: id, PullInterval: pointer(90 * time.Minute)}}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	r.NotNil(feeds[0].PullInterval)
+	a.Equal(90*time.Minute, *feeds[0].PullInterval)
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE id = ? AND pull_interval = 5400`, id))
+
+	ops = []*entity.FeedEditOp{{ID: id, PullInterval: pointer(time.Duration(0))}}
+	feeds, err = db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.Nil(feeds[0].PullInterval)
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE id = ? AND pull_interval IS NULL`, id))
+}
+
+func TestEditFeedsOkFetchContent(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+	id := keys["Feed A"].ID
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE id = ? AND NOT fetch_content`, id))
+
+	ops := []*entity.FeedEditOp{{ID: id, FetchContent: pointer(true)}}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.True(feeds[0].FetchContent)
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE id = ? AND fetch_content`, id))
+}
+
+func TestEditFeedsOkCredentials(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	feedURL := "http://a.com/feed.xml"
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: feedURL}})
+	id := keys["Feed A"].ID
+
+	creds := &entity.FeedCredentials{Username: pointer("user"), Password: pointer("pass")}
+	ops := []*entity.FeedEditOp{{ID: id, Credentials: creds}}
+	_, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	a.True(db.rowExists(
+		`SELECT * FROM feeds
+		WHERE id = ? AND auth_username = 'user' AND auth_password = 'pass' AND auth_token IS NULL`,
+		id,
+	))
+
+	// Stored credentials are used for pulls.
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), feedURL, creds, gomock.Any()).
+		Return(nil, &FetchCache{}, nil)
+	for res := range db.PullFeeds(context.Background(), []entity.ID{id}, nil, nil, nil, false) {
+		r.NoError(res.Error())
+	}
+
+	ops = []*entity.FeedEditOp{{ID: id, Credentials: &entity.FeedCredentials{}}}
+	_, err = db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	a.True(db.rowExists(
+		`SELECT * FROM feeds
+		WHERE id = ? AND auth_username IS NULL AND auth_password IS NULL AND auth_token IS NULL`,
+		id,
+	))
+}
+
+func TestEditFeedsErrInvalidCredentials(t *testing.T) {
+	t.Parallel()
+
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+	id := keys["Feed A"].ID
+
+	creds := &entity.FeedCredentials{Username: pointer("user"), Token: pointer("t0k")}
+	ops := []*entity.FeedEditOp{{ID: id, Credentials: creds}}
+	_, err := db.EditFeeds(context.Background(), ops)
+
+	assert.ErrorAs(t, err, &entity.InvalidFeedCredentialsError{})
+}
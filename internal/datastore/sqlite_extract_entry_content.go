@@ -0,0 +1,248 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ExtractEntryContent extracts the full content of the entry with the given ID from the page it
+// links to, and stores it as the content of the entry. The original content is kept as the
+// description of the entry if it has none.
+func (db *SQLite) ExtractEntryContent(
+	ctx context.Context,
+	id entity.ID,
+) (*entity.Entry, error) {
+
+	fail := failF("SQLite.ExtractEntryContent")
+
+	var pageURL string
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		rec, err := getEntry(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.EntryNotFoundError{ID: id}
+			}
+			return err
+		}
+		if !rec.url.Valid {
+			return entity.NoEntryURLError{ID: id}
+		}
+		pageURL = rec.url.String
+		return nil
+	}
+
+	db.mu.RLock()
+	err := db.withTx(ctx, dbFunc)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	content, err := db.extractContent(ctx, pageURL, nil)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	var rec *entryRecord
+	dbFunc = func(ctx context.Context, tx *sql.Tx) error {
+		if err := setEntryContent(ctx, tx, id, content); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.EntryNotFoundError{ID: id}
+			}
+			return err
+		}
+		rec, err = getEntry(ctx, tx, id)
+		return err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if err := db.withTx(ctx, dbFunc); err != nil {
+		return nil, fail(err)
+	}
+
+	return rec.entry(), nil
+}
+
+// extractNewEntriesContent extracts the full content of those of the given new entries whose feeds
+// fetch full content, and returns the updated entries. The entries are already stored with the
+// contents of their feeds, so failures are logged rather than returned. It must be called without
+// the database lock held.
+func (db *SQLite) extractNewEntriesContent(
+	ctx context.Context,
+	ids []ID,
+	timeout *time.Duration,
+) []*entity.Entry {
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var targets []entryURL
+	dbFunc := func(ctx context.Context, tx *sql.Tx) (err error) {
+		targets, err = getFetchContentEntryURLs(ctx, tx, ids)
+		return err
+	}
+
+	db.mu.RLock()
+	err := db.withTx(ctx, dbFunc)
+	db.mu.RUnlock()
+	if err != nil {
+		pkgLogger.Error().Err(err).Msg("failed to get entries for content extraction")
+		return nil
+	}
+
+	contents := make(map[ID]string, len(targets))
+	for _, target := range targets {
+		content, err := db.extractContent(ctx, target.url, timeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			pkgLogger.Warn().
+				Err(err).
+				Uint32("entry_id", target.id).
+				Str("url", target.url).
+				Msg("failed to extract entry content")
+			continue
+		}
+		contents[target.id] = content
+	}
+	if len(contents) == 0 {
+		return nil
+	}
+
+	entries := make([]*entity.Entry, 0, len(contents))
+	dbFunc = func(ctx context.Context, tx *sql.Tx) error {
+		for _, target := range targets {
+			content, ok := contents[target.id]
+			if !ok {
+				continue
+			}
+			// The entry may have been pruned or deleted in the meantime.
+			if err := setEntryContent(ctx, tx, target.id, content); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					continue
+				}
+				return err
+			}
+			rec, err := getEntry(ctx, tx, target.id)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, rec.entry())
+		}
+		return nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if err := db.withTx(ctx, dbFunc); err != nil {
+		pkgLogger.Error().Err(err).Msg("failed to store extracted entry content")
+		return nil
+	}
+
+	return entries
+}
+
+// extractContent extracts the main content of the given page within the request limits of its
+// host, giving up after the given timeout, if any.
+func (db *SQLite) extractContent(
+	ctx context.Context,
+	pageURL string,
+	timeout *time.Duration,
+) (string, error) {
+
+	_, release, err := db.hosts.acquire(ctx, urlHost(pageURL))
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	return db.parser.ExtractContent(ctx, pageURL)
+}
+
+type entryURL struct {
+	id  ID
+	url string
+}
+
+// getFetchContentEntryURLs returns the URLs of those of the given entries that link to a page and
+// belong to feeds that fetch full content.
+func getFetchContentEntryURLs(ctx context.Context, tx *sql.Tx, ids []ID) ([]entryURL, error) {
+
+	sql1 := `
+		SELECT
+			e.id
+			, e.url
+		FROM
+			entries e
+			INNER JOIN feeds f ON f.id = e.feed_id
+		WHERE
+			e.id = ?
+			AND e.url IS NOT NULL
+			AND f.fetch_content
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	targets := make([]entryURL, 0, len(ids))
+	for _, id := range ids {
+		var target entryURL
+		err := stmt1.QueryRowContext(ctx, id).Scan(&target.id, &target.url)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+// setEntryContent sets the content of an entry to the given extracted content. The original content
+// becomes the description of the entry if it has none, so that it is not lost.
+func setEntryContent(ctx context.Context, tx *sql.Tx, entryID ID, content string) error {
+
+	sql1 := `
+		UPDATE
+			entries
+		SET
+			description = COALESCE(description, content)
+			, content = ?
+		WHERE
+			id = ?
+`
+	res, err := tx.ExecContext(ctx, sql1, content, entryID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
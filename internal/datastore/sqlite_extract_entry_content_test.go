@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestExtractEntryContentOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:   "Entry A1",
+					url:     toNullString("http://a.com/posts/a1"),
+					content: toNullString("Truncated"),
+				},
+			},
+		},
+	})
+	id := keys["Feed A"].Entries["Entry A1"]
+
+	db.parser.EXPECT().
+		ExtractContent(gomock.Any(), "http://a.com/posts/a1").
+		Return("<div>Full</div>", nil)
+
+	entry, err := db.ExtractEntryContent(context.Background(), id)
+	r.NoError(err)
+	r.NotNil(entry)
+
+	a.Equal(id, entry.ID)
+	a.Equal(pointer("<div>Full</div>"), entry.Content)
+	a.Equal(pointer("Truncated"), entry.Description)
+}
+
+func TestExtractEntryContentErr(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{title: "Entry A1", url: toNullString("http://a.com/posts/a1")},
+				{title: "Entry A2"},
+			},
+		},
+	})
+
+	db.parser.EXPECT().
+		ExtractContent(gomock.Any(), "http://a.com/posts/a1").
+		Return("", fmt.Errorf("nope"))
+
+	entry, err := db.ExtractEntryContent(context.Background(), keys["Feed A"].Entries["Entry A1"])
+	r.Nil(entry)
+	a.EqualError(err, "SQLite.ExtractEntryContent: nope")
+
+	entry, err = db.ExtractEntryContent(context.Background(), keys["Feed A"].Entries["Entry A2"])
+	r.Nil(entry)
+	a.ErrorAs(err, &entity.NoEntryURLError{})
+
+	entry, err = db.ExtractEntryContent(context.Background(), 86)
+	r.Nil(entry)
+	a.EqualError(err, "SQLite.ExtractEntryContent: entry with ID=86 not found")
+}
+
+func TestPullFeedsOkFetchContent(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{title: "Feed A", feedURL: "http://a.com/feed.xml"},
+		{title: "Feed X", feedURL: "http://x.com/feed.xml"},
+	}
+	keys := db.addFeeds(dbFeeds)
+
+	ops := []*entity.FeedEditOp{{ID: keys["Feed A"].ID, FetchContent: pointer(true)}}
+	_, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+
+	pulledFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:   "Entry A1",
+					extID:   "A1",
+					url:     toNullString("http://a.com/posts/a1"),
+					content: toNullString("Truncated A1"),
+				},
+				{
+					title:   "Entry A2",
+					extID:   "A2",
+					url:     toNullString("http://a.com/posts/a2"),
+					content: toNullString("Truncated A2"),
+				},
+			},
+		},
+		{
+			title:   "Feed X",
+			feedURL: "http://x.com/feed.xml",
+			entries: []*entryRecord{
+				{title: "Entry X1", extID: "X1", url: toNullString("http://x.com/posts/x1")},
+			},
+		},
+	}
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Return(toGFeed(t, pulledFeeds[0]), &FetchCache{}, nil)
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[1].feedURL, nil, gomock.Any()).
+		Return(toGFeed(t, pulledFeeds[1]), &FetchCache{}, nil)
+	db.parser.EXPECT().
+		ExtractContent(gomock.Any(), "http://a.com/posts/a1").
+		Return("<div>Full A1</div>", nil)
+	db.parser.EXPECT().
+		ExtractContent(gomock.Any(), "http://a.com/posts/a2").
+		Return("", fmt.Errorf("nope"))
+
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
+
+	contents := make(map[string]*string)
+	for res := range c {
+		r.NoError(res.Error())
+		feed := res.Feed()
+		r.NotNil(feed)
+		for _, entry := range feed.Entries {
+			contents[entry.Title] = entry.Content
+		}
+	}
+
+	// Failed extractions keep the content from the feed.
+	r.Len(contents, 3)
+	a.Equal(pointer("<div>Full A1</div>"), contents["Entry A1"])
+	a.Equal(pointer("Truncated A2"), contents["Entry A2"])
+	a.Nil(contents["Entry X1"])
+
+	a.True(db.rowExists(
+		`SELECT * FROM entries WHERE content = ? AND description = ?`,
+		"<div>Full A1</div>",
+		"Truncated A1",
+	))
+}
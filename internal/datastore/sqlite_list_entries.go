@@ -6,7 +6,6 @@ package datastore
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 
 	"github.com/bow/neon/internal/entity"
@@ -64,6 +63,8 @@ func getEntries(
 			, e.url AS url
 			, e.update_time AS update_time
 			, e.pub_time AS pub_time
+			, e.authors AS authors
+			, e.tags AS categories
 		FROM
 			entries e
 		WHERE
@@ -96,6 +97,8 @@ func getEntries(
 			&entry.url,
 			&entry.updated,
 			&entry.published,
+			&entry.authors,
+			&entry.categories,
 		); err != nil {
 			return nil, err
 		}
@@ -108,13 +111,9 @@ func getEntries(
 	}
 	defer stmt1.Close()
 
-	feedIDsJSON := "null"
-	if len(feedIDs) > 0 {
-		s, merr := json.Marshal(feedIDs)
-		if merr != nil {
-			return nil, merr
-		}
-		feedIDsJSON = string(s)
+	feedIDsJSON, err := toJSONArrayOrNull(feedIDs)
+	if err != nil {
+		return nil, err
 	}
 
 	rows, err := stmt1.QueryContext(ctx, feedIDsJSON, isRead, isBookmarked)
@@ -130,5 +129,66 @@ func getEntries(
 		}
 		entries = append(entries, entry)
 	}
+
+	if err := setEntryEnclosures(ctx, tx, entries); err != nil {
+		return nil, err
+	}
+
 	return entries, nil
 }
+
+// setEntryEnclosures fetches the enclosures of the given entries and sets them on each entry.
+func setEntryEnclosures(ctx context.Context, tx *sql.Tx, entries []*entryRecord) error {
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	sql1 := `
+		SELECT
+			ee.entry_id AS entry_id
+			, ee.url AS url
+			, ee.mime_type AS mime_type
+			, ee.length AS length
+		FROM
+			entry_enclosures ee
+		WHERE
+			ee.entry_id IN (SELECT value FROM json_each($1))
+		ORDER BY
+			ee.id
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	byID := make(map[ID]*entryRecord, len(entries))
+	ids := make([]ID, len(entries))
+	for i, entry := range entries {
+		byID[entry.id] = entry
+		ids[i] = entry.id
+	}
+	idsJSON, err := toJSONArrayOrNull(ids)
+	if err != nil {
+		return err
+	}
+
+	rows, err := stmt1.QueryContext(ctx, idsJSON)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rec enclosureRecord
+		if err := rows.Scan(&rec.entryID, &rec.url, &rec.mimeType, &rec.length); err != nil {
+			return err
+		}
+		if entry, exists := byID[rec.entryID]; exists {
+			entry.enclosures = append(entry.enclosures, &rec)
+		}
+	}
+
+	return rows.Err()
+}
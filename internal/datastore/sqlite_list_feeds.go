@@ -60,6 +60,15 @@ func getAllFeeds(ctx context.Context, tx *sql.Tx) ([]*feedRecord, error) {
 			, f.is_starred AS is_starred
 			, f.sub_time AS sub_time
 			, f.last_pull_time AS last_pull_time
+			, f.pull_interval AS pull_interval
+			, f.retain_max_entries AS retain_max_entries
+			, f.retain_read_age AS retain_read_age
+			, f.consecutive_failures AS consecutive_failures
+			, f.last_error AS last_error
+			, f.backoff_until AS backoff_until
+			, f.is_paused AS is_paused
+			, f.next_pull_time AS next_pull_time
+			, f.fetch_content AS fetch_content
 			, f.update_time AS update_time
 			, json_group_array(fc.name) FILTER (WHERE fc.name IS NOT NULL) AS tags
 		FROM
@@ -82,6 +91,15 @@ func getAllFeeds(ctx context.Context, tx *sql.Tx) ([]*feedRecord, error) {
 			&feed.isStarred,
 			&feed.subscribed,
 			&feed.lastPulled,
+			&feed.pullInterval,
+			&feed.retainMaxEntries,
+			&feed.retainReadAge,
+			&feed.consecutiveFailures,
+			&feed.lastError,
+			&feed.backoffUntil,
+			&feed.isPaused,
+			&feed.nextPullTime,
+			&feed.fetchContent,
 			&feed.updated,
 			&feed.tags,
 		); err != nil {
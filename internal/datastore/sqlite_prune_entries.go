@@ -0,0 +1,365 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+// PruneEntries removes entries of the given feeds, or of all feeds if none are given, that fall
+// outside the retention policy. The policy of each feed is the global policy, overridden by any
+// feed-specific values. Bookmarked entries are never removed. When dryRun is true, no entries are
+// removed. In both cases, the returned entries are the ones that are or would be removed; their
+// description and content are not set.
+func (db *SQLite) PruneEntries(
+	ctx context.Context,
+	feedIDs []entity.ID,
+	dryRun bool,
+) ([]*entity.Entry, error) {
+
+	recs := make([]*entryRecord, 0)
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+
+		ids := sliceutil.Dedup(feedIDs)
+		if len(ids) == 0 {
+			var err error
+			if ids, err = getAllFeedIDs(ctx, tx); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().UTC()
+		for _, id := range ids {
+			pruned, err := pruneFeedEntries(ctx, tx, id, db.retention, now, dryRun)
+			if err != nil {
+				return err
+			}
+			recs = append(recs, pruned...)
+		}
+
+		return nil
+	}
+
+	fail := failF("SQLite.PruneEntries")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return entryRecords(recs).entriesSlice(), nil
+}
+
+func getAllFeedIDs(ctx context.Context, tx *sql.Tx) ([]ID, error) {
+
+	sql1 := `SELECT id FROM feeds ORDER BY id`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rows, err := stmt1.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]ID, 0)
+	for rows.Next() {
+		var id ID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// pruneFeedEntries removes the entries of a single feed that fall outside the given global policy,
+// overridden by the feed's own policy. Removed entries are recorded, so that they are not added
+// again by later pulls of the feed.
+func pruneFeedEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	global entity.RetentionPolicy,
+	now time.Time,
+	dryRun bool,
+) ([]*entryRecord, error) {
+
+	override, err := getFeedRetention(ctx, tx, feedID)
+	if err != nil {
+		return nil, err
+	}
+	policy := global.Override(*override)
+	if policy.IsEmpty() {
+		return nil, nil
+	}
+
+	recs, err := getPruneCandidates(ctx, tx, feedID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Timestamps are not stored in a sortable format, so the entries are ranked here instead of
+	// in the database.
+	entryTime := func(rec *entryRecord) *time.Time {
+		if rec.updated.Valid {
+			return &rec.updated.Time
+		}
+		if rec.published.Valid {
+			return &rec.published.Time
+		}
+		return nil
+	}
+	slices.SortStableFunc(recs, func(r1, r2 *entryRecord) int {
+		t1, t2 := entryTime(r1), entryTime(r2)
+		switch {
+		case t1 != nil && t2 != nil:
+			return t2.Compare(*t1)
+		case t1 != nil:
+			return -1
+		case t2 != nil:
+			return 1
+		default:
+			return cmp.Compare(r2.id, r1.id)
+		}
+	})
+
+	var cutoff *time.Time
+	if v := policy.MaxReadAge; v != nil {
+		cutoff = pointer(now.Add(-*v))
+	}
+
+	pruned := make([]*entryRecord, 0)
+	for i, rec := range recs {
+		if rec.isBookmarked {
+			continue
+		}
+		tooMany := policy.MaxEntries != nil && i >= int(*policy.MaxEntries)
+		tooOld := false
+		if t := entryTime(rec); cutoff != nil && rec.isRead && t != nil {
+			tooOld = t.Before(*cutoff)
+		}
+		if tooMany || tooOld {
+			pruned = append(pruned, rec)
+		}
+	}
+
+	if dryRun || len(pruned) == 0 {
+		return pruned, nil
+	}
+
+	ids := make([]ID, len(pruned))
+	for i, rec := range pruned {
+		ids[i] = rec.id
+	}
+	if err := deleteEntries(ctx, tx, ids); err != nil {
+		return nil, err
+	}
+	if err := addPrunedEntries(ctx, tx, feedID, pruned, now); err != nil {
+		return nil, err
+	}
+
+	return pruned, nil
+}
+
+// addPrunedEntries records the given entries of a feed as pruned.
+func addPrunedEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	recs []*entryRecord,
+	now time.Time,
+) error {
+
+	sql1 := `
+		INSERT OR IGNORE INTO
+			pruned_entries(feed_id, external_id, prune_time)
+			VALUES (?, ?, ?)
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	for _, rec := range recs {
+		if _, err := stmt1.ExecContext(ctx, feedID, rec.extID, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteStalePrunedEntries removes the records of pruned entries of a feed that the given pulled
+// items no longer list. They are only needed while the feed lists the entries, to keep them from
+// being added again.
+func deleteStalePrunedEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	items []*gofeed.Item,
+) error {
+
+	sql1 := `
+		DELETE FROM
+			pruned_entries
+		WHERE
+			feed_id = ?
+			AND external_id NOT IN (SELECT value FROM json_each(?))
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	extIDs := make([]string, len(items))
+	for i, item := range items {
+		extIDs[i] = item.GUID
+	}
+	extIDsJSON, err := toJSONArrayOrNull(extIDs)
+	if err != nil {
+		return err
+	}
+	_, err = stmt1.ExecContext(ctx, feedID, extIDsJSON)
+
+	return err
+}
+
+// withoutPruned returns the given entry IDs, without those of the given pruned entries.
+func withoutPruned(ids []ID, pruned []*entryRecord) []ID {
+	if len(pruned) == 0 {
+		return ids
+	}
+	prunedIDs := make(map[ID]struct{}, len(pruned))
+	for _, rec := range pruned {
+		prunedIDs[rec.id] = struct{}{}
+	}
+	kept := make([]ID, 0, len(ids))
+	for _, id := range ids {
+		if _, exists := prunedIDs[id]; !exists {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
+
+func getFeedRetention(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+) (*entity.RetentionPolicy, error) {
+
+	sql1 := `SELECT retain_max_entries, retain_read_age FROM feeds WHERE id = ?`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	var maxEntries, readAge sql.NullInt64
+	err = stmt1.QueryRowContext(ctx, feedID).Scan(&maxEntries, &readAge)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.FeedNotFoundError{ID: feedID}
+		}
+		return nil, err
+	}
+
+	policy := entity.RetentionPolicy{
+		MaxEntries: fromNullUint32(maxEntries),
+		MaxReadAge: fromNullSeconds(readAge),
+	}
+
+	return &policy, nil
+}
+
+// getPruneCandidates returns all entries of the given feed, without their description and
+// content.
+func getPruneCandidates(ctx context.Context, tx *sql.Tx, feedID ID) ([]*entryRecord, error) {
+
+	sql1 := `
+		SELECT
+			e.id AS id
+			, e.feed_id AS feed_id
+			, e.title AS title
+			, e.is_read AS is_read
+			, e.is_bookmarked AS is_bookmarked
+			, e.external_id AS ext_id
+			, e.url AS url
+			, e.update_time AS update_time
+			, e.pub_time AS pub_time
+		FROM
+			entries e
+		WHERE
+			e.feed_id = ?
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rows, err := stmt1.QueryContext(ctx, feedID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recs := make([]*entryRecord, 0)
+	for rows.Next() {
+		var rec entryRecord
+		if err := rows.Scan(
+			&rec.id,
+			&rec.feedID,
+			&rec.title,
+			&rec.isRead,
+			&rec.isBookmarked,
+			&rec.extID,
+			&rec.url,
+			&rec.updated,
+			&rec.published,
+		); err != nil {
+			return nil, err
+		}
+		recs = append(recs, &rec)
+	}
+
+	return recs, rows.Err()
+}
+
+func deleteEntries(ctx context.Context, tx *sql.Tx, ids []ID) error {
+
+	sql1 := `DELETE FROM entries WHERE id IN (SELECT value FROM json_each(?))`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	idsJSON, err := toJSONArrayOrNull(ids)
+	if err != nil {
+		return err
+	}
+	_, err = stmt1.ExecContext(ctx, idsJSON)
+
+	return err
+}
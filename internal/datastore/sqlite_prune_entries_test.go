@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func entryTitles(entries []*entity.Entry) []string {
+	titles := make([]string, len(entries))
+	for i, entry := range entries {
+		titles[i] = entry.Title
+	}
+	return titles
+}
+
+func addPruneTestFeeds(t *testing.T, db testSQLiteDB) map[string]feedKey {
+	t.Helper()
+
+	now := time.Now().UTC()
+	daysAgo := func(n int) sql.NullTime {
+		return toNullTime(now.Add(-time.Duration(n) * 24 * time.Hour))
+	}
+
+	return db.addFeeds([]*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{title: "A1", isRead: true, updated: daysAgo(1)},
+				{title: "A2", isRead: false, updated: daysAgo(10)},
+				{title: "A3", isRead: true, updated: daysAgo(20)},
+				{title: "A4", isRead: true, updated: daysAgo(30), isBookmarked: true},
+			},
+		},
+		{
+			title:   "Feed X",
+			feedURL: "http://x.com/feed.xml",
+			entries: []*entryRecord{
+				{title: "X1", isRead: true, updated: daysAgo(2)},
+				{title: "X2", isRead: true, updated: daysAgo(40)},
+			},
+		},
+	})
+}
+
+func TestPruneEntriesOkNoPolicy(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	addPruneTestFeeds(t, db)
+
+	entries, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+	a.Empty(entries)
+	a.Equal(6, db.countTableRows("entries"))
+}
+
+func TestPruneEntriesOkMaxEntries(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	addPruneTestFeeds(t, db)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxEntries: pointer(uint32(1))})
+
+	entries, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+	a.ElementsMatch([]string{"A2", "A3", "X2"}, entryTitles(entries))
+	a.Equal(3, db.countTableRows("entries"))
+	a.True(db.rowExists(`SELECT * FROM entries WHERE title = 'A4'`))
+}
+
+func TestPruneEntriesOkMaxReadAgeSelected(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	keys := addPruneTestFeeds(t, db)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxReadAge: pointer(5 * 24 * time.Hour)})
+
+	ids := []entity.ID{keys["Feed A"].ID}
+	entries, err := db.PruneEntries(context.Background(), ids, false)
+	r.NoError(err)
+	a.Equal([]string{"A3"}, entryTitles(entries))
+	a.Equal(5, db.countTableRows("entries"))
+	a.True(db.rowExists(`SELECT * FROM entries WHERE title = 'X2'`))
+}
+
+func TestPruneEntriesOkFeedOverride(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	keys := addPruneTestFeeds(t, db)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxReadAge: pointer(5 * 24 * time.Hour)})
+
+	ops := []*entity.FeedEditOp{
+		{
+			ID:        keys["Feed X"].ID,
+			Retention: &entity.RetentionPolicy{MaxReadAge: pointer(60 * 24 * time.Hour)},
+		},
+	}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.Equal(pointer(60*24*time.Hour), feeds[0].Retention.MaxReadAge)
+	a.Nil(feeds[0].Retention.MaxEntries)
+
+	entries, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+	a.Equal([]string{"A3"}, entryTitles(entries))
+}
+
+func TestPruneEntriesOkDryRun(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	addPruneTestFeeds(t, db)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxEntries: pointer(uint32(1))})
+
+	entries, err := db.PruneEntries(context.Background(), nil, true)
+	r.NoError(err)
+	a.ElementsMatch([]string{"A2", "A3", "X2"}, entryTitles(entries))
+	a.Equal(6, db.countTableRows("entries"))
+}
+
+func TestPruneEntriesErrFeedNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxEntries: pointer(uint32(1))})
+
+	entries, err := db.PruneEntries(context.Background(), []entity.ID{48}, false)
+	a.Nil(entries)
+	a.EqualError(err, "SQLite.PruneEntries: feed with ID=48 not found")
+}
@@ -6,10 +6,13 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"net/http"
 	"sync"
 	"time"
 
-	"github.com/bow/neon/internal/chanutil"
+	"github.com/mmcdole/gofeed"
+
 	"github.com/bow/neon/internal/entity"
 	"github.com/bow/neon/internal/sliceutil"
 )
@@ -20,89 +23,236 @@ func (db *SQLite) PullFeeds(
 	entryReadStatus *bool,
 	maxEntriesPerFeed *uint32,
 	timeoutPerFeed *time.Duration,
+	force bool,
 ) <-chan entity.PullResult {
 
 	var (
 		fail = failF("SQLite.PullFeeds")
 		c    = make(chan entity.PullResult)
-		wg   sync.WaitGroup
 	)
 
-	// nolint: unparam
-	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
-
-		defer wg.Done()
+	go func() {
+		defer close(c)
 
-		var (
-			pks []pullKey
-			err error
-		)
-		if dedups := sliceutil.Dedup(ids); len(dedups) == 0 {
-			pks, err = getAllPullKeys(ctx, tx)
-		} else {
-			pks, err = getPullKeys(ctx, tx, dedups)
-		}
+		pks, err := db.getPullKeys(ctx, ids)
 		if err != nil {
 			c <- entity.NewPullResultFromError(nil, fail(err))
-			return nil
+			return
 		}
 		if len(pks) == 0 {
-			return nil
+			return
 		}
 
-		chs := make([]<-chan entity.PullResult, len(pks))
-		for i, pk := range pks {
-			var (
-				pctx   = ctx
-				cancel context.CancelFunc
-			)
-			if tpf := timeoutPerFeed; tpf != nil {
-				pctx, cancel = context.WithTimeout(ctx, *tpf)
-				defer cancel() // nolint: revive
+		// Feeds are fetched by a bounded number of workers, without holding the database lock.
+		// The lock is only taken for storing the results of each feed. Feeds of the same host are
+		// spread out, so that workers are not all waiting on the limits of a single host.
+		var (
+			wg    sync.WaitGroup
+			queue = make(chan pullKey)
+			n     = min(db.pullConcurrency, len(pks))
+		)
+		worker := func() {
+			defer wg.Done()
+			for pk := range queue {
+				pr := db.pullFeed(ctx, pk, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed)
+				if e := pr.Error(); e != nil {
+					pr.SetError(fail(e))
+				}
+				c <- pr
 			}
-			chs[i] = pullFeedEntries(
-				pctx,
-				tx,
-				pk,
-				db.parser,
-				entryReadStatus,
-				maxEntriesPerFeed,
-			)
 		}
 
-		for pr := range chanutil.Merge(chs) {
-			pr := pr
-			if e := pr.Error(); e != nil {
-				pr.SetError(fail(e))
+		wg.Add(n)
+		for range n {
+			go worker()
+		}
+		// Feeds that are not yet due according to their refresh hints are only pulled when
+		// selected explicitly.
+		var (
+			now      = time.Now()
+			checkDue = len(ids) == 0
+		)
+		for _, pk := range interleaveByHost(pks) {
+			if err := db.checkLocalFeedURL(pk.feedURL); err != nil {
+				c <- pk.err(fail(err))
+				continue
 			}
-			c <- pr
+			if !force && (pk.isHeldBack(now) || (checkDue && !pk.isDue(now))) {
+				c <- pk.skipped()
+				continue
+			}
+			queue <- pk
+		}
+		close(queue)
+		wg.Wait()
+	}()
+
+	return c
+}
+
+// getPullKeys returns the pull keys of the feeds with the given IDs, or of all feeds if no IDs
+// are given.
+func (db *SQLite) getPullKeys(ctx context.Context, ids []entity.ID) ([]pullKey, error) {
+
+	var pks []pullKey
+	dbFunc := func(ctx context.Context, tx *sql.Tx) (err error) {
+		if dedups := sliceutil.Dedup(ids); len(dedups) == 0 {
+			pks, err = getAllPullKeys(ctx, tx)
+		} else {
+			pks, err = getPullKeys(ctx, tx, dedups)
 		}
+		return err
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
 
-		return nil
+	if err := db.withTx(ctx, dbFunc); err != nil {
+		return nil, err
 	}
 
-	go func() {
-		defer func() {
-			wg.Wait()
-			close(c)
-		}()
-		wg.Add(1)
+	return pks, nil
+}
+
+// pullFeed fetches a single feed within the request limits of its host, and stores its contents
+// in a transaction of its own. The attempt is recorded in the pull history of the feed, unless the
+// pull is canceled.
+func (db *SQLite) pullFeed(
+	ctx context.Context,
+	pk pullKey,
+	entryReadStatus *bool,
+	maxEntriesPerFeed *uint32,
+	timeoutPerFeed *time.Duration,
+) (pr entity.PullResult) {
 
+	waited, release, err := db.hosts.acquire(ctx, urlHost(pk.feedURL))
+	if err != nil {
+		return pk.err(err)
+	}
+	defer func() { pr.SetThrottled(waited) }()
+
+	start := time.Now()
+	pull := feedPull{feedID: pk.feedID, start: start, pullTime: start.UTC()}
+
+	fctx := ctx
+	if tpf := timeoutPerFeed; tpf != nil {
+		var cancel context.CancelFunc
+		fctx, cancel = context.WithTimeout(ctx, *tpf)
+		defer cancel()
+	}
+	gfeed, cache, err := fetchFeed(fctx, db.parser, pk)
+	release()
+	if err != nil {
 		db.mu.Lock()
 		defer db.mu.Unlock()
 
-		err := db.withTx(ctx, dbFunc)
-		if err != nil {
-			c <- entity.NewPullResultFromError(nil, fail(err))
+		return db.failPull(ctx, pk, &pull, err)
+	}
+	if cache != nil && cache.StatusCode != 0 {
+		pull.httpStatus = &cache.StatusCode
+	}
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		pr = storePulledFeed(
+			ctx,
+			tx,
+			pk,
+			gfeed,
+			cache,
+			&pull,
+			entryReadStatus,
+			maxEntriesPerFeed,
+			db.retention,
+		)
+		return pr.Error()
+	}
+
+	db.mu.Lock()
+	if err := db.withTx(ctx, dbFunc); err != nil {
+		defer db.mu.Unlock()
+		return db.failPull(ctx, pk, &pull, err)
+	}
+	db.mu.Unlock()
+
+	// The full content of new entries is extracted after they are stored, so that a failed
+	// extraction does not fail the pull.
+	entries := db.extractNewEntriesContent(ctx, pull.newEntryIDs, timeoutPerFeed)
+	if feed := pr.Feed(); feed != nil {
+		for _, entry := range entries {
+			if _, exists := feed.Entries[entry.ID]; exists {
+				feed.Entries[entry.ID] = entry
+			}
 		}
-	}()
+	}
 
-	return c
+	return pr
+}
+
+// failPull records the failed pull of a feed and returns the result for it. It must be called
+// with the database lock held.
+func (db *SQLite) failPull(
+	ctx context.Context,
+	pk pullKey,
+	pull *feedPull,
+	err error,
+) entity.PullResult {
+
+	// The pull was aborted rather than failed, so there is nothing to record.
+	if ctx.Err() != nil {
+		return pk.err(err)
+	}
+
+	var herr gofeed.HTTPError
+	if errors.As(err, &herr) {
+		pull.httpStatus = &herr.StatusCode
+	}
+	var rerr RetryAfterError
+	if errors.As(err, &rerr) {
+		pull.retryAfter = &rerr.RetryAfter
+	}
+	pull.numEntries = nil
+	pull.numNewEntries = nil
+	pull.duration = time.Since(pull.start)
+	pull.err = err
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		return recordFeedPull(ctx, tx, pull)
+	}
+	if rerr := db.withTx(ctx, dbFunc); rerr != nil {
+		pkgLogger.Error().
+			Err(rerr).
+			Uint32("feed_id", pk.feedID).
+			Msg("failed to record feed pull")
+	}
+
+	return pk.err(err)
 }
 
 type pullKey struct {
-	feedID  ID
-	feedURL string
+	feedID       ID
+	feedURL      string
+	cache        FetchCache
+	creds        *entity.FeedCredentials
+	exec         *entity.ExecOptions
+	scrape       *entity.ScrapeSpec
+	backoffUntil *time.Time
+	isPaused     bool
+	nextPullTime *time.Time
+}
+
+// isHeldBack checks whether the feed should not be pulled at the given time, because it is paused
+// or backing off after failed pulls.
+func (pk pullKey) isHeldBack(now time.Time) bool {
+	return pk.isPaused || (pk.backoffUntil != nil && now.Before(*pk.backoffUntil))
+}
+
+// isDue checks whether the feed is due a pull at the given time, according to its refresh hints.
+func (pk pullKey) isDue(now time.Time) bool {
+	return pk.nextPullTime == nil || !now.Before(*pk.nextPullTime)
+}
+
+func (pk pullKey) skipped() entity.PullResult {
+	return entity.NewPullResultSkipped(&pk.feedURL)
 }
 
 func (pk pullKey) ok(feed *entity.Feed) entity.PullResult {
@@ -111,6 +261,10 @@ func (pk pullKey) ok(feed *entity.Feed) entity.PullResult {
 	return pr
 }
 
+func (pk pullKey) notModified() entity.PullResult {
+	return entity.NewPullResultNotModified(&pk.feedURL)
+}
+
 func (pk pullKey) err(e error) entity.PullResult {
 	pr := entity.NewPullResultFromError(&pk.feedURL, e)
 	pr.SetStatus(entity.PullFail)
@@ -119,21 +273,398 @@ func (pk pullKey) err(e error) entity.PullResult {
 
 var (
 	setFeedUpdateTime   = tableFieldSetter[time.Time](feedsTable, "update_time")
-	setFeedLastPullTime = tableFieldSetter[time.Time](feedsTable, "last_pull_time")
+	setFeedBackoffUntil = tableFieldSetter[time.Time](feedsTable, "backoff_until")
+)
+
+// maxFeedPullHistory is the number of most recent pull attempts kept for each feed.
+const maxFeedPullHistory = 100
+
+// Bounds of the time a feed is not pulled for after failed pulls. The backoff starts at the
+// minimum and doubles with each consecutive failure, up to the maximum.
+const (
+	minPullBackoff = 15 * time.Minute
+	maxPullBackoff = 24 * time.Hour
 )
 
+// pullBackoff returns the time a feed is not pulled for after the given number of consecutive
+// failures, extended to the wait time requested by the server, if any.
+func pullBackoff(failures uint32, retryAfter *time.Duration) time.Duration {
+	if failures == 0 {
+		return 0
+	}
+	backoff := minPullBackoff
+	for i := uint32(1); i < failures && backoff < maxPullBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxPullBackoff)
+	if retryAfter != nil {
+		backoff = max(backoff, min(*retryAfter, maxPullBackoff))
+	}
+	return backoff
+}
+
+// feedPull is a single pull attempt of a feed.
+type feedPull struct {
+	feedID        ID
+	pullTime      time.Time
+	duration      time.Duration
+	httpStatus    *int
+	numEntries    *int
+	numNewEntries *int
+	err           error
+	// retryAfter is how long the server asked to wait before retrying a failed pull.
+	retryAfter *time.Duration
+	// movedFrom is the previous URL of the feed, if the pull moved it to a new URL.
+	movedFrom *string
+	// newEntryIDs are the IDs of the entries added by the pull.
+	newEntryIDs []ID
+
+	// start is the local start time of the pull, used for measuring its duration.
+	start time.Time
+}
+
+// recordFeedPull adds the given pull attempt to the pull history of its feed, and updates the
+// pull status of the feed accordingly.
+func recordFeedPull(ctx context.Context, tx *sql.Tx, pull *feedPull) error {
+
+	var errMsg *string
+	if pull.err != nil {
+		errMsg = pointer(pull.err.Error())
+	}
+
+	sql1 := `
+		INSERT INTO
+			feed_pulls(
+				feed_id
+				, pull_time
+				, duration
+				, http_status
+				, num_entries
+				, num_new_entries
+				, error
+				, moved_from
+			)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+	if _, err := tx.ExecContext(
+		ctx,
+		sql1,
+		pull.feedID,
+		pull.pullTime,
+		pull.duration.Milliseconds(),
+		pull.httpStatus,
+		pull.numEntries,
+		pull.numNewEntries,
+		errMsg,
+		pull.movedFrom,
+	); err != nil {
+		return err
+	}
+
+	if pull.err == nil {
+		sql2 := `
+			UPDATE
+				feeds
+			SET
+				last_pull_time = ?
+				, consecutive_failures = 0
+				, last_error = NULL
+				, backoff_until = NULL
+				, is_paused = false
+			WHERE
+				id = ?
+`
+		if _, err := tx.ExecContext(ctx, sql2, pull.pullTime, pull.feedID); err != nil {
+			return err
+		}
+	} else {
+		sql2 := `
+			UPDATE
+				feeds
+			SET
+				last_pull_time = ?
+				, consecutive_failures = consecutive_failures + 1
+				, last_error = ?
+			WHERE
+				id = ?
+			RETURNING
+				consecutive_failures
+`
+		var failures uint32
+		if err := tx.QueryRowContext(
+			ctx,
+			sql2,
+			pull.pullTime,
+			errMsg,
+			pull.feedID,
+		).Scan(&failures); err != nil {
+			return err
+		}
+
+		backoffUntil := pull.pullTime.Add(pullBackoff(failures, pull.retryAfter))
+		if err := setFeedBackoffUntil(ctx, tx, pull.feedID, &backoffUntil); err != nil {
+			return err
+		}
+		// The feed is gone for good, so there is no point in pulling it again.
+		if pull.httpStatus != nil && *pull.httpStatus == http.StatusGone {
+			if err := setFeedIsPaused(ctx, tx, pull.feedID, pointer(true)); err != nil {
+				return err
+			}
+		}
+	}
+
+	sql3 := `
+		DELETE FROM
+			feed_pulls
+		WHERE
+			feed_id = $1
+			AND id NOT IN (
+				SELECT
+					id
+				FROM
+					feed_pulls
+				WHERE
+					feed_id = $1
+				ORDER BY
+					id DESC
+				LIMIT $2
+			)
+`
+	_, err := tx.ExecContext(ctx, sql3, pull.feedID, maxFeedPullHistory)
+	return err
+}
+
+// moveFeed changes the URL of the given feed to the URL it has permanently moved to, and returns
+// the ID the feed is stored under afterwards. If another feed already has the new URL, the given
+// feed is merged into it: entries, tags, the pull history, and the records of pruned entries are
+// moved to the other feed, whose ID is returned, and the given feed is deleted. Entries in both
+// feeds keep the read and bookmark statuses set on either copy, and the pull interval, retention,
+// and credentials of the given feed are kept where the other feed has none. Credentials of the
+// given feed are removed if the new URL is on a different host, so that they are not sent to that
+// host.
+func moveFeed(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	oldURL string,
+	newURL string,
+) (ID, error) {
+
+	sql1 := `SELECT id FROM feeds WHERE feed_url = ?`
+	var targetID ID
+	err := tx.QueryRowContext(ctx, sql1, newURL).Scan(&targetID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	if urlHost(oldURL) != urlHost(newURL) {
+		if err := clearFeedCredentials(ctx, tx, feedID, oldURL, newURL); err != nil {
+			return 0, err
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		sql2 := `UPDATE feeds SET feed_url = ? WHERE id = ?`
+		if _, err = tx.ExecContext(ctx, sql2, newURL, feedID); err != nil {
+			return 0, err
+		}
+		return feedID, nil
+	}
+
+	// Entries present in both feeds keep the statuses set on either copy.
+	sql3 := `
+		UPDATE
+			entries
+		SET
+			is_read = entries.is_read OR src.is_read
+			, is_bookmarked = entries.is_bookmarked OR src.is_bookmarked
+		FROM
+			(
+				SELECT
+					external_id
+					, is_read
+					, is_bookmarked
+				FROM
+					entries
+				WHERE
+					feed_id = $1
+			) AS src
+		WHERE
+			entries.feed_id = $2
+			AND entries.external_id = src.external_id
+`
+	sql4 := `UPDATE OR IGNORE entries SET feed_id = $2 WHERE feed_id = $1`
+	sql5 := `
+		INSERT OR IGNORE INTO
+			feeds_x_feed_tags(
+				feed_id
+				, feed_tag_id
+			)
+			SELECT
+				$2
+				, feed_tag_id
+			FROM
+				feeds_x_feed_tags
+			WHERE
+				feed_id = $1
+`
+	sql6 := `UPDATE feed_pulls SET feed_id = $2 WHERE feed_id = $1`
+	sql7 := `
+		UPDATE
+			feeds
+		SET
+			is_starred = feeds.is_starred OR src.is_starred
+			, fetch_content = feeds.fetch_content OR src.fetch_content
+			, pull_interval = COALESCE(feeds.pull_interval, src.pull_interval)
+			, retain_max_entries = COALESCE(feeds.retain_max_entries, src.retain_max_entries)
+			, retain_read_age = COALESCE(feeds.retain_read_age, src.retain_read_age)
+		FROM
+			(
+				SELECT
+					is_starred
+					, fetch_content
+					, pull_interval
+					, retain_max_entries
+					, retain_read_age
+				FROM
+					feeds
+				WHERE
+					id = $1
+			) AS src
+		WHERE
+			feeds.id = $2
+`
+	// Credentials are kept together, so that those of both feeds are not mixed.
+	sql8 := `
+		UPDATE
+			feeds
+		SET
+			auth_username = src.auth_username
+			, auth_password = src.auth_password
+			, auth_token = src.auth_token
+		FROM
+			(
+				SELECT
+					auth_username
+					, auth_password
+					, auth_token
+				FROM
+					feeds
+				WHERE
+					id = $1
+			) AS src
+		WHERE
+			feeds.id = $2
+			AND feeds.auth_username IS NULL
+			AND feeds.auth_password IS NULL
+			AND feeds.auth_token IS NULL
+`
+	// Entries that the other feed still has are not recorded as pruned, as that would stop them
+	// from being updated by later pulls.
+	sql9 := `
+		INSERT OR IGNORE INTO
+			pruned_entries(
+				feed_id
+				, external_id
+				, prune_time
+			)
+			SELECT
+				$2
+				, external_id
+				, prune_time
+			FROM
+				pruned_entries
+			WHERE
+				feed_id = $1
+				AND external_id NOT IN (SELECT external_id FROM entries WHERE feed_id = $2)
+`
+	sql10 := `DELETE FROM feeds WHERE id = $1`
+
+	for _, stmt := range []string{sql3, sql4, sql5, sql6, sql7, sql8, sql9, sql10} {
+		if _, err := tx.ExecContext(ctx, stmt, feedID, targetID); err != nil {
+			return 0, err
+		}
+	}
+
+	return targetID, nil
+}
+
+// clearFeedCredentials removes the credentials of a feed that has moved from the given old URL to
+// the given new URL, logging if it had any.
+func clearFeedCredentials(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	oldURL string,
+	newURL string,
+) error {
+
+	sql1 := `
+		UPDATE
+			feeds
+		SET
+			auth_username = NULL
+			, auth_password = NULL
+			, auth_token = NULL
+		WHERE
+			id = ?
+			AND (
+				auth_username IS NOT NULL
+				OR auth_password IS NOT NULL
+				OR auth_token IS NOT NULL
+			)
+`
+	res, err := tx.ExecContext(ctx, sql1, feedID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		pkgLogger.Warn().
+			Uint32("feed_id", feedID).
+			Str("old_url", oldURL).
+			Str("new_url", newURL).
+			Msg("removed credentials of feed that moved to another host")
+	}
+
+	return nil
+}
+
 func getPullKeys(ctx context.Context, tx *sql.Tx, feedIDs []ID) ([]pullKey, error) {
 	// FIXME: Find a cleaner way to check for array membership using database/sql.
 	//        Until then, we just loop through all IDs.
-	stmt1, err := tx.PrepareContext(ctx, `SELECT feed_url FROM feeds WHERE id = ?`)
+	sql1 := `
+		SELECT
+			id
+			, feed_url
+			, http_etag
+			, http_last_modified
+			, content_hash
+			, backoff_until
+			, is_paused
+			, auth_username
+			, auth_password
+			, auth_token
+			, next_pull_time
+			, exec_dir
+			, exec_timeout
+			, scrape_spec
+		FROM
+			feeds
+		WHERE
+			id = ?
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
 	if err != nil {
 		return nil, err
 	}
+	defer stmt1.Close()
 
 	pks := make([]pullKey, len(feedIDs))
 	for i, id := range feedIDs {
-		pk := pullKey{feedID: id}
-		if err := stmt1.QueryRowContext(ctx, pk.feedID).Scan(&pk.feedURL); err != nil {
+		pk, err := scanPullKey(stmt1.QueryRowContext(ctx, id))
+		if err != nil {
 			return nil, err
 		}
 		pks[i] = pk
@@ -144,110 +675,261 @@ func getPullKeys(ctx context.Context, tx *sql.Tx, feedIDs []ID) ([]pullKey, erro
 
 func getAllPullKeys(ctx context.Context, tx *sql.Tx) ([]pullKey, error) {
 
-	sql1 := `SELECT id, feed_url FROM feeds`
-
-	scanRow := func(rows *sql.Rows) (pullKey, error) {
-		var pk pullKey
-		err := rows.Scan(&pk.feedID, &pk.feedURL)
-		return pk, err
-	}
-
+	sql1 := `
+		SELECT
+			id
+			, feed_url
+			, http_etag
+			, http_last_modified
+			, content_hash
+			, backoff_until
+			, is_paused
+			, auth_username
+			, auth_password
+			, auth_token
+			, next_pull_time
+			, exec_dir
+			, exec_timeout
+			, scrape_spec
+		FROM
+			feeds
+`
 	stmt1, err := tx.PrepareContext(ctx, sql1)
 	if err != nil {
 		return nil, err
 	}
+	defer stmt1.Close()
 
 	rows, err := stmt1.QueryContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	pks := make([]pullKey, 0)
 	for rows.Next() {
-		pk, err := scanRow(rows)
+		pk, err := scanPullKey(rows)
 		if err != nil {
 			return nil, err
 		}
 		pks = append(pks, pk)
 	}
 
-	return pks, nil
+	return pks, rows.Err()
+}
+
+func scanPullKey(row interface{ Scan(...any) error }) (pullKey, error) {
+	var (
+		pk                         pullKey
+		etag, lastModified, digest sql.NullString
+		backoffUntil               sql.NullTime
+		username, password, token  sql.NullString
+		nextPullTime               sql.NullTime
+		execDir                    sql.NullString
+		execTimeout                sql.NullInt64
+		scrapeSpec                 sql.NullString
+	)
+	if err := row.Scan(
+		&pk.feedID,
+		&pk.feedURL,
+		&etag,
+		&lastModified,
+		&digest,
+		&backoffUntil,
+		&pk.isPaused,
+		&username,
+		&password,
+		&token,
+		&nextPullTime,
+		&execDir,
+		&execTimeout,
+		&scrapeSpec,
+	); err != nil {
+		return pk, err
+	}
+	pk.backoffUntil = fromNullTime(backoffUntil)
+	pk.nextPullTime = fromNullTime(nextPullTime)
+	pk.cache = FetchCache{
+		ETag:         fromNullString(etag),
+		LastModified: fromNullString(lastModified),
+		ContentHash:  fromNullString(digest),
+	}
+	creds := entity.FeedCredentials{
+		Username: fromNullString(username),
+		Password: fromNullString(password),
+		Token:    fromNullString(token),
+	}
+	if !creds.IsEmpty() {
+		pk.creds = &creds
+	}
+	execOpts := entity.ExecOptions{
+		Dir:     fromNullString(execDir),
+		Timeout: fromNullSeconds(execTimeout),
+	}
+	if !execOpts.IsEmpty() {
+		pk.exec = &execOpts
+	}
+	scrape, err := fromNullScrapeSpec(scrapeSpec)
+	if err != nil {
+		return pk, err
+	}
+	pk.scrape = scrape
+	return pk, nil
+}
+
+func setFeedFetchCache(ctx context.Context, tx *sql.Tx, feedID ID, cache *FetchCache) error {
+	sql1 := `
+		UPDATE
+			feeds
+		SET
+			http_etag = ?
+			, http_last_modified = ?
+			, content_hash = ?
+		WHERE
+			id = ?
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	_, err = stmt1.ExecContext(ctx, cache.ETag, cache.LastModified, cache.ContentHash, feedID)
+	return err
+}
+
+// fetchFeed fetches and parses the feed of the given pull key, returning early if the context is
+// done before the parser returns.
+func fetchFeed(
+	ctx context.Context,
+	parser Parser,
+	pk pullKey,
+) (*gofeed.Feed, *FetchCache, error) {
+
+	type fetchResult struct {
+		feed  *gofeed.Feed
+		cache *FetchCache
+		err   error
+	}
+
+	ch := make(chan fetchResult, 1)
+	go func() {
+		feed, cache, err := parseFeed(
+			ctx,
+			parser,
+			pk.feedURL,
+			pk.creds,
+			pk.exec,
+			pk.scrape,
+			&pk.cache,
+		)
+		ch <- fetchResult{feed, cache, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case res := <-ch:
+		return res.feed, res.cache, res.err
+	}
 }
 
-func pullFeedEntries(
+// storePulledFeed stores the fetched contents of a feed, prunes its entries according to the
+// given retention policy, and records the given pull in the pull history of the feed.
+func storePulledFeed(
 	ctx context.Context,
 	tx *sql.Tx,
 	pk pullKey,
-	parser Parser,
+	gfeed *gofeed.Feed,
+	cache *FetchCache,
+	pull *feedPull,
 	entryReadStatus *bool,
 	maxEntriesPerFeed *uint32,
-) chan entity.PullResult {
+	retention entity.RetentionPolicy,
+) entity.PullResult {
 
-	pullTime := time.Now().UTC()
-	pullf := func() entity.PullResult {
-
-		gfeed, err := parser.ParseURLWithContext(pk.feedURL, ctx)
+	if newURL := cache.PermanentURL; newURL != nil && *newURL != pk.feedURL {
+		feedID, err := moveFeed(ctx, tx, pk.feedID, pk.feedURL, *newURL)
 		if err != nil {
 			return pk.err(err)
 		}
+		pull.movedFrom = pointer(pk.feedURL)
+		pull.feedID = feedID
+		pk.feedID = feedID
+		pk.feedURL = *newURL
+	}
+
+	if err := setFeedFetchCache(ctx, tx, pk.feedID, cache); err != nil {
+		return pk.err(err)
+	}
+	if err := storeFeedRefreshHints(ctx, tx, pk.feedID, gfeed, cache, pull.pullTime); err != nil {
+		return pk.err(err)
+	}
+	if err := storeFeedWebSub(ctx, tx, pk.feedID, pk.feedURL, gfeed); err != nil {
+		return pk.err(err)
+	}
+
+	numAdded := 0
+	if gfeed != nil {
+		pull.numEntries = pointer(len(gfeed.Items))
 
 		updateTime := resolveFeedUpdateTime(gfeed)
-		if err = setFeedUpdateTime(ctx, tx, pk.feedID, updateTime); err != nil {
+		if err := setFeedUpdateTime(ctx, tx, pk.feedID, updateTime); err != nil {
 			return pk.err(err)
 		}
-		if err = setFeedLastPullTime(ctx, tx, pk.feedID, &pullTime); err != nil {
+		if err := deleteStalePrunedEntries(ctx, tx, pk.feedID, gfeed.Items); err != nil {
 			return pk.err(err)
 		}
 
-		if len(gfeed.Items) == 0 {
-			return pk.ok(nil)
-		}
-
-		if err = upsertEntries(ctx, tx, pk.feedID, gfeed.Items); err != nil {
-			return pk.err(err)
-		}
+		if len(gfeed.Items) > 0 {
+			added, err := upsertEntries(ctx, tx, pk.feedID, gfeed.Items)
+			if err != nil {
+				return pk.err(err)
+			}
 
-		entries, err := getEntries(
-			ctx,
-			tx,
-			[]ID{pk.feedID},
-			maxEntriesPerFeed,
-			entryReadStatus,
-			nil,
-		)
-		if err != nil {
-			return pk.err(err)
-		}
-		if len(entries) == 0 && maxEntriesPerFeed == nil {
-			return pk.ok(nil)
+			pruned, err := pruneFeedEntries(ctx, tx, pk.feedID, retention, pull.pullTime, false)
+			if err != nil {
+				return pk.err(err)
+			}
+			pull.newEntryIDs = withoutPruned(added, pruned)
+			numAdded = len(pull.newEntryIDs)
 		}
+	}
+	pull.numNewEntries = &numAdded
+	pull.duration = time.Since(pull.start)
 
-		rec, err := getFeed(ctx, tx, pk.feedID)
-		if err != nil {
-			return pk.err(err)
-		}
+	if err := recordFeedPull(ctx, tx, pull); err != nil {
+		return pk.err(err)
+	}
 
-		rec.entries = entries
+	if gfeed == nil {
+		return pk.notModified()
+	}
+	if len(gfeed.Items) == 0 {
+		return pk.ok(nil)
+	}
 
-		return pk.ok(rec.feed())
+	entries, err := getEntries(
+		ctx,
+		tx,
+		[]ID{pk.feedID},
+		maxEntriesPerFeed,
+		entryReadStatus,
+		nil,
+	)
+	if err != nil {
+		return pk.err(err)
+	}
+	if len(entries) == 0 && maxEntriesPerFeed == nil {
+		return pk.ok(nil)
 	}
 
-	ic := make(chan entity.PullResult)
-	go func() {
-		defer close(ic)
-		ic <- pullf()
-	}()
+	rec, err := getFeed(ctx, tx, pk.feedID)
+	if err != nil {
+		return pk.err(err)
+	}
 
-	oc := make(chan entity.PullResult)
-	go func() {
-		defer close(oc)
-		select {
-		case <-ctx.Done():
-			oc <- pk.err(ctx.Err())
-		case msg := <-ic:
-			oc <- msg
-		}
-	}()
+	rec.entries = entries
 
-	return oc
+	return pk.ok(rec.feed())
 }
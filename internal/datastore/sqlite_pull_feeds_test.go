@@ -5,6 +5,8 @@ package datastore
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,10 +28,10 @@ func TestPullFeedsAllOkEmptyDB(t *testing.T) {
 	r.Equal(0, db.countFeeds())
 
 	db.parser.EXPECT().
-		ParseURLWithContext(gomock.Any(), gomock.Any()).
+		ParseURLIfModified(gomock.Any(), gomock.Any(), nil, gomock.Any()).
 		MaxTimes(0)
 
-	c := db.PullFeeds(context.Background(), nil, nil, nil, nil)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
 	a.Empty(c)
 }
 
@@ -59,16 +61,16 @@ func TestPullFeedsAllOkEmptyEntries(t *testing.T) {
 	r.Equal(2, db.countFeeds())
 
 	db.parser.EXPECT().
-		ParseURLWithContext(dbFeeds[0].feedURL, gomock.Any()).
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
 		MaxTimes(1).
-		Return(toGFeed(t, dbFeeds[0]), nil)
+		Return(toGFeed(t, dbFeeds[0]), &FetchCache{}, nil)
 
 	db.parser.EXPECT().
-		ParseURLWithContext(dbFeeds[1].feedURL, gomock.Any()).
+		ParseURLIfModified(gomock.Any(), dbFeeds[1].feedURL, nil, gomock.Any()).
 		MaxTimes(1).
-		Return(toGFeed(t, dbFeeds[1]), nil)
+		Return(toGFeed(t, dbFeeds[1]), &FetchCache{}, nil)
 
-	c := db.PullFeeds(context.Background(), nil, nil, nil, nil)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -173,16 +175,16 @@ func TestPullFeedsAllOkNoNewEntries(t *testing.T) {
 	}
 
 	db.parser.EXPECT().
-		ParseURLWithContext(dbFeeds[0].feedURL, gomock.Any()).
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
 		MaxTimes(1).
-		Return(toGFeed(t, pulledFeeds[0]), nil)
+		Return(toGFeed(t, pulledFeeds[0]), &FetchCache{}, nil)
 
 	db.parser.EXPECT().
-		ParseURLWithContext(dbFeeds[1].feedURL, gomock.Any()).
+		ParseURLIfModified(gomock.Any(), dbFeeds[1].feedURL, nil, gomock.Any()).
 		MaxTimes(1).
-		Return(toGFeed(t, pulledFeeds[1]), nil)
+		Return(toGFeed(t, pulledFeeds[1]), &FetchCache{}, nil)
 
-	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil)
+	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -208,7 +210,7 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 	a := assert.New(t)
 	db, dbFeeds, keys, pulledFeeds := setupComplexDBFixture(t)
 
-	c := db.PullFeeds(context.Background(), nil, nil, nil, nil)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -310,7 +312,7 @@ func TestPullFeedsAllOkSomeNewEntriesNoneReturned(t *testing.T) {
 	a := assert.New(t)
 	db, dbFeeds, keys, pulledFeeds := setupComplexDBFixture(t)
 
-	c := db.PullFeeds(context.Background(), nil, nil, pointer(uint32(0)), nil)
+	c := db.PullFeeds(context.Background(), nil, nil, pointer(uint32(0)), nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -360,7 +362,7 @@ func TestPullFeedsAllOkSomeNewEntriesOnlyUnread(t *testing.T) {
 	a := assert.New(t)
 	db, dbFeeds, keys, pulledFeeds := setupComplexDBFixture(t)
 
-	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil)
+	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -520,9 +522,9 @@ func TestPullFeedsSelectedOkSomeNewEntries(t *testing.T) {
 	}
 
 	db.parser.EXPECT().
-		ParseURLWithContext(dbFeeds[1].feedURL, gomock.Any()).
+		ParseURLIfModified(gomock.Any(), dbFeeds[1].feedURL, nil, gomock.Any()).
 		MaxTimes(1).
-		Return(toGFeed(t, pulledFeed), nil)
+		Return(toGFeed(t, pulledFeed), &FetchCache{}, nil)
 
 	c := db.PullFeeds(
 		context.Background(),
@@ -530,6 +532,7 @@ func TestPullFeedsSelectedOkSomeNewEntries(t *testing.T) {
 		pointer(false),
 		nil,
 		nil,
+		false,
 	)
 
 	got := make([]entity.PullResult, 0)
@@ -571,6 +574,1105 @@ func TestPullFeedsSelectedOkSomeNewEntries(t *testing.T) {
 	a.ElementsMatch(want, got)
 }
 
+func TestPullFeedsSelectedOkNotModified(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:      "Feed A",
+			feedURL:    "http://a.com/feed.xml",
+			lastPulled: mustTime(t, "2022-07-18T22:04:37Z"),
+			updated:    toNullTime(mustTime(t, "2022-03-19T16:23:18.600+02:00")),
+			entries: []*entryRecord{
+				{
+					title:  "Entry A1",
+					extID:  "A1",
+					isRead: true,
+					url:    toNullString("http://a.com/a1.html"),
+				},
+			},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	r.Equal(1, db.countFeeds())
+
+	cache := FetchCache{
+		ETag:         pointer(`"abc"`),
+		LastModified: pointer("Wed, 21 Oct 2015 07:28:00 GMT"),
+		ContentHash:  pointer("d1g35t"),
+	}
+
+	// First pull returns the feed along with its cache validators.
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, &FetchCache{}).
+		Times(1).
+		Return(toGFeed(t, dbFeeds[0]), &cache, nil)
+
+	// Second pull must send the stored validators and gets a not-modified response.
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, &cache).
+		Times(1).
+		Return(nil, &cache, nil)
+
+	ids := []entity.ID{keys[dbFeeds[0].title].ID}
+
+	got := make([]entity.PullResult, 0)
+	for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, false) {
+		got = append(got, res)
+	}
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Equal(entity.PullSuccess, got[0].Status())
+
+	a.True(db.rowExists(
+		`SELECT * FROM feeds WHERE http_etag = ? AND http_last_modified = ? AND content_hash = ?`,
+		*cache.ETag,
+		*cache.LastModified,
+		*cache.ContentHash,
+	))
+
+	got = make([]entity.PullResult, 0)
+	for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, false) {
+		got = append(got, res)
+	}
+	r.Len(got, 1)
+	a.Equal(entity.PullNotModified, got[0].Status())
+	a.Equal(dbFeeds[0].feedURL, got[0].URL())
+	a.Nil(got[0].Feed())
+	a.NoError(got[0].Error())
+	a.False(db.rowExists(
+		`SELECT * FROM feeds WHERE last_pull_time = ?`,
+		dbFeeds[0].lastPulled,
+	))
+}
+
+func TestPullFeedsFetchDoesNotBlockReads(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{title: "Feed A", feedURL: "http://a.com/feed.xml"},
+		{title: "Feed X", feedURL: "http://x.com/feed.xml"},
+	}
+	db.addFeeds(dbFeeds)
+
+	var (
+		started = make(chan struct{}, len(dbFeeds))
+		release = make(chan struct{})
+	)
+	for _, feed := range dbFeeds {
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), feed.feedURL, nil, gomock.Any()).
+			Times(1).
+			DoAndReturn(
+				func(
+					context.Context,
+					string,
+					*entity.FeedCredentials,
+					*FetchCache,
+				) (*gofeed.Feed, *FetchCache, error) {
+					started <- struct{}{}
+					<-release
+					return nil, &FetchCache{}, nil
+				},
+			)
+	}
+
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
+
+	// Both fetches are in flight at the same time, and the datastore remains readable.
+	<-started
+	<-started
+	feeds, err := db.ListFeeds(context.Background(), nil)
+	r.NoError(err)
+	a.Len(feeds, 2)
+	close(release)
+
+	got := make([]entity.PullResult, 0)
+	for res := range c {
+		got = append(got, res)
+	}
+	r.Len(got, 2)
+	for _, res := range got {
+		a.NoError(res.Error())
+		a.Equal(entity.PullNotModified, res.Status())
+	}
+}
+
+func TestPullFeedsBoundedConcurrency(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetPullConcurrency(2)
+
+	dbFeeds := []*feedRecord{
+		{title: "Feed A", feedURL: "http://a.com/feed.xml"},
+		{title: "Feed B", feedURL: "http://b.com/feed.xml"},
+		{title: "Feed C", feedURL: "http://c.com/feed.xml"},
+		{title: "Feed D", feedURL: "http://d.com/feed.xml"},
+		{title: "Feed E", feedURL: "http://e.com/feed.xml"},
+	}
+	db.addFeeds(dbFeeds)
+
+	var (
+		mu              sync.Mutex
+		active, maxSeen int
+	)
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), gomock.Any(), nil, gomock.Any()).
+		Times(len(dbFeeds)).
+		DoAndReturn(
+			func(
+				context.Context,
+				string,
+				*entity.FeedCredentials,
+				*FetchCache,
+			) (*gofeed.Feed, *FetchCache, error) {
+				mu.Lock()
+				active++
+				maxSeen = max(maxSeen, active)
+				mu.Unlock()
+
+				time.Sleep(20 * time.Millisecond)
+
+				mu.Lock()
+				active--
+				mu.Unlock()
+				return nil, &FetchCache{}, nil
+			},
+		)
+
+	n := 0
+	for res := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		r.NoError(res.Error())
+		n++
+	}
+	a.Equal(len(dbFeeds), n)
+	a.LessOrEqual(maxSeen, 2)
+}
+
+func TestPullFeedsHostLimits(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetPullConcurrencyPerHost(1)
+	db.SetPullHostDelay(30 * time.Millisecond)
+
+	dbFeeds := []*feedRecord{
+		{title: "Feed A", feedURL: "http://a.com/1.xml"},
+		{title: "Feed B", feedURL: "http://a.com/2.xml"},
+	}
+	db.addFeeds(dbFeeds)
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), gomock.Any(), nil, gomock.Any()).
+		Times(len(dbFeeds)).
+		Return(nil, &FetchCache{}, nil)
+
+	var throttled []time.Duration
+	for res := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		r.NoError(res.Error())
+		throttled = append(throttled, res.Throttled())
+	}
+	r.Len(throttled, 2)
+
+	slices.Sort(throttled)
+	a.Less(throttled[0], 30*time.Millisecond)
+	a.GreaterOrEqual(throttled[1], 20*time.Millisecond)
+}
+
+func TestPullFeedsSelectedErrTimeout(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:      "Feed A",
+			feedURL:    "http://a.com/feed.xml",
+			lastPulled: mustTime(t, "2022-07-18T22:04:37Z"),
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+
+	release := make(chan struct{})
+	defer close(release)
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(1).
+		DoAndReturn(
+			func(
+				context.Context,
+				string,
+				*entity.FeedCredentials,
+				*FetchCache,
+			) (*gofeed.Feed, *FetchCache, error) {
+				<-release
+				return nil, &FetchCache{}, nil
+			},
+		)
+
+	ids := []entity.ID{keys[dbFeeds[0].title].ID}
+	got := make([]entity.PullResult, 0)
+	c := db.PullFeeds(context.Background(), ids, nil, nil, pointer(time.Millisecond), false)
+	for res := range c {
+		got = append(got, res)
+	}
+	r.Len(got, 1)
+	a.Equal(entity.PullFail, got[0].Status())
+	a.ErrorIs(got[0].Error(), context.DeadlineExceeded)
+}
+
+func TestPullFeedsSelectedOkPrunesEntries(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxEntries: pointer(uint32(2))})
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:   "Entry A1",
+					extID:   "A1",
+					updated: toNullTime(mustTime(t, "2022-07-16T23:39:07.383Z")),
+					url:     toNullString("http://a.com/a1.html"),
+				},
+			},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+
+	pulledFeed := &feedRecord{
+		title:   "Feed A",
+		feedURL: "http://a.com/feed.xml",
+		entries: []*entryRecord{
+			{
+				title:   "Entry A2",
+				extID:   "A2",
+				updated: toNullTime(mustTime(t, "2022-07-17T23:39:07.383Z")),
+				url:     toNullString("http://a.com/a2.html"),
+			},
+			{
+				title:   "Entry A3",
+				extID:   "A3",
+				updated: toNullTime(mustTime(t, "2022-07-18T23:39:07.383Z")),
+				url:     toNullString("http://a.com/a3.html"),
+			},
+		},
+	}
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(1).
+		Return(toGFeed(t, pulledFeed), &FetchCache{}, nil)
+
+	ids := []entity.ID{keys[dbFeeds[0].title].ID}
+	for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, false) {
+		r.NoError(res.Error())
+	}
+
+	a.Equal(2, db.countEntries(dbFeeds[0].feedURL))
+	a.False(db.rowExists(`SELECT * FROM entries WHERE external_id = 'A1'`))
+}
+
+func TestPullFeedsSelectedOkSkipsPrunedEntries(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxReadAge: pointer(24 * time.Hour)})
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:   "Entry A1",
+					extID:   "A1",
+					isRead:  true,
+					updated: toNullTime(mustTime(t, "2022-07-16T23:39:07.383Z")),
+				},
+				{
+					title:   "Entry A2",
+					extID:   "A2",
+					updated: toNullTime(mustTime(t, "2022-07-18T23:39:07.383Z")),
+				},
+			},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	feedID := keys[dbFeeds[0].title].ID
+
+	pruned, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+	a.Equal([]string{"Entry A1"}, entryTitles(pruned))
+
+	// The pulled feed still lists the pruned entry.
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(2).
+		Return(toGFeed(t, dbFeeds[0]), &FetchCache{}, nil)
+
+	for range 2 {
+		for res := range db.PullFeeds(context.Background(), []entity.ID{feedID}, nil, nil, nil, true) {
+			r.NoError(res.Error())
+			r.NotNil(res.Feed())
+			a.Len(res.Feed().Entries, 1)
+		}
+
+		a.Equal(1, db.countEntries(dbFeeds[0].feedURL))
+		a.False(db.rowExists(`SELECT * FROM entries WHERE external_id = 'A1'`))
+	}
+	a.False(db.rowExists(`SELECT * FROM feed_pulls WHERE num_new_entries != 0`))
+}
+
+func TestPullFeedsSelectedOkDeletesStalePrunedEntries(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxReadAge: pointer(24 * time.Hour)})
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:   "Entry A1",
+					extID:   "A1",
+					isRead:  true,
+					updated: toNullTime(mustTime(t, "2022-07-16T23:39:07.383Z")),
+				},
+				{
+					title:   "Entry A2",
+					extID:   "A2",
+					updated: toNullTime(mustTime(t, "2022-07-18T23:39:07.383Z")),
+				},
+			},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	feedID := keys[dbFeeds[0].title].ID
+
+	_, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+	r.True(db.rowExists(`SELECT * FROM pruned_entries WHERE external_id = 'A1'`))
+
+	// The first pulled feed still lists the pruned entry, the second one no longer does.
+	laterFeed := &feedRecord{
+		title:   "Feed A",
+		feedURL: "http://a.com/feed.xml",
+		entries: dbFeeds[0].entries[1:],
+	}
+	gomock.InOrder(
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Return(toGFeed(t, dbFeeds[0]), &FetchCache{}, nil),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Return(toGFeed(t, laterFeed), &FetchCache{}, nil),
+	)
+	pull := func() {
+		for res := range db.PullFeeds(context.Background(), []entity.ID{feedID}, nil, nil, nil, true) {
+			r.NoError(res.Error())
+		}
+	}
+
+	pull()
+	a.True(db.rowExists(`SELECT * FROM pruned_entries WHERE external_id = 'A1'`))
+	a.False(db.rowExists(`SELECT * FROM entries WHERE external_id = 'A1'`))
+
+	pull()
+	a.False(db.rowExists(`SELECT * FROM pruned_entries WHERE external_id = 'A1'`))
+	a.Equal(1, db.countEntries(dbFeeds[0].feedURL))
+}
+
+func TestPullFeedsSelectedOkNewEntriesExcludePruned(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetRetentionPolicy(entity.RetentionPolicy{MaxEntries: pointer(uint32(1))})
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:   "Entry A1",
+					extID:   "A1",
+					updated: toNullTime(mustTime(t, "2022-07-18T23:39:07.383Z")),
+				},
+			},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	feedID := keys[dbFeeds[0].title].ID
+
+	// The new entry is older than the existing one, so it is pruned by the pull that adds it.
+	pulledFeed := &feedRecord{
+		title:   "Feed A",
+		feedURL: "http://a.com/feed.xml",
+		entries: append(
+			dbFeeds[0].entries,
+			&entryRecord{
+				title:   "Entry A2",
+				extID:   "A2",
+				updated: toNullTime(mustTime(t, "2022-07-17T23:39:07.383Z")),
+			},
+		),
+	}
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(1).
+		Return(toGFeed(t, pulledFeed), &FetchCache{}, nil)
+
+	for res := range db.PullFeeds(context.Background(), []entity.ID{feedID}, nil, nil, nil, true) {
+		r.NoError(res.Error())
+	}
+
+	a.Equal(1, db.countEntries(dbFeeds[0].feedURL))
+	a.True(db.rowExists(`SELECT * FROM pruned_entries WHERE external_id = 'A2'`))
+	a.True(db.rowExists(`SELECT * FROM feed_pulls WHERE num_new_entries = 0`))
+}
+
+func TestPullFeedsAllLocalFeedsNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	db.SetAllowLocalFeeds(false)
+
+	dbFeeds := []*feedRecord{
+		{title: "Feed A", feedURL: "http://a.com/feed.xml"},
+		{title: "Feed X", feedURL: "exec:./report.sh"},
+	}
+	db.addFeeds(dbFeeds)
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Return(nil, &FetchCache{}, nil)
+
+	got := make(map[string]entity.PullResult)
+	for res := range db.PullFeeds(context.Background(), nil, nil, nil, nil, true) {
+		got[res.URL()] = res
+	}
+	r.Len(got, 2)
+
+	a.NoError(got[dbFeeds[0].feedURL].Error())
+	a.ErrorIs(
+		got[dbFeeds[1].feedURL].Error(),
+		entity.InvalidFeedSourceError{Reason: "local feeds are not allowed"},
+	)
+}
+
+func TestPullFeedsSelectedOkEntryMetadata(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}}
+	keys := db.addFeeds(dbFeeds)
+
+	gfeed := func(categories []string, length string) *gofeed.Feed {
+		return &gofeed.Feed{
+			Title: "Feed A",
+			Items: []*gofeed.Item{
+				{
+					GUID:  "A1",
+					Title: "Episode 1",
+					Link:  "http://a.com/ep1.html",
+					Authors: []*gofeed.Person{
+						{Name: "Alice", Email: "alice@a.com"},
+						{Name: "Bob"},
+					},
+					Categories: categories,
+					Enclosures: []*gofeed.Enclosure{
+						{URL: "http://a.com/ep1.mp3", Type: "audio/mpeg", Length: length},
+						{URL: ""},
+					},
+				},
+			},
+		}
+	}
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(1).
+		Return(gfeed([]string{"tech"}, "1024"), &FetchCache{}, nil)
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(1).
+		Return(gfeed([]string{"tech", "audio"}, "invalid"), &FetchCache{}, nil)
+
+	ids := []entity.ID{keys[dbFeeds[0].title].ID}
+	pull := func() {
+		for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, false) {
+			r.NoError(res.Error())
+		}
+	}
+
+	pull()
+	entries, err := db.ListEntries(context.Background(), ids, nil)
+	r.NoError(err)
+	r.Len(entries, 1)
+	entry, err := db.GetEntry(context.Background(), entries[0].ID)
+	r.NoError(err)
+	a.Equal([]string{"Alice <alice@a.com>", "Bob"}, entry.Authors)
+	a.Equal([]string{"tech"}, entry.Categories)
+	a.Equal(
+		[]*entity.Enclosure{
+			{
+				URL:      "http://a.com/ep1.mp3",
+				MIMEType: pointer("audio/mpeg"),
+				Length:   pointer(uint64(1024)),
+			},
+		},
+		entry.Enclosures,
+	)
+
+	pull()
+	entries, err = db.ListEntries(context.Background(), ids, nil)
+	r.NoError(err)
+	r.Len(entries, 1)
+	a.Equal([]string{"tech", "audio"}, entries[0].Categories)
+	r.Len(entries[0].Enclosures, 1)
+	a.Nil(entries[0].Enclosures[0].Length)
+	a.Equal(1, db.countTableRows("entry_enclosures"))
+}
+
+func TestPullFeedsSelectedRecordsPullHistory(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{{title: "Entry A1", extID: "A1"}},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	feedID := keys[dbFeeds[0].title].ID
+
+	herr := gofeed.HTTPError{StatusCode: 500, Status: "500 Internal Server Error"}
+	pulled := toGFeed(t, dbFeeds[0])
+	pulled.Items = append(pulled.Items, &gofeed.Item{GUID: "A2", Title: "Entry A2"})
+
+	gomock.InOrder(
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Times(2).
+			Return(nil, nil, herr),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Times(1).
+			Return(pulled, &FetchCache{StatusCode: 200}, nil),
+	)
+
+	// Pulls are forced, so that the failures do not hold back the subsequent pulls.
+	pull := func() entity.PullResult {
+		got := make([]entity.PullResult, 0)
+		for res := range db.PullFeeds(context.Background(), []entity.ID{feedID}, nil, nil, nil, true) {
+			got = append(got, res)
+		}
+		r.Len(got, 1)
+		return got[0]
+	}
+	getFeed := func() *entity.Feed {
+		feeds, err := db.ListFeeds(context.Background(), nil)
+		r.NoError(err)
+		r.Len(feeds, 1)
+		return feeds[0]
+	}
+
+	a.Equal(entity.PullFail, pull().Status())
+	a.Equal(entity.PullFail, pull().Status())
+
+	feed := getFeed()
+	a.True(feed.IsFailing())
+	a.Equal(uint32(2), feed.ConsecutiveFailures)
+	a.Equal(pointer(herr.Error()), feed.LastError)
+	a.Equal(2, db.countTableRows("feed_pulls"))
+	a.True(db.rowExists(
+		`SELECT * FROM feed_pulls
+		WHERE feed_id = ? AND http_status = 500 AND num_entries IS NULL AND error = ?`,
+		feedID,
+		herr.Error(),
+	))
+
+	res := pull()
+	r.NoError(res.Error())
+	a.Equal(entity.PullSuccess, res.Status())
+	a.Equal(uint32(0), res.Feed().ConsecutiveFailures)
+
+	feed = getFeed()
+	a.False(feed.IsFailing())
+	a.Nil(feed.LastError)
+	a.Equal(3, db.countTableRows("feed_pulls"))
+	a.True(db.rowExists(
+		`SELECT * FROM feed_pulls
+		WHERE
+			feed_id = ?
+			AND http_status = 200
+			AND num_entries = 2
+			AND num_new_entries = 1
+			AND error IS NULL`,
+		feedID,
+	))
+}
+
+func TestPullFeedsSelectedBackoff(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}}
+	keys := db.addFeeds(dbFeeds)
+	ids := []entity.ID{keys[dbFeeds[0].title].ID}
+
+	herr := RetryAfterError{
+		Err:        gofeed.HTTPError{StatusCode: 503, Status: "503 Service Unavailable"},
+		RetryAfter: 2 * time.Hour,
+	}
+	gomock.InOrder(
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Times(1).
+			Return(nil, nil, herr),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Times(1).
+			Return(nil, &FetchCache{StatusCode: 304}, nil),
+	)
+
+	pull := func(force bool) entity.PullResult {
+		got := make([]entity.PullResult, 0)
+		for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, force) {
+			got = append(got, res)
+		}
+		r.Len(got, 1)
+		return got[0]
+	}
+
+	start := time.Now()
+	a.Equal(entity.PullFail, pull(false).Status())
+
+	feeds, err := db.ListFeeds(context.Background(), nil)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	r.NotNil(feeds[0].BackoffUntil)
+	a.WithinRange(*feeds[0].BackoffUntil, start.Add(2*time.Hour-time.Second), start.Add(3*time.Hour))
+	a.False(feeds[0].IsPaused)
+
+	// The feed is not fetched while backing off, unless forced.
+	res := pull(false)
+	a.Equal(entity.PullSkipped, res.Status())
+	a.NoError(res.Error())
+	a.Equal(dbFeeds[0].feedURL, res.URL())
+
+	a.Equal(entity.PullNotModified, pull(true).Status())
+
+	feeds, err = db.ListFeeds(context.Background(), nil)
+	r.NoError(err)
+	a.Nil(feeds[0].BackoffUntil)
+	a.Zero(feeds[0].ConsecutiveFailures)
+}
+
+func TestPullFeedsAllRefreshHints(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}}
+	keys := db.addFeeds(dbFeeds)
+	ids := []entity.ID{keys[dbFeeds[0].title].ID}
+
+	gfeed := gofeed.Feed{Title: "Feed A", Custom: map[string]string{customKeyTTL: "60"}}
+	gomock.InOrder(
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Times(1).
+			Return(&gfeed, &FetchCache{StatusCode: 200}, nil),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Times(2).
+			Return(nil, &FetchCache{StatusCode: 304}, nil),
+	)
+
+	pull := func(ids []entity.ID, force bool) entity.PullResult {
+		got := make([]entity.PullResult, 0)
+		for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, force) {
+			got = append(got, res)
+		}
+		r.Len(got, 1)
+		return got[0]
+	}
+
+	start := time.Now()
+	a.Equal(entity.PullSuccess, pull(nil, false).Status())
+
+	feeds, err := db.ListFeeds(context.Background(), nil)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	r.NotNil(feeds[0].NextPullTime)
+	a.WithinRange(*feeds[0].NextPullTime, start.Add(time.Hour-time.Second), start.Add(2*time.Hour))
+	a.False(feeds[0].IsDue(start))
+
+	// The feed is not pulled before it is due, unless selected or forced.
+	a.Equal(entity.PullSkipped, pull(nil, false).Status())
+	a.Equal(entity.PullNotModified, pull(ids, false).Status())
+	a.Equal(entity.PullNotModified, pull(nil, true).Status())
+
+	// The stored hints still apply to responses that are not modified.
+	feeds, err = db.ListFeeds(context.Background(), nil)
+	r.NoError(err)
+	r.NotNil(feeds[0].NextPullTime)
+	a.True(feeds[0].NextPullTime.After(start.Add(time.Hour - time.Second)))
+}
+
+func TestPullFeedsSelectedGonePauses(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}}
+	keys := db.addFeeds(dbFeeds)
+	ids := []entity.ID{keys[dbFeeds[0].title].ID}
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(1).
+		Return(nil, nil, gofeed.HTTPError{StatusCode: 410, Status: "410 Gone"})
+
+	for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, false) {
+		a.Equal(entity.PullFail, res.Status())
+	}
+
+	feeds, err := db.ListFeeds(context.Background(), nil)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.True(feeds[0].IsPaused)
+
+	// Paused feeds are skipped even without any backoff.
+	_, err = db.handle.Exec(`UPDATE feeds SET backoff_until = NULL`)
+	r.NoError(err)
+	for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, false) {
+		a.Equal(entity.PullSkipped, res.Status())
+	}
+
+	// Resuming the feed lets it be pulled again.
+	_, err = db.EditFeeds(
+		context.Background(),
+		[]*entity.FeedEditOp{{ID: ids[0], IsPaused: pointer(false)}},
+	)
+	r.NoError(err)
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Times(1).
+		Return(nil, &FetchCache{}, nil)
+	for res := range db.PullFeeds(context.Background(), ids, nil, nil, nil, false) {
+		a.Equal(entity.PullNotModified, res.Status())
+	}
+}
+
+func TestPullFeedsSelectedPermanentRedirect(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{{title: "Entry A1", extID: "A1"}},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	feedID := keys[dbFeeds[0].title].ID
+
+	newURL := "https://a.com/feed.xml"
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Return(nil, &FetchCache{StatusCode: 304, PermanentURL: &newURL}, nil)
+
+	got := make([]entity.PullResult, 0)
+	for res := range db.PullFeeds(context.Background(), []entity.ID{feedID}, nil, nil, nil, false) {
+		got = append(got, res)
+	}
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Equal(entity.PullNotModified, got[0].Status())
+	a.Equal(newURL, got[0].URL())
+
+	a.Equal(1, db.countFeeds())
+	a.Equal(1, db.countEntries(newURL))
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE id = ? AND feed_url = ?`, feedID, newURL))
+	a.True(db.rowExists(
+		`SELECT * FROM feed_pulls WHERE feed_id = ? AND moved_from = ?`,
+		feedID,
+		dbFeeds[0].feedURL,
+	))
+}
+
+func TestPullFeedsSelectedPermanentRedirectCredentials(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	tests := []struct {
+		newURL    string
+		keepCreds bool
+	}{
+		{newURL: "https://a.com/new.xml", keepCreds: true},
+		{newURL: "https://b.com/feed.xml", keepCreds: false},
+	}
+
+	for _, test := range tests {
+		db := newTestSQLiteDB(t)
+
+		feedURL := "https://a.com/feed.xml"
+		keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: feedURL}})
+		feedID := keys["Feed A"].ID
+
+		creds := &entity.FeedCredentials{Token: pointer("t0k")}
+		ops := []*entity.FeedEditOp{{ID: feedID, Credentials: creds}}
+		_, err := db.EditFeeds(context.Background(), ops)
+		r.NoError(err)
+
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), feedURL, creds, gomock.Any()).
+			Return(nil, &FetchCache{StatusCode: 304, PermanentURL: &test.newURL}, nil)
+
+		for res := range db.PullFeeds(context.Background(), []entity.ID{feedID}, nil, nil, nil, false) {
+			r.NoError(res.Error())
+		}
+
+		a.True(db.rowExists(
+			`SELECT * FROM feeds WHERE id = ? AND feed_url = ?`,
+			feedID,
+			test.newURL,
+		))
+		a.Equal(
+			test.keepCreds,
+			db.rowExists(`SELECT * FROM feeds WHERE id = ? AND auth_token = 't0k'`, feedID),
+			test.newURL,
+		)
+	}
+}
+
+func TestPullFeedsSelectedPermanentRedirectMerges(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:     "Feed A",
+			feedURL:   "http://a.com/feed.xml",
+			isStarred: true,
+			tags:      []string{"x"},
+			entries: []*entryRecord{
+				{title: "Entry A1", extID: "A1", isBookmarked: true},
+				{title: "Entry A2", extID: "A2", isRead: true},
+			},
+		},
+		{
+			title:   "Feed B",
+			feedURL: "https://a.com/feed.xml",
+			tags:    []string{"y"},
+			entries: []*entryRecord{{title: "Entry B1", extID: "A1"}},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	oldID := keys[dbFeeds[0].title].ID
+	targetID := keys[dbFeeds[1].title].ID
+
+	db.parser.EXPECT().
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+		Return(nil, &FetchCache{StatusCode: 304, PermanentURL: &dbFeeds[1].feedURL}, nil)
+
+	got := make([]entity.PullResult, 0)
+	for res := range db.PullFeeds(context.Background(), []entity.ID{oldID}, nil, nil, nil, false) {
+		got = append(got, res)
+	}
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Equal(dbFeeds[1].feedURL, got[0].URL())
+
+	feeds, err := db.ListFeeds(context.Background(), nil)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	feed := feeds[0]
+	a.Equal(targetID, feed.ID)
+	a.True(feed.IsStarred)
+	a.ElementsMatch([]string{"x", "y"}, feed.Tags)
+
+	a.Equal(2, db.countEntries(dbFeeds[1].feedURL))
+	a.True(db.rowExists(
+		`SELECT * FROM entries WHERE feed_id = ? AND external_id = 'A1' AND is_bookmarked`,
+		targetID,
+	))
+	a.True(db.rowExists(
+		`SELECT * FROM entries WHERE feed_id = ? AND external_id = 'A2' AND is_read`,
+		targetID,
+	))
+	a.True(db.rowExists(
+		`SELECT * FROM feed_pulls WHERE feed_id = ? AND moved_from = ?`,
+		targetID,
+		dbFeeds[0].feedURL,
+	))
+}
+
+func TestPullFeedsSelectedPermanentRedirectMergesState(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:        "Entry A1",
+					extID:        "A1",
+					isRead:       true,
+					isBookmarked: true,
+					updated:      toNullTime(mustTime(t, "2022-07-18T23:39:07.383Z")),
+				},
+				{
+					title:   "Entry A2",
+					extID:   "A2",
+					updated: toNullTime(mustTime(t, "2022-07-16T23:39:07.383Z")),
+				},
+			},
+		},
+		{
+			title:   "Feed B",
+			feedURL: "https://a.com/feed.xml",
+			entries: []*entryRecord{
+				{
+					title:   "Entry A1",
+					extID:   "A1",
+					updated: toNullTime(mustTime(t, "2022-07-18T23:39:07.383Z")),
+				},
+			},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	oldID := keys[dbFeeds[0].title].ID
+	targetID := keys[dbFeeds[1].title].ID
+
+	creds := &entity.FeedCredentials{Token: pointer("t0k")}
+	ops := []*entity.FeedEditOp{
+		{
+			ID:           oldID,
+			PullInterval: pointer(2 * time.Hour),
+			Retention:    &entity.RetentionPolicy{MaxEntries: pointer(uint32(1))},
+			Credentials:  creds,
+		},
+	}
+	_, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	pruned, err := db.PruneEntries(context.Background(), []entity.ID{oldID}, false)
+	r.NoError(err)
+	r.Equal([]string{"Entry A2"}, entryTitles(pruned))
+
+	gomock.InOrder(
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, creds, gomock.Any()).
+			Return(nil, &FetchCache{StatusCode: 304, PermanentURL: &dbFeeds[1].feedURL}, nil),
+		// The merged feed still lists the entry pruned from the moved feed.
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[1].feedURL, gomock.Any(), gomock.Any()).
+			Return(toGFeed(t, dbFeeds[0]), &FetchCache{}, nil),
+	)
+
+	for res := range db.PullFeeds(context.Background(), []entity.ID{oldID}, nil, nil, nil, false) {
+		r.NoError(res.Error())
+	}
+
+	a.True(db.rowExists(
+		`SELECT * FROM entries WHERE feed_id = ? AND external_id = 'A1' AND is_read AND is_bookmarked`,
+		targetID,
+	))
+	a.True(db.rowExists(
+		`SELECT * FROM feeds WHERE id = ?
+			AND pull_interval = 7200 AND retain_max_entries = 1 AND auth_token = 't0k'`,
+		targetID,
+	))
+	a.True(db.rowExists(
+		`SELECT * FROM pruned_entries WHERE feed_id = ? AND external_id = 'A2'`,
+		targetID,
+	))
+
+	for res := range db.PullFeeds(context.Background(), []entity.ID{targetID}, nil, nil, nil, true) {
+		r.NoError(res.Error())
+	}
+	a.Equal(1, db.countEntries(dbFeeds[1].feedURL))
+	a.False(db.rowExists(`SELECT * FROM entries WHERE external_id = 'A2'`))
+}
+
+func TestPullBackoff(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	a.Equal(time.Duration(0), pullBackoff(0, nil))
+	a.Equal(15*time.Minute, pullBackoff(1, nil))
+	a.Equal(30*time.Minute, pullBackoff(2, nil))
+	a.Equal(4*time.Hour, pullBackoff(5, nil))
+	a.Equal(16*time.Hour, pullBackoff(7, nil))
+	a.Equal(24*time.Hour, pullBackoff(8, nil))
+	a.Equal(24*time.Hour, pullBackoff(1000, nil))
+	a.Equal(3*time.Hour, pullBackoff(1, pointer(3*time.Hour)))
+	a.Equal(30*time.Minute, pullBackoff(2, pointer(time.Minute)))
+	a.Equal(24*time.Hour, pullBackoff(1, pointer(1000*time.Hour)))
+}
+
 func toGFeed(t *testing.T, feed *feedRecord) *gofeed.Feed {
 	t.Helper()
 	gfeed := gofeed.Feed{
@@ -717,14 +1819,14 @@ func setupComplexDBFixture(t *testing.T) (
 	}
 
 	db.parser.EXPECT().
-		ParseURLWithContext(dbFeeds[0].feedURL, gomock.Any()).
+		ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
 		MaxTimes(1).
-		Return(toGFeed(t, pulledFeeds[0]), nil)
+		Return(toGFeed(t, pulledFeeds[0]), &FetchCache{}, nil)
 
 	db.parser.EXPECT().
-		ParseURLWithContext(dbFeeds[1].feedURL, gomock.Any()).
+		ParseURLIfModified(gomock.Any(), dbFeeds[1].feedURL, nil, gomock.Any()).
 		MaxTimes(1).
-		Return(toGFeed(t, pulledFeeds[1]), nil)
+		Return(toGFeed(t, pulledFeeds[1]), &FetchCache{}, nil)
 
 	return db, dbFeeds, keys, pulledFeeds
 }
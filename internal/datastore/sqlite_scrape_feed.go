@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ScrapeFeed extracts the entries of the web page at the given URL with the given scrape spec,
+// without storing anything. It is meant for trying out scrape specs before adding the feed.
+func (db *SQLite) ScrapeFeed(
+	ctx context.Context,
+	pageURL string,
+	spec *entity.ScrapeSpec,
+	timeout *time.Duration,
+) ([]*entity.Entry, error) {
+
+	fail := failF("SQLite.ScrapeFeed")
+
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	feed, _, err := db.parser.ScrapeURLIfModified(ctx, pageURL, nil, spec, nil)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	entries := make([]*entity.Entry, 0, len(feed.Items))
+	for _, item := range feed.Items {
+		entries = append(entries, &entity.Entry{
+			Title:       item.Title,
+			ExtID:       item.GUID,
+			Published:   item.PublishedParsed,
+			Description: pointerOrNil(item.Description),
+			URL:         pointerOrNil(item.Link),
+		})
+	}
+
+	return entries, nil
+}
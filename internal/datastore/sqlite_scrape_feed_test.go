@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestScrapeFeedOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	pageURL := "https://bar.com/news"
+	spec := &entity.ScrapeSpec{Item: "article", Title: "h2"}
+	published := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	feed := gofeed.Feed{
+		Title: "News",
+		Items: []*gofeed.Item{
+			{
+				GUID:            "scrape:1",
+				Title:           "Post",
+				Link:            "https://bar.com/posts/1",
+				PublishedParsed: &published,
+			},
+		},
+	}
+
+	db.parser.EXPECT().
+		ScrapeURLIfModified(gomock.Any(), pageURL, nil, spec, nil).
+		Return(&feed, &FetchCache{}, nil)
+
+	entries, err := db.ScrapeFeed(context.Background(), pageURL, spec, nil)
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.Entry{
+			{
+				Title:     "Post",
+				ExtID:     "scrape:1",
+				URL:       pointer("https://bar.com/posts/1"),
+				Published: &published,
+			},
+		},
+		entries,
+	)
+	a.Equal(0, db.countFeeds())
+}
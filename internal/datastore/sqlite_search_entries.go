@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// snippetNumTokens is the maximum number of tokens in a search result snippet.
+const snippetNumTokens = 24
+
+// SearchEntries returns entries matching the given full-text search query, ordered by relevance.
+// The query follows the SQLite FTS5 syntax, so phrase queries ("foo bar"), prefix queries (foo*),
+// and boolean operators (AND, OR, NOT) are supported. Results may be scoped to the given feeds
+// and/or to feeds having any of the given tags.
+func (db *SQLite) SearchEntries(
+	ctx context.Context,
+	query string,
+	feedIDs []entity.ID,
+	tags []string,
+	maxResults *uint32,
+) ([]*entity.SearchResult, error) {
+
+	fail := failF("SQLite.SearchEntries")
+
+	if strings.TrimSpace(query) == "" {
+		return nil, fail(entity.InvalidSearchQueryError{
+			Query: query,
+			Err:   errors.New("query is empty"),
+		})
+	}
+
+	recs := make([]*searchResultRecord, 0)
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		irecs, err := searchEntries(ctx, tx, query, feedIDs, tags, maxResults)
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return searchResultRecords(recs).results(), nil
+}
+
+func searchEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	query string,
+	feedIDs []ID,
+	tags []string,
+	numMaxResults *uint32,
+) ([]*searchResultRecord, error) {
+
+	// bm25 weights are given per indexed column: title, description, content.
+	sql1 := `
+		SELECT
+			e.id AS id
+			, e.feed_id AS feed_id
+			, e.title AS title
+			, e.is_read AS is_read
+			, e.is_bookmarked AS is_bookmarked
+			, e.external_id AS ext_id
+			, e.description AS description
+			, e.content AS content
+			, e.url AS url
+			, e.update_time AS update_time
+			, e.pub_time AS pub_time
+			, snippet(entries_fts, -1, $4, $5, '…', $6) AS snippet
+			, bm25(entries_fts, 10.0, 5.0, 1.0) AS rank
+		FROM
+			entries_fts
+			INNER JOIN entries e ON e.id = entries_fts.rowid
+		WHERE
+			entries_fts MATCH $1
+			AND COALESCE(e.feed_id IN (SELECT value FROM json_each($2)), true)
+			AND (
+				json_type($3) = 'null'
+				OR e.feed_id IN (
+					SELECT
+						fxft.feed_id
+					FROM
+						feeds_x_feed_tags fxft
+						INNER JOIN feed_tags ft ON fxft.feed_tag_id = ft.id
+					WHERE
+						ft.name IN (SELECT value FROM json_each($3))
+				)
+			)
+		ORDER BY
+			rank
+			, COALESCE(e.update_time, e.pub_time) DESC
+`
+
+	if numMaxResults != nil {
+		nmax := *numMaxResults
+		if nmax == 0 {
+			return nil, nil
+		}
+		sql1 += fmt.Sprintf("\nLIMIT %d", nmax)
+	}
+
+	scanRow := func(rows *sql.Rows) (*searchResultRecord, error) {
+		var (
+			rec   searchResultRecord
+			entry entryRecord
+		)
+		if err := rows.Scan(
+			&entry.id,
+			&entry.feedID,
+			&entry.title,
+			&entry.isRead,
+			&entry.isBookmarked,
+			&entry.extID,
+			&entry.description,
+			&entry.content,
+			&entry.url,
+			&entry.updated,
+			&entry.published,
+			&rec.snippet,
+			&rec.rank,
+		); err != nil {
+			return nil, err
+		}
+		rec.entry = &entry
+		return &rec, nil
+	}
+
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	feedIDsJSON, err := toJSONArrayOrNull(feedIDs)
+	if err != nil {
+		return nil, err
+	}
+	tagsJSON, err := toJSONArrayOrNull(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := stmt1.QueryContext(
+		ctx,
+		query,
+		feedIDsJSON,
+		tagsJSON,
+		entity.SnippetMatchStart,
+		entity.SnippetMatchEnd,
+		snippetNumTokens,
+	)
+	if err != nil {
+		return nil, asSearchQueryErr(query, err)
+	}
+	defer rows.Close()
+
+	results := make([]*searchResultRecord, 0)
+	for rows.Next() {
+		rec, err := scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, asSearchQueryErr(query, err)
+	}
+
+	return results, nil
+}
+
+// toJSONArrayOrNull serializes the given values into a JSON array, or into a JSON null if there
+// are no values. This allows the values to be used as optional filters with json_each.
+func toJSONArrayOrNull[T any](values []T) (string, error) {
+	if len(values) == 0 {
+		return "null", nil
+	}
+	s, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
+
+// asSearchQueryErr wraps the given error into an entity.InvalidSearchQueryError if it was raised
+// by SQLite because of a malformed full-text query.
+func asSearchQueryErr(query string, err error) error {
+	var serr *sqlite.Error
+	if errors.As(err, &serr) && serr.Code() == sqlite3.SQLITE_ERROR {
+		return entity.InvalidSearchQueryError{Query: query, Err: err}
+	}
+	return err
+}
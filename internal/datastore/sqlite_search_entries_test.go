@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func setupSearchDBFixture(t *testing.T) (testSQLiteDB, map[string]feedKey) {
+	t.Helper()
+
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			tags:    []string{"go"},
+			entries: []*entryRecord{
+				{
+					title:       "Generics in practice",
+					description: toNullString("Type parameters for everyday code"),
+					updated:     toNullTime(mustTime(t, "2023-01-01T10:00:00Z")),
+				},
+				{
+					title:   "Garbage collection tuning",
+					content: toNullString("Notes on tuning the garbage collector of the runtime."),
+					updated: toNullTime(mustTime(t, "2023-01-02T10:00:00Z")),
+				},
+			},
+		},
+		{
+			title:   "Feed X",
+			feedURL: "http://x.com/feed.xml",
+			tags:    []string{"misc"},
+			entries: []*entryRecord{
+				{
+					title:   "Collection of recipes",
+					content: toNullString("A garbage-free kitchen and a collection of recipes."),
+					updated: toNullTime(mustTime(t, "2023-01-03T10:00:00Z")),
+				},
+			},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+	r.Equal(2, db.countFeeds())
+
+	return db, keys
+}
+
+func TestSearchEntriesOkRanked(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupSearchDBFixture(t)
+
+	results, err := db.SearchEntries(context.Background(), "collection", nil, nil, nil)
+	r.NoError(err)
+	r.Len(results, 2)
+
+	// Title matches are weighted more than content matches.
+	a.Equal(keys["Feed X"].Entries["Collection of recipes"], results[0].Entry.ID)
+	a.Equal(keys["Feed A"].Entries["Garbage collection tuning"], results[1].Entry.ID)
+	a.LessOrEqual(results[0].Rank, results[1].Rank)
+	a.Contains(
+		results[0].Snippet,
+		entity.SnippetMatchStart+"Collection"+entity.SnippetMatchEnd,
+	)
+}
+
+func TestSearchEntriesOkPhrase(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupSearchDBFixture(t)
+
+	results, err := db.SearchEntries(
+		context.Background(),
+		`"garbage collector"`,
+		nil,
+		nil,
+		nil,
+	)
+	r.NoError(err)
+	r.Len(results, 1)
+
+	a.Equal(keys["Feed A"].Entries["Garbage collection tuning"], results[0].Entry.ID)
+	a.Equal("Garbage collection tuning", results[0].Entry.Title)
+}
+
+func TestSearchEntriesOkScoped(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupSearchDBFixture(t)
+
+	results, err := db.SearchEntries(
+		context.Background(),
+		"garbage",
+		[]entity.ID{keys["Feed X"].ID},
+		nil,
+		nil,
+	)
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal(keys["Feed X"].ID, results[0].Entry.FeedID)
+
+	results, err = db.SearchEntries(context.Background(), "garbage", nil, []string{"go"}, nil)
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal(keys["Feed A"].ID, results[0].Entry.FeedID)
+
+	results, err = db.SearchEntries(context.Background(), "garbage", nil, nil, pointer(uint32(1)))
+	r.NoError(err)
+	a.Len(results, 1)
+}
+
+func TestSearchEntriesOkUpdatedIndex(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupSearchDBFixture(t)
+
+	r.NoError(db.DeleteFeeds(context.Background(), []entity.ID{keys["Feed X"].ID}))
+
+	results, err := db.SearchEntries(context.Background(), "recipes", nil, nil, nil)
+	r.NoError(err)
+	a.Empty(results)
+}
+
+func TestSearchEntriesErrInvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db, _ := setupSearchDBFixture(t)
+
+	for _, query := range []string{"", `"unterminated`} {
+		results, err := db.SearchEntries(context.Background(), query, nil, nil, nil)
+		a.Nil(results)
+		a.ErrorAs(err, &entity.InvalidSearchQueryError{})
+	}
+}
@@ -28,6 +28,8 @@ func newTestSQLiteDB(t *testing.T) testSQLiteDB {
 	prs := NewMockParser(gomock.NewController(t))
 	s, err := newSQLiteWithParser(dbPath, prs)
 	require.NoError(t, err)
+	// Test feeds do not hit real hosts, so there is no need to space their pulls apart.
+	s.SetPullHostDelay(0)
 
 	return testSQLiteDB{s, t, prs}
 }
@@ -236,11 +238,13 @@ func (db *testSQLiteDB) addFeeds(feeds []*feedRecord) map[string]feedKey {
 			, external_id
 			, title
 			, url
+			, description
+			, content
 			, is_read
 			, is_bookmarked
 			, update_time
 		)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 		RETURNING id
 	`)
 	require.NoError(db.t, err)
@@ -284,6 +288,8 @@ func (db *testSQLiteDB) addFeeds(feeds []*feedRecord) map[string]feedKey {
 				extID,
 				entry.title,
 				entry.url,
+				entry.description,
+				entry.content,
 				entry.isRead,
 				entry.isBookmarked,
 				updateTime,
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ListWebSubSubscriptions returns the WebSub subscriptions of all feeds that advertise a hub.
+func (db *SQLite) ListWebSubSubscriptions(
+	ctx context.Context,
+) ([]*entity.WebSubSubscription, error) {
+
+	subs := make([]*entity.WebSubSubscription, 0)
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+
+		sql1 := `
+			SELECT
+				feed_id
+				, hub_url
+				, topic_url
+				, state
+				, secret
+				, lease_expire_time
+			FROM
+				websub_subscriptions
+			ORDER BY
+				feed_id
+`
+		rows, err := tx.QueryContext(ctx, sql1)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				sub    entity.WebSubSubscription
+				secret sql.NullString
+				lease  sql.NullTime
+			)
+			err := rows.Scan(
+				&sub.FeedID,
+				&sub.HubURL,
+				&sub.TopicURL,
+				&sub.State,
+				&secret,
+				&lease,
+			)
+			if err != nil {
+				return err
+			}
+			sub.Secret = fromNullString(secret)
+			sub.LeaseExpires = fromNullTime(lease)
+			subs = append(subs, &sub)
+		}
+
+		return rows.Err()
+	}
+
+	fail := failF("SQLite.ListWebSubSubscriptions")
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return subs, nil
+}
+
+// UpdateWebSubSubscription sets the state, secret, and lease expiry time of the given WebSub
+// subscription. The hub and topic URLs are not updated; instead, they must match those currently
+// advertised by the feed, so that subscriptions replaced in the meantime are left untouched.
+func (db *SQLite) UpdateWebSubSubscription(
+	ctx context.Context,
+	sub *entity.WebSubSubscription,
+) error {
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+
+		var (
+			secret sql.NullString
+			lease  sql.NullTime
+		)
+		if v := sub.Secret; v != nil {
+			secret = sql.NullString{String: *v, Valid: true}
+		}
+		if v := sub.LeaseExpires; v != nil {
+			lease = sql.NullTime{Time: *v, Valid: true}
+		}
+
+		sql1 := `
+			UPDATE
+				websub_subscriptions
+			SET
+				state = ?
+				, secret = ?
+				, lease_expire_time = ?
+			WHERE
+				feed_id = ?
+				AND hub_url = ?
+				AND topic_url = ?
+`
+		res, err := tx.ExecContext(
+			ctx,
+			sql1,
+			string(sub.State),
+			secret,
+			lease,
+			sub.FeedID,
+			sub.HubURL,
+			sub.TopicURL,
+		)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n != 1 {
+			return entity.FeedNotFoundError{ID: sub.FeedID}
+		}
+		return nil
+	}
+
+	fail := failF("SQLite.UpdateWebSubSubscription")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return fail(err)
+	}
+
+	return nil
+}
+
+// StorePushedFeed stores the feed contents pushed by the WebSub hub of the feed with the given ID,
+// through the same path as pulled contents, and returns the number of added entries. Like pulls,
+// the full content of the added entries is extracted if the feed fetches full content.
+func (db *SQLite) StorePushedFeed(
+	ctx context.Context,
+	feedID entity.ID,
+	content []byte,
+) (int, error) {
+
+	fail := failF("SQLite.StorePushedFeed")
+
+	gfeed, err := db.parser.Parse(bytes.NewReader(content))
+	if err != nil {
+		return 0, fail(err)
+	}
+
+	var added []ID
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+
+		updateTime := resolveFeedUpdateTime(gfeed)
+		if updateTime == nil {
+			updateTime = pointer(time.Now())
+		}
+		if err := setFeedUpdateTime(ctx, tx, feedID, updateTime); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.FeedNotFoundError{ID: feedID}
+			}
+			return err
+		}
+		if len(gfeed.Items) == 0 {
+			return nil
+		}
+
+		ids, err := upsertEntries(ctx, tx, feedID, gfeed.Items)
+		if err != nil {
+			return err
+		}
+
+		pruned, err := pruneFeedEntries(ctx, tx, feedID, db.retention, time.Now(), false)
+		if err != nil {
+			return err
+		}
+		added = withoutPruned(ids, pruned)
+
+		return nil
+	}
+
+	db.mu.Lock()
+	err = db.withTx(ctx, dbFunc)
+	db.mu.Unlock()
+	if err != nil {
+		return 0, fail(err)
+	}
+
+	db.extractNewEntriesContent(ctx, added, nil)
+
+	return len(added), nil
+}
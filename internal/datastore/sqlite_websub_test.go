@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestWebSubSubscriptionsFollowPulls(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}}
+	keys := db.addFeeds(dbFeeds)
+	feedID := keys[dbFeeds[0].title].ID
+
+	withHub := func(hub string) *gofeed.Feed {
+		return &gofeed.Feed{Title: "Feed A", Custom: map[string]string{customKeyHub: hub}}
+	}
+	gomock.InOrder(
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Return(withHub("http://hub.com/"), &FetchCache{}, nil),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Return(withHub("http://hub.com/"), &FetchCache{}, nil),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Return(withHub("http://other-hub.com/"), &FetchCache{}, nil),
+		db.parser.EXPECT().
+			ParseURLIfModified(gomock.Any(), dbFeeds[0].feedURL, nil, gomock.Any()).
+			Return(&gofeed.Feed{Title: "Feed A"}, &FetchCache{}, nil),
+	)
+
+	pull := func() {
+		for res := range db.PullFeeds(context.Background(), nil, nil, nil, nil, true) {
+			r.NoError(res.Error())
+		}
+	}
+	list := func() []*entity.WebSubSubscription {
+		subs, err := db.ListWebSubSubscriptions(context.Background())
+		r.NoError(err)
+		return subs
+	}
+
+	// The topic defaults to the feed URL when the feed has no self link.
+	pull()
+	subs := list()
+	r.Len(subs, 1)
+	a.Equal(
+		&entity.WebSubSubscription{
+			FeedID:   feedID,
+			HubURL:   "http://hub.com/",
+			TopicURL: dbFeeds[0].feedURL,
+			State:    entity.WebSubUnsubscribed,
+		},
+		subs[0],
+	)
+
+	active := *subs[0]
+	active.State = entity.WebSubActive
+	active.Secret = pointer("s3cr3t")
+	active.LeaseExpires = pointer(time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC))
+	r.NoError(db.UpdateWebSubSubscription(context.Background(), &active))
+
+	// Pulls advertising the same hub keep the subscription.
+	pull()
+	subs = list()
+	r.Len(subs, 1)
+	a.Equal(entity.WebSubActive, subs[0].State)
+	a.Equal(pointer("s3cr3t"), subs[0].Secret)
+	r.NotNil(subs[0].LeaseExpires)
+	a.True(active.LeaseExpires.Equal(*subs[0].LeaseExpires))
+
+	// A new hub starts the subscription over, and updates for the old hub are rejected.
+	pull()
+	subs = list()
+	r.Len(subs, 1)
+	a.Equal("http://other-hub.com/", subs[0].HubURL)
+	a.Equal(entity.WebSubUnsubscribed, subs[0].State)
+	a.Nil(subs[0].Secret)
+	a.Nil(subs[0].LeaseExpires)
+
+	err := db.UpdateWebSubSubscription(context.Background(), &active)
+	a.ErrorIs(err, entity.FeedNotFoundError{ID: feedID})
+
+	// Feeds that stop advertising a hub lose their subscription.
+	pull()
+	a.Empty(list())
+}
+
+func TestStorePushedFeedOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}}
+	keys := db.addFeeds(dbFeeds)
+	feedID := keys[dbFeeds[0].title].ID
+
+	content := []byte("<feed/>")
+	updated := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	gfeed := gofeed.Feed{
+		Title: "Feed A",
+		Items: []*gofeed.Item{
+			{GUID: "entry1", Title: "Entry 1", UpdatedParsed: &updated},
+			{GUID: "entry2", Title: "Entry 2", UpdatedParsed: &updated},
+		},
+	}
+	db.parser.EXPECT().
+		Parse(gomock.Any()).
+		Times(2).
+		Return(&gfeed, nil)
+
+	n, err := db.StorePushedFeed(context.Background(), feedID, content)
+	r.NoError(err)
+	a.Equal(2, n)
+	a.Equal(2, db.countEntries(dbFeeds[0].feedURL))
+
+	// Pushed entries are upserted like pulled ones.
+	n, err = db.StorePushedFeed(context.Background(), feedID, content)
+	r.NoError(err)
+	a.Equal(0, n)
+	a.Equal(2, db.countEntries(dbFeeds[0].feedURL))
+	a.Equal(&updated, db.getFeedUpdateTime(dbFeeds[0].feedURL))
+}
+
+func TestStorePushedFeedErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	db := newTestSQLiteDB(t)
+
+	db.parser.EXPECT().
+		Parse(gomock.Any()).
+		Return(&gofeed.Feed{Title: "Feed A"}, nil)
+
+	n, err := db.StorePushedFeed(context.Background(), entity.ID(42), []byte("<feed/>"))
+	assert.Zero(t, n)
+	assert.ErrorIs(t, err, entity.FeedNotFoundError{ID: entity.ID(42)})
+}
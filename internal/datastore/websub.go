@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/atom"
+)
+
+// Keys of the custom fields in which atomTranslator keeps the WebSub links of Atom feeds.
+const (
+	customKeyHub  = "neon:hub"
+	customKeySelf = "neon:self"
+)
+
+// atomTranslator is the default gofeed Atom translator, which additionally keeps the WebSub hub
+// and self links of the feed, as the universal feed only keeps its alternate link.
+type atomTranslator struct {
+	gofeed.DefaultAtomTranslator
+}
+
+func (t *atomTranslator) Translate(feed any) (*gofeed.Feed, error) {
+	result, err := t.DefaultAtomTranslator.Translate(feed)
+	if err != nil {
+		return nil, err
+	}
+	afeed, ok := feed.(*atom.Feed)
+	if !ok {
+		return result, nil
+	}
+	if result.Custom == nil {
+		result.Custom = make(map[string]string)
+	}
+	for _, link := range afeed.Links {
+		key := ""
+		switch strings.ToLower(strings.TrimSpace(link.Rel)) {
+		case "hub":
+			key = customKeyHub
+		case "self":
+			key = customKeySelf
+		default:
+			continue
+		}
+		if _, exists := result.Custom[key]; !exists && strings.TrimSpace(link.Href) != "" {
+			result.Custom[key] = strings.TrimSpace(link.Href)
+		}
+	}
+	return result, nil
+}
+
+// feedWebSubLinks returns the URLs of the WebSub hub and of the topic advertised by the given feed,
+// through the <link rel="hub"> and <link rel="self"> elements of Atom feeds or the <atom:link>
+// elements of RSS feeds. The returned URLs are empty if they are not advertised.
+func feedWebSubLinks(feed *gofeed.Feed) (hubURL, selfURL string) {
+
+	hubURL, selfURL = feed.Custom[customKeyHub], feed.Custom[customKeySelf]
+
+	for _, elem := range feed.Extensions["atom"]["link"] {
+		href := strings.TrimSpace(elem.Attrs["href"])
+		switch strings.ToLower(strings.TrimSpace(elem.Attrs["rel"])) {
+		case "hub":
+			if hubURL == "" {
+				hubURL = href
+			}
+		case "self":
+			if selfURL == "" {
+				selfURL = href
+			}
+		}
+	}
+
+	return hubURL, selfURL
+}
+
+// storeFeedWebSub records the WebSub hub advertised by the given feed, so that it can be
+// subscribed to. The topic is the self URL of the feed or, failing that, the URL the feed is
+// fetched from. Subscriptions whose hub or topic changes start over, and those of feeds that no
+// longer advertise a hub are removed. Nothing is changed if the feed is nil, e.g. when it has not
+// been modified.
+func storeFeedWebSub(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	feedURL string,
+	gfeed *gofeed.Feed,
+) error {
+
+	if gfeed == nil {
+		return nil
+	}
+
+	hubURL, topicURL := feedWebSubLinks(gfeed)
+	if hubURL == "" {
+		sql1 := `DELETE FROM websub_subscriptions WHERE feed_id = ?`
+		_, err := tx.ExecContext(ctx, sql1, feedID)
+		return err
+	}
+	if topicURL == "" {
+		topicURL = feedURL
+	}
+
+	sql2 := `
+		INSERT INTO
+			websub_subscriptions(
+				feed_id
+				, hub_url
+				, topic_url
+			)
+			VALUES (?, ?, ?)
+		ON CONFLICT (feed_id) DO UPDATE
+			SET
+				hub_url = excluded.hub_url
+				, topic_url = excluded.topic_url
+				, state = 'unsubscribed'
+				, secret = NULL
+				, lease_expire_time = NULL
+			WHERE
+				hub_url != excluded.hub_url
+				OR topic_url != excluded.topic_url
+`
+	_, err := tx.ExecContext(ctx, sql2, feedID, hubURL, topicURL)
+	return err
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedWebSubLinks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		doc      string
+		wantHub  string
+		wantSelf string
+	}{
+		{
+			name: "atom",
+			doc: `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Feed</title>
+  <link rel="alternate" href="https://a.com/"/>
+  <link rel="hub" href="https://hub.a.com/"/>
+  <link rel="self" href="https://a.com/atom.xml"/>
+</feed>`,
+			wantHub:  "https://hub.a.com/",
+			wantSelf: "https://a.com/atom.xml",
+		},
+		{
+			name: "rss",
+			doc: `<?xml version="1.0" encoding="utf-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+  <channel>
+    <title>Feed</title>
+    <link>https://a.com/</link>
+    <atom:link rel="self" href="https://a.com/rss.xml"/>
+    <atom:link rel="hub" href="https://hub.a.com/"/>
+  </channel>
+</rss>`,
+			wantHub:  "https://hub.a.com/",
+			wantSelf: "https://a.com/rss.xml",
+		},
+		{
+			name: "no hub",
+			doc: `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Feed</title>
+  <link rel="alternate" href="https://a.com/"/>
+</feed>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed, err := newFeedParser(FetcherConfig{}).ParseString(test.doc)
+			require.NoError(t, err)
+
+			hub, self := feedWebSubLinks(feed)
+			assert.Equal(t, test.wantHub, hub)
+			assert.Equal(t, test.wantSelf, self)
+		})
+	}
+}
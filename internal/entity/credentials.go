@@ -0,0 +1,29 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+// FeedCredentials are sent along with the requests for a feed. A feed is fetched either with
+// HTTP basic authentication or with a bearer token, but not both. Credentials are stored by the
+// datastore but never returned with the feed.
+type FeedCredentials struct {
+	Username *string
+	Password *string
+	Token    *string
+}
+
+// IsEmpty returns true if no credentials are set.
+func (c FeedCredentials) IsEmpty() bool {
+	return c.Username == nil && c.Password == nil && c.Token == nil
+}
+
+// Validate checks that the credentials use one authentication scheme only.
+func (c FeedCredentials) Validate() error {
+	if c.Token != nil && (c.Username != nil || c.Password != nil) {
+		return InvalidFeedCredentialsError{"token can not be combined with username or password"}
+	}
+	if c.Password != nil && c.Username == nil {
+		return InvalidFeedCredentialsError{"password requires a username"}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bow/neon/api"
@@ -38,7 +39,7 @@ func FromFeedPb(pb *api.Feed) *Feed {
 	if pb == nil {
 		return nil
 	}
-	return &Feed{
+	feed := &Feed{
 		ID:          pb.GetId(),
 		Title:       pb.GetTitle(),
 		Description: pb.Description,
@@ -50,7 +51,20 @@ func FromFeedPb(pb *api.Feed) *Feed {
 		IsStarred:   pb.GetIsStarred(),
 		Tags:        pb.GetTags(),
 		Entries:     fromEntryPbs(pb.GetEntries()),
+
+		PullInterval: FromDurationPb(pb.GetPullInterval()),
+
+		ConsecutiveFailures: pb.GetConsecutiveFailures(),
+		LastError:           pb.LastError,
+		BackoffUntil:        FromTimestampPb(pb.GetBackoffUntil()),
+		IsPaused:            pb.GetIsPaused(),
+		NextPullTime:        FromTimestampPb(pb.GetNextPullTime()),
+		FetchContent:        pb.GetFetchContent(),
+	}
+	if rp := FromRetentionPolicyPb(pb.GetRetention()); rp != nil {
+		feed.Retention = *rp
 	}
+	return feed
 }
 
 func FromFeedPbs(pbs []*api.Feed) []*Feed {
@@ -79,7 +93,28 @@ func FromEntryPb(pb *api.Entry) *Entry {
 		Description:  pb.Description,
 		Content:      pb.Content,
 		URL:          pb.Url,
+		Authors:      pb.GetAuthors(),
+		Categories:   pb.GetCategories(),
+		Enclosures:   fromEnclosurePbs(pb.GetEnclosures()),
+	}
+}
+
+func fromEnclosurePbs(pbs []*api.Entry_Enclosure) []*Enclosure {
+	if len(pbs) == 0 {
+		return nil
+	}
+	enclosures := make([]*Enclosure, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb == nil {
+			continue
+		}
+		enclosures = append(enclosures, &Enclosure{
+			URL:      pb.GetUrl(),
+			MIMEType: pb.MimeType,
+			Length:   pb.Length,
+		})
 	}
+	return enclosures
 }
 
 func fromEntryPbs(pbs []*api.Entry) map[ID]*Entry {
@@ -112,4 +147,22 @@ func FromTimestampPb(pb *timestamppb.Timestamp) *time.Time {
 	return &v
 }
 
+func FromDurationPb(pb *durationpb.Duration) *time.Duration {
+	if pb == nil {
+		return nil
+	}
+	v := pb.AsDuration()
+	return &v
+}
+
+func FromRetentionPolicyPb(pb *api.RetentionPolicy) *RetentionPolicy {
+	if pb == nil {
+		return nil
+	}
+	return &RetentionPolicy{
+		MaxEntries: pb.MaxEntries,
+		MaxReadAge: FromDurationPb(pb.GetMaxReadAge()),
+	}
+}
+
 const defaultExportTitle = "neon export"
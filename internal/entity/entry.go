@@ -17,6 +17,17 @@ type Entry struct {
 	Description  *string
 	Content      *string
 	URL          *string
+	Authors      []string
+	Categories   []string
+	Enclosures   []*Enclosure
+}
+
+// Enclosure is a media file attached to an entry.
+type Enclosure struct {
+	URL      string
+	MIMEType *string
+	// Length is the declared size of the file in bytes.
+	Length *uint64
 }
 
 type EntryEditOp struct {
@@ -3,7 +3,10 @@
 
 package entity
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type FeedNotFoundError struct{ ID any }
 
@@ -16,3 +19,71 @@ type EntryNotFoundError struct{ ID any }
 func (e EntryNotFoundError) Error() string {
 	return fmt.Sprintf("entry with ID=%v not found", e.ID)
 }
+
+type InvalidSearchQueryError struct {
+	Query string
+	Err   error
+}
+
+func (e InvalidSearchQueryError) Error() string {
+	return fmt.Sprintf("invalid search query %q: %s", e.Query, e.Err)
+}
+
+func (e InvalidSearchQueryError) Unwrap() error {
+	return e.Err
+}
+
+// NoFeedFoundError is returned when a URL neither points to a feed nor advertises any.
+type NoFeedFoundError struct{ URL string }
+
+func (e NoFeedFoundError) Error() string {
+	return fmt.Sprintf("no feed found at %s", e.URL)
+}
+
+// AmbiguousFeedError is returned when a URL advertises more than one feed, so one of the
+// candidates must be chosen explicitly.
+type AmbiguousFeedError struct {
+	URL        string
+	Candidates []*FeedCandidate
+}
+
+func (e AmbiguousFeedError) Error() string {
+	urls := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		urls[i] = c.URL
+	}
+	return fmt.Sprintf(
+		"found %d feeds at %s, choose one of: %s",
+		len(e.Candidates),
+		e.URL,
+		strings.Join(urls, ", "),
+	)
+}
+
+// InvalidFeedCredentialsError is returned when the credentials of a feed can not be used.
+type InvalidFeedCredentialsError struct{ Reason string }
+
+func (e InvalidFeedCredentialsError) Error() string {
+	return fmt.Sprintf("invalid feed credentials: %s", e.Reason)
+}
+
+// InvalidFeedSourceError is returned when a feed can not be read from its local file or command.
+type InvalidFeedSourceError struct{ Reason string }
+
+func (e InvalidFeedSourceError) Error() string {
+	return fmt.Sprintf("invalid feed source: %s", e.Reason)
+}
+
+// NoArticleContentError is returned when no main content can be extracted from a web page.
+type NoArticleContentError struct{ URL string }
+
+func (e NoArticleContentError) Error() string {
+	return fmt.Sprintf("no article content found at %s", e.URL)
+}
+
+// NoEntryURLError is returned when an entry does not link to any page to extract its content from.
+type NoEntryURLError struct{ ID any }
+
+func (e NoEntryURLError) Error() string {
+	return fmt.Sprintf("entry with ID=%v has no URL", e.ID)
+}
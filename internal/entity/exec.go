@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ExecScheme is the URL scheme of feeds generated by commands. The rest of the URL is the command,
+// which is run by the shell and whose standard output is parsed as the feed, e.g.
+// "exec:./report.sh --format=atom".
+const ExecScheme = "exec"
+
+// ExecCommand returns the command of the given exec: feed URL. It returns false if the URL is not
+// an exec: feed URL.
+func ExecCommand(feedURL string) (string, bool) {
+	scheme, command, found := strings.Cut(feedURL, ":")
+	if !found || !strings.EqualFold(scheme, ExecScheme) {
+		return "", false
+	}
+	return strings.TrimSpace(command), true
+}
+
+// ExecOptions configure how the command of an exec: feed is run.
+type ExecOptions struct {
+	// Dir is the working directory of the command. If nil, the command is run in the working
+	// directory of the process pulling the feed.
+	Dir *string
+	// Timeout is the maximum time the command may run for. If nil, a default timeout is used.
+	Timeout *time.Duration
+}
+
+// IsEmpty returns true if no options are set.
+func (o ExecOptions) IsEmpty() bool {
+	return o.Dir == nil && o.Timeout == nil
+}
+
+// Validate checks that the options can be used for running commands.
+func (o ExecOptions) Validate() error {
+	if o.Dir != nil && !filepath.IsAbs(*o.Dir) {
+		return InvalidFeedSourceError{"command directory must be an absolute path"}
+	}
+	if o.Timeout != nil && *o.Timeout <= 0 {
+		return InvalidFeedSourceError{"command timeout must be positive"}
+	}
+	return nil
+}
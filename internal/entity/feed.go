@@ -22,6 +22,36 @@ type Feed struct {
 	IsStarred   bool
 	Tags        []string
 	Entries     map[ID]*Entry
+
+	// PullInterval overrides the default interval between scheduled pulls of the feed.
+	PullInterval *time.Duration
+	// Retention overrides the global retention policy for entries of the feed.
+	Retention RetentionPolicy
+
+	// ConsecutiveFailures is the number of pulls that have failed since the last successful one.
+	ConsecutiveFailures uint32
+	// LastError is the error message of the last pull, if it failed.
+	LastError *string
+	// BackoffUntil is the time before which the feed is not pulled, after failed pulls.
+	BackoffUntil *time.Time
+	// IsPaused is whether pulls of the feed are paused, e.g. after the feed is reported gone.
+	IsPaused bool
+	// NextPullTime is the time before which the feed is not due a pull, according to the refresh
+	// hints of the feed and of its HTTP responses.
+	NextPullTime *time.Time
+	// FetchContent is whether the full content of new entries is extracted from the pages they
+	// link to after each pull, for feeds that only provide summaries.
+	FetchContent bool
+}
+
+// IsDue checks whether the feed is due a pull at the given time, according to its refresh hints.
+func (f *Feed) IsDue(now time.Time) bool {
+	return f.NextPullTime == nil || !now.Before(*f.NextPullTime)
+}
+
+// IsFailing checks whether the most recent pull of the feed failed.
+func (f *Feed) IsFailing() bool {
+	return f.ConsecutiveFailures > 0
 }
 
 func (f *Feed) NumEntriesTotal() int {
@@ -129,4 +159,13 @@ type FeedEditOp struct {
 	Description *string
 	Tags        *[]string
 	IsStarred   *bool
+	// PullInterval sets the scheduled pull interval of the feed; zero removes the override.
+	PullInterval *time.Duration
+	// Retention sets the retention policy fields of the feed; zero values remove the override.
+	Retention *RetentionPolicy
+	IsPaused  *bool
+	// Credentials sets the credentials used for fetching the feed; empty credentials remove them.
+	Credentials *FeedCredentials
+	// FetchContent sets whether the full content of new entries is extracted after each pull.
+	FetchContent *bool
 }
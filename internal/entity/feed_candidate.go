@@ -0,0 +1,12 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+// FeedCandidate is a feed found while looking for feeds advertised by a web page.
+type FeedCandidate struct {
+	URL   string
+	Title *string
+	// Format is the feed format, one of "rss", "atom", or "json", if known.
+	Format *string
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"net/url"
+	"path/filepath"
+)
+
+// URL schemes of feeds of email messages in local mailboxes, e.g. email newsletters. The path of
+// the URL is the mailbox, and its "group" query parameter is the sender group whose messages make
+// up the feed: the ID of their mailing list or, failing that, the address of their sender, e.g.
+// "maildir:///home/me/Mail/News?group=weekly.example.com".
+const (
+	// MaildirScheme is the URL scheme of feeds of Maildir directories.
+	MaildirScheme = "maildir"
+	// MboxScheme is the URL scheme of feeds of mbox files.
+	MboxScheme = "mbox"
+)
+
+// MailboxURL returns the URL of the feed of the messages of the given sender group in the mailbox
+// with the given scheme at the given path. An empty group gives the URL of the whole mailbox,
+// whose sender groups are found by discovering its feeds.
+func MailboxURL(scheme, path, group string) string {
+	u := url.URL{Scheme: scheme, Path: filepath.ToSlash(path)}
+	if group != "" {
+		u.RawQuery = url.Values{"group": {group}}.Encode()
+	}
+	return u.String()
+}
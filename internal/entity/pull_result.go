@@ -3,12 +3,18 @@
 
 package entity
 
+import (
+	"fmt"
+	"time"
+)
+
 // PullResult is a container for a pull operation.
 type PullResult struct {
-	status PullStatus
-	url    *string
-	feed   *Feed
-	err    error
+	status    PullStatus
+	url       *string
+	feed      *Feed
+	err       error
+	throttled time.Duration
 }
 
 func NewPullResultFromFeed(url *string, feed *Feed) PullResult {
@@ -19,6 +25,22 @@ func NewPullResultFromError(url *string, err error) PullResult {
 	return PullResult{status: PullFail, url: url, err: err}
 }
 
+// NewPullResultNotModified creates a result for a feed whose remote content has not changed
+// since it was last pulled.
+func NewPullResultNotModified(url *string) PullResult {
+	return PullResult{status: PullNotModified, url: url}
+}
+
+// NewPullResultSkipped creates a result for a feed that was not pulled because it is paused,
+// backing off after failed pulls, or not yet due according to its refresh hints.
+func NewPullResultSkipped(url *string) PullResult {
+	return PullResult{status: PullSkipped, url: url}
+}
+
+func (msg PullResult) Status() PullStatus {
+	return msg.status
+}
+
 func (msg PullResult) Feed() *Feed {
 	if msg.status == PullSuccess {
 		return msg.feed
@@ -40,6 +62,12 @@ func (msg PullResult) URL() string {
 	return ""
 }
 
+// Throttled returns how long the pull waited before fetching the feed, to stay within the
+// request limits of the feed host.
+func (msg PullResult) Throttled() time.Duration {
+	return msg.throttled
+}
+
 func (msg *PullResult) SetError(err error) {
 	msg.err = err
 }
@@ -48,9 +76,27 @@ func (msg *PullResult) SetStatus(status PullStatus) {
 	msg.status = status
 }
 
+func (msg *PullResult) SetThrottled(d time.Duration) {
+	msg.throttled = d
+}
+
 type PullStatus int
 
 const (
 	PullSuccess PullStatus = iota
 	PullFail
+	// PullNotModified indicates a successful pull of a feed that has not changed remotely.
+	PullNotModified
+	// PullSkipped indicates a feed that was not pulled because it is paused, backing off after
+	// failed pulls, or not yet due according to its refresh hints.
+	PullSkipped
 )
+
+var pullStatusNames = []string{"success", "fail", "not_modified", "skipped"}
+
+func (status PullStatus) String() string {
+	if int(status) >= 0 && int(status) < len(pullStatusNames) {
+		return pullStatusNames[status]
+	}
+	return fmt.Sprintf("PullStatus(%d)", status)
+}
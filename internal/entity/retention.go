@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import "time"
+
+// RetentionPolicy describes which entries of a feed are kept in the datastore. Bookmarked entries
+// are always kept, regardless of the policy. A nil field means no limit.
+type RetentionPolicy struct {
+	// MaxEntries is the maximum number of most recent entries kept per feed.
+	MaxEntries *uint32
+	// MaxReadAge is how long read entries are kept for, counted from their update or publication
+	// time.
+	MaxReadAge *time.Duration
+}
+
+// IsEmpty returns true if the policy does not remove any entries.
+func (p RetentionPolicy) IsEmpty() bool {
+	return p.MaxEntries == nil && p.MaxReadAge == nil
+}
+
+// Override returns a new policy whose fields are taken from the given policy if they are set, and
+// from the receiver otherwise.
+func (p RetentionPolicy) Override(other RetentionPolicy) RetentionPolicy {
+	if other.MaxEntries != nil {
+		p.MaxEntries = other.MaxEntries
+	}
+	if other.MaxReadAge != nil {
+		p.MaxReadAge = other.MaxReadAge
+	}
+	return p
+}
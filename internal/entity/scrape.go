@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+// ScrapeSpec defines a feed scraped from a web page that has no feed of its own. Each element
+// matched by the Item selector is an entry of the feed, and the other selectors are matched
+// within it.
+type ScrapeSpec struct {
+	// Item is the CSS selector of the elements containing the entries.
+	Item string
+	// Title is the CSS selector of the entry title.
+	Title string
+	// Link is the CSS selector of the element whose href, or text, is the entry URL. If nil, the
+	// first link of the item is used.
+	Link *string
+	// Date is the CSS selector of the element whose datetime attribute, or text, is the entry
+	// publication time.
+	Date *string
+	// Summary is the CSS selector of the entry summary.
+	Summary *string
+}
+
+// Validate checks that the required selectors are set.
+func (s ScrapeSpec) Validate() error {
+	if s.Item == "" {
+		return InvalidFeedSourceError{"scrape item selector is required"}
+	}
+	if s.Title == "" {
+		return InvalidFeedSourceError{"scrape title selector is required"}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+// Markers that delimit matching terms in SearchResult snippets. Control characters are used so
+// that they never clash with the entry text, and can be replaced freely by the presentation layer.
+const (
+	SnippetMatchStart = "\x02"
+	SnippetMatchEnd   = "\x03"
+)
+
+// SearchResult is a single entry matching a full-text search query.
+type SearchResult struct {
+	Entry *Entry
+	// Snippet is a short excerpt of the entry, with matching terms wrapped in SnippetMatchStart and
+	// SnippetMatchEnd.
+	Snippet string
+	// Rank is the relevance score of the result; lower values indicate better matches.
+	Rank float64
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import "time"
+
+// WebSubState is the state of the WebSub subscription of a feed.
+type WebSubState string
+
+const (
+	// WebSubUnsubscribed is the state of feeds whose hub is known, but which have not been
+	// subscribed to yet.
+	WebSubUnsubscribed WebSubState = "unsubscribed"
+	// WebSubPending is the state of subscriptions that have been requested from the hub, but whose
+	// intent has not been verified yet.
+	WebSubPending WebSubState = "pending"
+	// WebSubActive is the state of subscriptions verified by the hub.
+	WebSubActive WebSubState = "active"
+	// WebSubDenied is the state of subscriptions denied by the hub.
+	WebSubDenied WebSubState = "denied"
+)
+
+// WebSubSubscription is the subscription of a feed to the WebSub hub it advertises, through which
+// the hub pushes the contents of the feed as soon as it is updated.
+type WebSubSubscription struct {
+	FeedID ID
+	// HubURL is the URL of the hub advertised by the feed.
+	HubURL string
+	// TopicURL is the URL the feed advertises as its own, which is subscribed to at the hub.
+	TopicURL string
+	State    WebSubState
+	// Secret is the secret the hub signs pushed contents with, if the subscription was requested.
+	Secret *string
+	// LeaseExpires is when the subscription expires, if it is active.
+	LeaseExpires *time.Time
+}
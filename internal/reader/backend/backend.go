@@ -14,6 +14,11 @@ type Backend interface {
 	GetStatsF(context.Context) func() (*entity.Stats, error)
 	GetAllFeedsF(context.Context) func() ([]*entity.Feed, error)
 	PullFeedsF(context.Context, []entity.ID) func() (<-chan entity.PullResult, error)
+	AddFeedF(context.Context, string, []string) func() (*entity.Feed, bool, error)
+	EditFeedsF(context.Context, []*entity.FeedEditOp) func() ([]*entity.Feed, error)
+	DeleteFeedsF(context.Context, []entity.ID) func() error
+	EditEntriesF(context.Context, []*entity.EntryEditOp) func() ([]*entity.Entry, error)
+	ExtractEntryContentF(context.Context, entity.ID) func() (*entity.Entry, error)
 	String() string
 }
 
@@ -82,6 +82,26 @@ func (mr *MockNeonClientMockRecorder) DeleteFeeds(ctx, in any, opts ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeds", reflect.TypeOf((*MockNeonClient)(nil).DeleteFeeds), varargs...)
 }
 
+// DiscoverFeeds mocks base method.
+func (m *MockNeonClient) DiscoverFeeds(ctx context.Context, in *api.DiscoverFeedsRequest, opts ...grpc.CallOption) (*api.DiscoverFeedsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiscoverFeeds", varargs...)
+	ret0, _ := ret[0].(*api.DiscoverFeedsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverFeeds indicates an expected call of DiscoverFeeds.
+func (mr *MockNeonClientMockRecorder) DiscoverFeeds(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverFeeds", reflect.TypeOf((*MockNeonClient)(nil).DiscoverFeeds), varargs...)
+}
+
 // EditEntries mocks base method.
 func (m *MockNeonClient) EditEntries(ctx context.Context, in *api.EditEntriesRequest, opts ...grpc.CallOption) (*api.EditEntriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +162,26 @@ func (mr *MockNeonClientMockRecorder) ExportOPML(ctx, in any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOPML", reflect.TypeOf((*MockNeonClient)(nil).ExportOPML), varargs...)
 }
 
+// ExtractEntryContent mocks base method.
+func (m *MockNeonClient) ExtractEntryContent(ctx context.Context, in *api.ExtractEntryContentRequest, opts ...grpc.CallOption) (*api.ExtractEntryContentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExtractEntryContent", varargs...)
+	ret0, _ := ret[0].(*api.ExtractEntryContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractEntryContent indicates an expected call of ExtractEntryContent.
+func (mr *MockNeonClientMockRecorder) ExtractEntryContent(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractEntryContent", reflect.TypeOf((*MockNeonClient)(nil).ExtractEntryContent), varargs...)
+}
+
 // GetEntry mocks base method.
 func (m *MockNeonClient) GetEntry(ctx context.Context, in *api.GetEntryRequest, opts ...grpc.CallOption) (*api.GetEntryResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +302,26 @@ func (mr *MockNeonClientMockRecorder) ListFeeds(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockNeonClient)(nil).ListFeeds), varargs...)
 }
 
+// PruneEntries mocks base method.
+func (m *MockNeonClient) PruneEntries(ctx context.Context, in *api.PruneEntriesRequest, opts ...grpc.CallOption) (*api.PruneEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PruneEntries", varargs...)
+	ret0, _ := ret[0].(*api.PruneEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEntries indicates an expected call of PruneEntries.
+func (mr *MockNeonClientMockRecorder) PruneEntries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEntries", reflect.TypeOf((*MockNeonClient)(nil).PruneEntries), varargs...)
+}
+
 // PullFeeds mocks base method.
 func (m *MockNeonClient) PullFeeds(ctx context.Context, in *api.PullFeedsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[api.PullFeedsResponse], error) {
 	m.ctrl.T.Helper()
@@ -282,6 +342,26 @@ func (mr *MockNeonClientMockRecorder) PullFeeds(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockNeonClient)(nil).PullFeeds), varargs...)
 }
 
+// SearchEntries mocks base method.
+func (m *MockNeonClient) SearchEntries(ctx context.Context, in *api.SearchEntriesRequest, opts ...grpc.CallOption) (*api.SearchEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchEntries", varargs...)
+	ret0, _ := ret[0].(*api.SearchEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntries indicates an expected call of SearchEntries.
+func (mr *MockNeonClientMockRecorder) SearchEntries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntries", reflect.TypeOf((*MockNeonClient)(nil).SearchEntries), varargs...)
+}
+
 // StreamEntries mocks base method.
 func (m *MockNeonClient) StreamEntries(ctx context.Context, in *api.StreamEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[api.StreamEntriesResponse], error) {
 	m.ctrl.T.Helper()
@@ -356,6 +436,21 @@ func (mr *MockNeonServerMockRecorder) DeleteFeeds(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeds", reflect.TypeOf((*MockNeonServer)(nil).DeleteFeeds), arg0, arg1)
 }
 
+// DiscoverFeeds mocks base method.
+func (m *MockNeonServer) DiscoverFeeds(arg0 context.Context, arg1 *api.DiscoverFeedsRequest) (*api.DiscoverFeedsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverFeeds", arg0, arg1)
+	ret0, _ := ret[0].(*api.DiscoverFeedsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverFeeds indicates an expected call of DiscoverFeeds.
+func (mr *MockNeonServerMockRecorder) DiscoverFeeds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverFeeds", reflect.TypeOf((*MockNeonServer)(nil).DiscoverFeeds), arg0, arg1)
+}
+
 // EditEntries mocks base method.
 func (m *MockNeonServer) EditEntries(arg0 context.Context, arg1 *api.EditEntriesRequest) (*api.EditEntriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -401,6 +496,21 @@ func (mr *MockNeonServerMockRecorder) ExportOPML(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOPML", reflect.TypeOf((*MockNeonServer)(nil).ExportOPML), arg0, arg1)
 }
 
+// ExtractEntryContent mocks base method.
+func (m *MockNeonServer) ExtractEntryContent(arg0 context.Context, arg1 *api.ExtractEntryContentRequest) (*api.ExtractEntryContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractEntryContent", arg0, arg1)
+	ret0, _ := ret[0].(*api.ExtractEntryContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractEntryContent indicates an expected call of ExtractEntryContent.
+func (mr *MockNeonServerMockRecorder) ExtractEntryContent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractEntryContent", reflect.TypeOf((*MockNeonServer)(nil).ExtractEntryContent), arg0, arg1)
+}
+
 // GetEntry mocks base method.
 func (m *MockNeonServer) GetEntry(arg0 context.Context, arg1 *api.GetEntryRequest) (*api.GetEntryResponse, error) {
 	m.ctrl.T.Helper()
@@ -491,6 +601,21 @@ func (mr *MockNeonServerMockRecorder) ListFeeds(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockNeonServer)(nil).ListFeeds), arg0, arg1)
 }
 
+// PruneEntries mocks base method.
+func (m *MockNeonServer) PruneEntries(arg0 context.Context, arg1 *api.PruneEntriesRequest) (*api.PruneEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneEntries", arg0, arg1)
+	ret0, _ := ret[0].(*api.PruneEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEntries indicates an expected call of PruneEntries.
+func (mr *MockNeonServerMockRecorder) PruneEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEntries", reflect.TypeOf((*MockNeonServer)(nil).PruneEntries), arg0, arg1)
+}
+
 // PullFeeds mocks base method.
 func (m *MockNeonServer) PullFeeds(arg0 *api.PullFeedsRequest, arg1 grpc.ServerStreamingServer[api.PullFeedsResponse]) error {
 	m.ctrl.T.Helper()
@@ -505,6 +630,21 @@ func (mr *MockNeonServerMockRecorder) PullFeeds(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockNeonServer)(nil).PullFeeds), arg0, arg1)
 }
 
+// SearchEntries mocks base method.
+func (m *MockNeonServer) SearchEntries(arg0 context.Context, arg1 *api.SearchEntriesRequest) (*api.SearchEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEntries", arg0, arg1)
+	ret0, _ := ret[0].(*api.SearchEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntries indicates an expected call of SearchEntries.
+func (mr *MockNeonServerMockRecorder) SearchEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntries", reflect.TypeOf((*MockNeonServer)(nil).SearchEntries), arg0, arg1)
+}
+
 // StreamEntries mocks base method.
 func (m *MockNeonServer) StreamEntries(arg0 *api.StreamEntriesRequest, arg1 grpc.ServerStreamingServer[api.StreamEntriesResponse]) error {
 	m.ctrl.T.Helper()
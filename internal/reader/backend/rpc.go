@@ -88,17 +88,122 @@ func (r *RPC) PullFeedsF(
 					}
 					return
 				}
-				if perr := rsp.Error; perr != nil {
-					ch <- entity.NewPullResultFromError(&rsp.Url, fmt.Errorf("%s", *perr))
-					continue
+				var pr entity.PullResult
+				switch rsp.GetStatus() {
+				case api.PullFeedsResponse_NOT_MODIFIED:
+					pr = entity.NewPullResultNotModified(&rsp.Url)
+				case api.PullFeedsResponse_SKIPPED:
+					pr = entity.NewPullResultSkipped(&rsp.Url)
+				default:
+					if perr := rsp.Error; perr != nil {
+						ch <- entity.NewPullResultFromError(&rsp.Url, fmt.Errorf("%s", *perr))
+						continue
+					}
+					pr = entity.NewPullResultFromFeed(&rsp.Url, entity.FromFeedPb(rsp.GetFeed()))
+				}
+				if d := entity.FromDurationPb(rsp.GetThrottled()); d != nil {
+					pr.SetThrottled(*d)
 				}
-				ch <- entity.NewPullResultFromFeed(&rsp.Url, entity.FromFeedPb(rsp.GetFeed()))
+				ch <- pr
 			}
 		}()
 		return ch, nil
 	}
 }
 
+func (r *RPC) AddFeedF(
+	ctx context.Context,
+	feedURL string,
+	tags []string,
+) func() (*entity.Feed, bool, error) {
+	return func() (*entity.Feed, bool, error) {
+		rsp, err := r.client.AddFeed(ctx, &api.AddFeedRequest{Url: feedURL, Tags: tags})
+		if err != nil {
+			return nil, false, err
+		}
+		return entity.FromFeedPb(rsp.GetFeed()), rsp.GetIsAdded(), nil
+	}
+}
+
+// EditFeedsF returns a function that edits the title, description, tags, and starred status of
+// feeds. The tags of a feed are always replaced by those of its edit op, so ops that do not change
+// the tags must still set them.
+func (r *RPC) EditFeedsF(
+	ctx context.Context,
+	ops []*entity.FeedEditOp,
+) func() ([]*entity.Feed, error) {
+	return func() ([]*entity.Feed, error) {
+		req := api.EditFeedsRequest{Ops: make([]*api.EditFeedsRequest_Op, len(ops))}
+		for i, op := range ops {
+			var tags []string
+			if op.Tags != nil {
+				tags = *op.Tags
+			}
+			req.Ops[i] = &api.EditFeedsRequest_Op{
+				Id: op.ID,
+				Fields: &api.EditFeedsRequest_Op_Fields{
+					Title:       op.Title,
+					Description: op.Description,
+					Tags:        tags,
+					IsStarred:   op.IsStarred,
+				},
+			}
+		}
+		rsp, err := r.client.EditFeeds(ctx, &req)
+		if err != nil {
+			return nil, err
+		}
+		return entity.FromFeedPbs(rsp.GetFeeds()), nil
+	}
+}
+
+func (r *RPC) DeleteFeedsF(ctx context.Context, ids []entity.ID) func() error {
+	return func() error {
+		_, err := r.client.DeleteFeeds(ctx, &api.DeleteFeedsRequest{FeedIds: ids})
+		return err
+	}
+}
+
+func (r *RPC) EditEntriesF(
+	ctx context.Context,
+	ops []*entity.EntryEditOp,
+) func() ([]*entity.Entry, error) {
+	return func() ([]*entity.Entry, error) {
+		req := api.EditEntriesRequest{Ops: make([]*api.EditEntriesRequest_Op, len(ops))}
+		for i, op := range ops {
+			req.Ops[i] = &api.EditEntriesRequest_Op{
+				Id: op.ID,
+				Fields: &api.EditEntriesRequest_Op_Fields{
+					IsRead:       op.IsRead,
+					IsBookmarked: op.IsBookmarked,
+				},
+			}
+		}
+		rsp, err := r.client.EditEntries(ctx, &req)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]*entity.Entry, len(rsp.GetEntries()))
+		for i, pb := range rsp.GetEntries() {
+			entries[i] = entity.FromEntryPb(pb)
+		}
+		return entries, nil
+	}
+}
+
+func (r *RPC) ExtractEntryContentF(
+	ctx context.Context,
+	id entity.ID,
+) func() (*entity.Entry, error) {
+	return func() (*entity.Entry, error) {
+		rsp, err := r.client.ExtractEntryContent(ctx, &api.ExtractEntryContentRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return entity.FromEntryPb(rsp.GetEntry()), nil
+	}
+}
+
 func (r *RPC) String() string {
 	return fmt.Sprintf("grpc://%s", r.addr)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bow/neon/api"
@@ -55,6 +56,242 @@ func TestGetStatsFErr(t *testing.T) {
 	a.EqualError(err, "nope")
 }
 
+func TestAddFeedFOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		AddFeed(
+			gomock.Any(),
+			&api.AddFeedRequest{Url: "https://a.com/feed.xml", Tags: []string{"x"}},
+		).
+		Return(
+			&api.AddFeedResponse{
+				Feed: &api.Feed{
+					Id:           2,
+					Title:        "Feed A",
+					Tags:         []string{"x"},
+					SubTime:      timestamppb.New(time.Now()),
+					LastPullTime: timestamppb.New(time.Now()),
+				},
+				IsAdded: true,
+			},
+			nil,
+		)
+
+	feed, added, err := rpc.AddFeedF(
+		context.Background(),
+		"https://a.com/feed.xml",
+		[]string{"x"},
+	)()
+	r.NoError(err)
+	r.NotNil(feed)
+	a.True(added)
+	a.Equal(entity.ID(2), feed.ID)
+	a.Equal([]string{"x"}, feed.Tags)
+}
+
+func TestAddFeedFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		AddFeed(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	feed, added, err := rpc.AddFeedF(context.Background(), "https://a.com/feed.xml", nil)()
+	r.Nil(feed)
+	a.False(added)
+	a.EqualError(err, "nope")
+}
+
+func TestEditFeedsFOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	isStarred := true
+	tags := []string{"x", "y"}
+	client.EXPECT().
+		EditFeeds(
+			gomock.Any(),
+			&api.EditFeedsRequest{
+				Ops: []*api.EditFeedsRequest_Op{
+					{
+						Id: 2,
+						Fields: &api.EditFeedsRequest_Op_Fields{
+							Tags:      tags,
+							IsStarred: &isStarred,
+						},
+					},
+				},
+			},
+		).
+		Return(
+			&api.EditFeedsResponse{
+				Feeds: []*api.Feed{
+					{
+						Id:           2,
+						Title:        "Feed A",
+						IsStarred:    true,
+						Tags:         tags,
+						SubTime:      timestamppb.New(time.Now()),
+						LastPullTime: timestamppb.New(time.Now()),
+					},
+				},
+			},
+			nil,
+		)
+
+	ops := []*entity.FeedEditOp{{ID: 2, Tags: &tags, IsStarred: &isStarred}}
+	feeds, err := rpc.EditFeedsF(context.Background(), ops)()
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.Equal(entity.ID(2), feeds[0].ID)
+	a.True(feeds[0].IsStarred)
+	a.Equal(tags, feeds[0].Tags)
+}
+
+func TestEditFeedsFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		EditFeeds(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	feeds, err := rpc.EditFeedsF(context.Background(), nil)()
+	r.Nil(feeds)
+	a.EqualError(err, "nope")
+}
+
+func TestDeleteFeedsFOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		DeleteFeeds(gomock.Any(), &api.DeleteFeedsRequest{FeedIds: []entity.ID{2}}).
+		Return(&api.DeleteFeedsResponse{}, nil)
+
+	err := rpc.DeleteFeedsF(context.Background(), []entity.ID{2})()
+	a.NoError(err)
+}
+
+func TestDeleteFeedsFErr(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		DeleteFeeds(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	err := rpc.DeleteFeedsF(context.Background(), []entity.ID{2})()
+	a.EqualError(err, "nope")
+}
+
+func TestEditEntriesFOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	isRead := true
+	client.EXPECT().
+		EditEntries(
+			gomock.Any(),
+			&api.EditEntriesRequest{
+				Ops: []*api.EditEntriesRequest_Op{
+					{Id: 3, Fields: &api.EditEntriesRequest_Op_Fields{IsRead: &isRead}},
+				},
+			},
+		).
+		Return(
+			&api.EditEntriesResponse{
+				Entries: []*api.Entry{{Id: 3, FeedId: 1, Title: "Entry A", IsRead: true}},
+			},
+			nil,
+		)
+
+	ops := []*entity.EntryEditOp{{ID: 3, IsRead: &isRead}}
+	entries, err := rpc.EditEntriesF(context.Background(), ops)()
+	r.NoError(err)
+	r.Len(entries, 1)
+	a.Equal(entity.ID(3), entries[0].ID)
+	a.True(entries[0].IsRead)
+}
+
+func TestEditEntriesFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		EditEntries(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	entries, err := rpc.EditEntriesF(context.Background(), nil)()
+	r.Nil(entries)
+	a.EqualError(err, "nope")
+}
+
+func TestExtractEntryContentFOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	content := "<div>Full</div>"
+	client.EXPECT().
+		ExtractEntryContent(gomock.Any(), &api.ExtractEntryContentRequest{Id: 3}).
+		Return(
+			&api.ExtractEntryContentResponse{
+				Entry: &api.Entry{Id: 3, FeedId: 1, Title: "Entry A", Content: &content},
+			},
+			nil,
+		)
+
+	entry, err := rpc.ExtractEntryContentF(context.Background(), 3)()
+	r.NoError(err)
+	r.NotNil(entry)
+	a.Equal(entity.ID(3), entry.ID)
+	a.Equal(&content, entry.Content)
+}
+
+func TestExtractEntryContentFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		ExtractEntryContent(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	entry, err := rpc.ExtractEntryContentF(context.Background(), 3)()
+	r.Nil(entry)
+	a.EqualError(err, "nope")
+}
+
 func TestGetAllFeedsFOk(t *testing.T) {
 	t.Parallel()
 
@@ -347,6 +584,77 @@ func TestPullFeedsFExtended(t *testing.T) {
 	a.Nil(pr1.Error())
 }
 
+func TestPullFeedsFStatus(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+	streamClient := NewMockServerStreamingClient[api.PullFeedsResponse](gomock.NewController(t))
+
+	client.EXPECT().
+		PullFeeds(gomock.Any(), gomock.Any()).
+		Return(streamClient, nil)
+	streamClient.EXPECT().
+		Recv().
+		Return(
+			&api.PullFeedsResponse{
+				Url:       "https://same.com/feed.xml",
+				Status:    api.PullFeedsResponse_NOT_MODIFIED,
+				Throttled: durationpb.New(3 * time.Second),
+			},
+			nil,
+		)
+	streamClient.EXPECT().
+		Recv().
+		Return(
+			&api.PullFeedsResponse{
+				Url:    "https://paused.com/feed.xml",
+				Status: api.PullFeedsResponse_SKIPPED,
+			},
+			nil,
+		)
+	// Responses without a status are failed if they have an error.
+	streamClient.EXPECT().
+		Recv().
+		Return(
+			&api.PullFeedsResponse{
+				Url:   "https://down.com/feed.xml",
+				Error: pointer("timeout"),
+			},
+			nil,
+		)
+	streamClient.EXPECT().
+		Recv().
+		Return(nil, io.EOF)
+
+	ch, err := rpc.PullFeedsF(context.Background(), nil)()
+	r.NoError(err)
+
+	prs := make([]entity.PullResult, 0)
+	for pr := range ch {
+		prs = append(prs, pr)
+	}
+	r.Len(prs, 3)
+
+	pr0 := prs[0] // #nosec: G602
+	a.Equal("https://same.com/feed.xml", pr0.URL())
+	a.Equal(entity.PullNotModified, pr0.Status())
+	a.Equal(3*time.Second, pr0.Throttled())
+	a.Nil(pr0.Feed())
+	a.Nil(pr0.Error())
+
+	pr1 := prs[1] // #nosec: G602
+	a.Equal("https://paused.com/feed.xml", pr1.URL())
+	a.Equal(entity.PullSkipped, pr1.Status())
+	a.Nil(pr1.Error())
+
+	pr2 := prs[2] // #nosec: G602
+	a.Equal("https://down.com/feed.xml", pr2.URL())
+	a.Equal(entity.PullFail, pr2.Status())
+	a.EqualError(pr2.Error(), "timeout")
+}
+
 func TestPullFeedsFErr(t *testing.T) {
 	t.Parallel()
 
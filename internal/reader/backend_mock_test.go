@@ -41,6 +41,76 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 	return m.recorder
 }
 
+// AddFeedF mocks base method.
+func (m *MockBackend) AddFeedF(arg0 context.Context, arg1 string, arg2 []string) func() (*entity.Feed, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFeedF", arg0, arg1, arg2)
+	ret0, _ := ret[0].(func() (*entity.Feed, bool, error))
+	return ret0
+}
+
+// AddFeedF indicates an expected call of AddFeedF.
+func (mr *MockBackendMockRecorder) AddFeedF(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFeedF", reflect.TypeOf((*MockBackend)(nil).AddFeedF), arg0, arg1, arg2)
+}
+
+// DeleteFeedsF mocks base method.
+func (m *MockBackend) DeleteFeedsF(arg0 context.Context, arg1 []entity.ID) func() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeedsF", arg0, arg1)
+	ret0, _ := ret[0].(func() error)
+	return ret0
+}
+
+// DeleteFeedsF indicates an expected call of DeleteFeedsF.
+func (mr *MockBackendMockRecorder) DeleteFeedsF(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeedsF", reflect.TypeOf((*MockBackend)(nil).DeleteFeedsF), arg0, arg1)
+}
+
+// EditEntriesF mocks base method.
+func (m *MockBackend) EditEntriesF(arg0 context.Context, arg1 []*entity.EntryEditOp) func() ([]*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditEntriesF", arg0, arg1)
+	ret0, _ := ret[0].(func() ([]*entity.Entry, error))
+	return ret0
+}
+
+// EditEntriesF indicates an expected call of EditEntriesF.
+func (mr *MockBackendMockRecorder) EditEntriesF(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditEntriesF", reflect.TypeOf((*MockBackend)(nil).EditEntriesF), arg0, arg1)
+}
+
+// EditFeedsF mocks base method.
+func (m *MockBackend) EditFeedsF(arg0 context.Context, arg1 []*entity.FeedEditOp) func() ([]*entity.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditFeedsF", arg0, arg1)
+	ret0, _ := ret[0].(func() ([]*entity.Feed, error))
+	return ret0
+}
+
+// EditFeedsF indicates an expected call of EditFeedsF.
+func (mr *MockBackendMockRecorder) EditFeedsF(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFeedsF", reflect.TypeOf((*MockBackend)(nil).EditFeedsF), arg0, arg1)
+}
+
+// ExtractEntryContentF mocks base method.
+func (m *MockBackend) ExtractEntryContentF(arg0 context.Context, arg1 entity.ID) func() (*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractEntryContentF", arg0, arg1)
+	ret0, _ := ret[0].(func() (*entity.Entry, error))
+	return ret0
+}
+
+// ExtractEntryContentF indicates an expected call of ExtractEntryContentF.
+func (mr *MockBackendMockRecorder) ExtractEntryContentF(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractEntryContentF", reflect.TypeOf((*MockBackend)(nil).ExtractEntryContentF), arg0, arg1)
+}
+
 // GetAllFeedsF mocks base method.
 func (m *MockBackend) GetAllFeedsF(arg0 context.Context) func() ([]*entity.Feed, error) {
 	m.ctrl.T.Helper()
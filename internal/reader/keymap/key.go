@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package keymap
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Key is a key press that can be bound to an action.
+type Key struct {
+	key  tcell.Key
+	ch   rune
+	mods tcell.ModMask
+}
+
+// keyNames maps the lowercased names of special keys to the keys, e.g. 'enter', 'tab', 'f1', or
+// 'ctrl-r'.
+var keyNames = func() map[string]tcell.Key {
+	m := make(map[string]tcell.Key, len(tcell.KeyNames)+1)
+	for key, name := range tcell.KeyNames {
+		m[strings.ToLower(name)] = key
+	}
+	m["escape"] = tcell.KeyEscape
+	return m
+}()
+
+var modNames = []struct {
+	prefix string
+	mod    tcell.ModMask
+}{
+	{"alt-", tcell.ModAlt},
+	{"ctrl-", tcell.ModCtrl},
+	{"shift-", tcell.ModShift},
+}
+
+// ParseKey parses the given key. A key is either a single character, such as 'P' or '?', 'space',
+// or the name of a special key, such as 'enter', 'esc', 'tab', 'pgdn', 'f1', or 'ctrl-r'. Names
+// are case-insensitive, and may be prefixed with 'alt-', 'ctrl-', or 'shift-' modifiers, as in
+// 'alt-tab'.
+func ParseKey(text string) (Key, error) {
+	if utf8.RuneCountInString(text) == 1 {
+		ch, _ := utf8.DecodeRuneInString(text)
+		return newKey(tcell.NewEventKey(tcell.KeyRune, ch, tcell.ModNone)), nil
+	}
+
+	lower := strings.ToLower(text)
+	if lower == "space" {
+		return Key{key: tcell.KeyRune, ch: ' '}, nil
+	}
+	if key, exists := keyNames[lower]; exists {
+		return newKey(tcell.NewEventKey(key, 0, tcell.ModNone)), nil
+	}
+	for _, item := range modNames {
+		if !strings.HasPrefix(lower, item.prefix) || len(text) == len(item.prefix) {
+			continue
+		}
+		k, err := ParseKey(text[len(item.prefix):])
+		if err != nil {
+			return Key{}, err
+		}
+		if k.mods&item.mod == 0 {
+			k.mods |= item.mod
+			// Modifiers implied by the key, such as shift for characters, have no effect.
+			if newKey(tcell.NewEventKey(k.key, k.ch, k.mods)) == k {
+				return k, nil
+			}
+		}
+		break
+	}
+
+	return Key{}, fmt.Errorf("invalid key %q", text)
+}
+
+// mustParseKey parses the given key, panicking if it is invalid.
+func mustParseKey(text string) Key {
+	k, err := ParseKey(text)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// newKey returns the key pressed in the given event. Modifiers implied by the key are dropped, so
+// that the same key press is always represented in the same way: shift for characters, and ctrl
+// for control keys such as 'ctrl-r'.
+func newKey(event *tcell.EventKey) Key {
+	var (
+		key  = event.Key()
+		mods = event.Modifiers()
+	)
+	switch {
+	case key == tcell.KeyRune:
+		return Key{key: key, ch: event.Rune(), mods: mods &^ tcell.ModShift}
+	case key < tcell.Key(' ') || key == tcell.KeyDEL:
+		return Key{key: key, mods: mods &^ tcell.ModCtrl}
+	default:
+		return Key{key: key, mods: mods}
+	}
+}
+
+func (k Key) String() string {
+	var sb strings.Builder
+	if k.mods&tcell.ModAlt != 0 {
+		sb.WriteString("Alt-")
+	}
+	if k.mods&tcell.ModCtrl != 0 {
+		sb.WriteString("Ctrl-")
+	}
+	if k.mods&tcell.ModShift != 0 {
+		sb.WriteString("Shift-")
+	}
+	switch {
+	case k.key == tcell.KeyRune && k.ch == ' ':
+		sb.WriteString("Space")
+	case k.key == tcell.KeyRune:
+		sb.WriteRune(k.ch)
+	default:
+		if name, exists := tcell.KeyNames[k.key]; exists {
+			sb.WriteString(name)
+		} else {
+			fmt.Fprintf(&sb, "Key(%d)", k.key)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package keymap maps the keys of the reader to the named actions they trigger.
+package keymap
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Scope is the part of the reader in which the keys of an action apply.
+type Scope uint8
+
+const (
+	// Global keys apply everywhere, except in forms and the search prompt. They take precedence
+	// over the keys of panes.
+	Global Scope = iota
+	// FeedsPane keys apply when the feeds pane has the focus.
+	FeedsPane
+	// EntriesPane keys apply when the entries pane has the focus.
+	EntriesPane
+	// ReadingPane keys apply when the reading pane has the focus.
+	ReadingPane
+)
+
+// Scopes are all scopes, in the order their actions are listed.
+var Scopes = []Scope{FeedsPane, EntriesPane, ReadingPane, Global}
+
+var scopeNames = []string{"global", "feeds", "entries", "reading"}
+
+func (s Scope) String() string {
+	if int(s) < len(scopeNames) {
+		return scopeNames[s]
+	}
+	return fmt.Sprintf("Scope(%d)", s)
+}
+
+// Action is the name of something the reader does when its keys are pressed.
+type Action string
+
+// Actions of the reader, grouped by the scope they apply in. Their descriptions and default keys
+// are listed in the registry.
+const (
+	NextFeed            Action = "next-feed"
+	PreviousFeed        Action = "previous-feed"
+	PullCurrent         Action = "pull-current"
+	PullAll             Action = "pull-all"
+	MarkFeedRead        Action = "mark-feed-read"
+	ToggleStar          Action = "toggle-star"
+	AddFeed             Action = "add-feed"
+	EditFeed            Action = "edit-feed"
+	DeleteFeed          Action = "delete-feed"
+	ToggleStarredFilter Action = "toggle-starred-filter"
+	SwitchGrouping      Action = "switch-grouping"
+	NextMatch           Action = "next-match"
+	PreviousMatch       Action = "previous-match"
+	ToggleFold          Action = "toggle-fold"
+	ToggleAllFolds      Action = "toggle-all-folds"
+
+	NextEntry              Action = "next-entry"
+	PreviousEntry          Action = "previous-entry"
+	OpenEntry              Action = "open-entry"
+	MarkEntryRead          Action = "mark-entry-read"
+	MarkEntryUnread        Action = "mark-entry-unread"
+	ToggleBookmark         Action = "toggle-bookmark"
+	ToggleUnreadFilter     Action = "toggle-unread-filter"
+	ToggleBookmarkedFilter Action = "toggle-bookmarked-filter"
+
+	ScrollDown     Action = "scroll-down"
+	ScrollUp       Action = "scroll-up"
+	ScrollToTop    Action = "scroll-to-top"
+	ScrollToBottom Action = "scroll-to-bottom"
+	ExtractContent Action = "extract-content"
+
+	FocusFeeds        Action = "focus-feeds"
+	FocusEntries      Action = "focus-entries"
+	FocusReading      Action = "focus-reading"
+	FocusNextPane     Action = "focus-next-pane"
+	FocusPreviousPane Action = "focus-previous-pane"
+	ToggleStatusBar   Action = "toggle-status-bar"
+	ClearStatusBar    Action = "clear-status-bar"
+	Search            Action = "search"
+	Unfocus           Action = "unfocus"
+	ToggleStats       Action = "toggle-stats"
+	ToggleAbout       Action = "toggle-about"
+	ToggleHelp        Action = "toggle-help"
+	Quit              Action = "quit"
+)
+
+type actionSpec struct {
+	action Action
+	scopes []Scope
+	desc   string
+	keys   []string
+}
+
+var (
+	feeds   = []Scope{FeedsPane}
+	entries = []Scope{EntriesPane}
+	reading = []Scope{ReadingPane}
+	global  = []Scope{Global}
+	lists   = []Scope{FeedsPane, EntriesPane}
+)
+
+// registry lists all actions with their default keys, in the order they are listed in each scope.
+var registry = []actionSpec{
+	{NextFeed, feeds, "Next item", []string{"j"}},
+	{PreviousFeed, feeds, "Previous item", []string{"k"}},
+	{PullCurrent, feeds, "Pull current feed", []string{"p"}},
+	{PullAll, feeds, "Pull all feeds", []string{"P"}},
+	{MarkFeedRead, feeds, "Mark all entries in current feed / group read", []string{"r"}},
+	{ToggleStar, feeds, "Star / unstar feed", []string{"s"}},
+	{AddFeed, feeds, "Add feed", []string{"a"}},
+	{EditFeed, feeds, "Edit feed", []string{"e"}},
+	{DeleteFeed, feeds, "Delete feed", []string{"d"}},
+	{ToggleStarredFilter, feeds, "Show starred feeds only / all feeds", []string{"*"}},
+	{
+		SwitchGrouping, feeds,
+		"Group feeds by update period / tag / starred status / title", []string{"v"},
+	},
+	{ToggleFold, feeds, "Expand / collapse current group", []string{"z"}},
+	{ToggleAllFolds, feeds, "Expand / collapse all groups", []string{"Z"}},
+
+	{NextEntry, entries, "Next entry", []string{"j"}},
+	{PreviousEntry, entries, "Previous entry", []string{"k"}},
+	{OpenEntry, entries, "Open current entry and mark it read", []string{"enter"}},
+	{MarkEntryRead, entries, "Mark current entry read", []string{"r"}},
+	{MarkEntryUnread, entries, "Mark current entry unread", []string{"u"}},
+	{ToggleBookmark, entries, "Add / remove current entry from bookmarks", []string{"s"}},
+	{ToggleUnreadFilter, entries, "Show unread entries only / all entries", []string{"U"}},
+	{
+		ToggleBookmarkedFilter, entries,
+		"Show bookmarked entries only / all entries", []string{"B"},
+	},
+	{NextMatch, lists, "Next search match", []string{"n"}},
+	{PreviousMatch, lists, "Previous search match", []string{"N"}},
+
+	{ScrollDown, reading, "Scroll down", []string{"j"}},
+	{ScrollUp, reading, "Scroll up", []string{"k"}},
+	{ScrollToTop, reading, "Go to top", []string{"g"}},
+	{ScrollToBottom, reading, "Go to bottom", []string{"G"}},
+	{ExtractContent, reading, "Extract full content of entry", []string{"x"}},
+
+	{FocusFeeds, global, "Set focus to feeds pane", []string{"F"}},
+	{FocusEntries, global, "Set focus to entries pane", []string{"E"}},
+	{FocusReading, global, "Set focus to reading pane", []string{"R"}},
+	{FocusNextPane, global, "Switch to next pane", []string{"tab"}},
+	{FocusPreviousPane, global, "Switch to previous pane", []string{"alt-tab"}},
+	{ToggleStatusBar, global, "Toggle status bar", []string{"b"}},
+	{ClearStatusBar, global, "Clear status bar", []string{"c"}},
+	{Search, global, "Search feeds or entries in focused pane", []string{"/"}},
+	{Unfocus, global, "Unset current focus or close open frame", []string{"esc"}},
+	{ToggleStats, global, "Toggle stats popup and show latest values", []string{"S"}},
+	{ToggleAbout, global, "Toggle 'about' popup", []string{"A"}},
+	{ToggleHelp, global, "Toggle this help", []string{"?", "H"}},
+	{Quit, global, "Quit reader", []string{"q", "ctrl-c"}},
+}
+
+// Binding is an action together with the keys bound to it.
+type Binding struct {
+	Action      Action
+	Description string
+	Keys        []Key
+}
+
+// Keymap maps keys to the actions they trigger in each scope.
+type Keymap struct {
+	keys    map[Action][]Key
+	actions map[Scope]map[Key]Action
+}
+
+// Default returns the keymap with the default keys of all actions.
+func Default() *Keymap {
+	keys := make(map[Action][]Key, len(registry))
+	for _, spec := range registry {
+		keys[spec.action] = mustParseKeys(spec.keys)
+	}
+	km, err := newKeymap(keys)
+	if err != nil {
+		panic(err)
+	}
+	return km
+}
+
+// Override returns a copy of the keymap, with the actions of the given names bound to the given
+// keys instead. An action is unbound if it is given no keys. An error is returned if an action
+// does not exist, if a key is invalid, or if a key is bound to more than one action in the same
+// scope. As global keys take precedence over those of panes, they must not be bound in any pane.
+func (km *Keymap) Override(bindings map[string][]string) (*Keymap, error) {
+	keys := make(map[Action][]Key, len(registry))
+	for _, action := range slices.Sorted(maps.Keys(bindings)) {
+		texts := bindings[action]
+		if !isAction(Action(action)) {
+			return nil, fmt.Errorf("action %q does not exist", action)
+		}
+		parsed := make([]Key, 0, len(texts))
+		for _, text := range texts {
+			k, err := ParseKey(text)
+			if err != nil {
+				return nil, fmt.Errorf("action %q: %w", action, err)
+			}
+			if !slices.Contains(parsed, k) {
+				parsed = append(parsed, k)
+			}
+		}
+		keys[Action(action)] = parsed
+	}
+	for _, spec := range registry {
+		if _, exists := keys[spec.action]; !exists {
+			keys[spec.action] = km.keys[spec.action]
+		}
+	}
+	return newKeymap(keys)
+}
+
+// Action returns the action bound to the key of the given event in the given scope, if any.
+func (km *Keymap) Action(scope Scope, event *tcell.EventKey) (Action, bool) {
+	action, exists := km.actions[scope][newKey(event)]
+	return action, exists
+}
+
+// Keys returns the keys bound to the given action.
+func (km *Keymap) Keys(action Action) []Key {
+	return km.keys[action]
+}
+
+// Bindings returns the actions of the given scope that are bound to keys, in the order they are
+// listed.
+func (km *Keymap) Bindings(scope Scope) []Binding {
+	bindings := make([]Binding, 0)
+	for _, spec := range registry {
+		if !slices.Contains(spec.scopes, scope) || len(km.keys[spec.action]) == 0 {
+			continue
+		}
+		bindings = append(
+			bindings,
+			Binding{Action: spec.action, Description: spec.desc, Keys: km.keys[spec.action]},
+		)
+	}
+	return bindings
+}
+
+func newKeymap(keys map[Action][]Key) (*Keymap, error) {
+	km := Keymap{
+		keys:    keys,
+		actions: make(map[Scope]map[Key]Action, len(Scopes)),
+	}
+	for _, scope := range Scopes {
+		km.actions[scope] = make(map[Key]Action)
+	}
+
+	// Global keys are registered first, so that conflicts with them are checked in panes.
+	for _, scope := range []Scope{Global, FeedsPane, EntriesPane, ReadingPane} {
+		for _, spec := range registry {
+			if !slices.Contains(spec.scopes, scope) {
+				continue
+			}
+			for _, k := range keys[spec.action] {
+				other, exists := km.actions[scope][k]
+				if !exists {
+					other, exists = km.actions[Global][k]
+				}
+				if exists {
+					return nil, fmt.Errorf("key %q is bound to both %q and %q", k, other, spec.action)
+				}
+				km.actions[scope][k] = spec.action
+			}
+		}
+	}
+
+	return &km, nil
+}
+
+func isAction(action Action) bool {
+	return slices.ContainsFunc(registry, func(spec actionSpec) bool { return spec.action == action })
+}
+
+func mustParseKeys(texts []string) []Key {
+	keys := make([]Key, len(texts))
+	for i, text := range texts {
+		keys[i] = mustParseKey(text)
+	}
+	return keys
+}
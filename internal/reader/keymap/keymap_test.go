@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package keymap
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text  string
+		event *tcell.EventKey
+		str   string
+	}{
+		{"P", tcell.NewEventKey(tcell.KeyRune, 'P', tcell.ModShift), "P"},
+		{"?", tcell.NewEventKey(tcell.KeyRune, '?', tcell.ModNone), "?"},
+		{"space", tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModNone), "Space"},
+		{"Enter", tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), "Enter"},
+		{"escape", tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone), "Esc"},
+		{"pgdn", tcell.NewEventKey(tcell.KeyPgDn, 0, tcell.ModNone), "PgDn"},
+		{"ctrl-r", tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModCtrl), "Ctrl-R"},
+		{"ctrl-r", tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModNone), "Ctrl-R"},
+		{"alt-tab", tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModAlt), "Alt-Tab"},
+		{"alt-ctrl-n", tcell.NewEventKey(tcell.KeyCtrlN, 0, tcell.ModAlt), "Alt-Ctrl-N"},
+		{"Alt-x", tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModAlt), "Alt-x"},
+		{"shift-up", tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModShift), "Shift-Up"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			t.Parallel()
+
+			k, err := ParseKey(test.text)
+			require.NoError(t, err)
+			assert.Equal(t, newKey(test.event), k)
+			assert.Equal(t, test.str, k.String())
+		})
+	}
+}
+
+func TestParseKeyErr(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{"", "foo", "ctrl-", "alt-alt-x", "shift-a", "ctrl-tab"} {
+		t.Run(text, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseKey(text)
+			assert.ErrorContains(t, err, "invalid key")
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	km := Default()
+
+	tests := []struct {
+		scope  Scope
+		event  *tcell.EventKey
+		action Action
+	}{
+		{FeedsPane, tcell.NewEventKey(tcell.KeyRune, 'P', tcell.ModNone), PullAll},
+		{FeedsPane, tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone), NextMatch},
+		{EntriesPane, tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone), NextMatch},
+		{EntriesPane, tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), OpenEntry},
+		{ReadingPane, tcell.NewEventKey(tcell.KeyRune, 'G', tcell.ModNone), ScrollToBottom},
+		{Global, tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModAlt), FocusPreviousPane},
+		{Global, tcell.NewEventKey(tcell.KeyCtrlC, 0, tcell.ModCtrl), Quit},
+	}
+	for _, test := range tests {
+		action, exists := km.Action(test.scope, test.event)
+		a.True(exists)
+		a.Equal(test.action, action)
+	}
+
+	_, exists := km.Action(ReadingPane, tcell.NewEventKey(tcell.KeyRune, 'P', tcell.ModNone))
+	a.False(exists)
+
+	// Every action is listed in the scopes it applies to.
+	bindings := km.Bindings(EntriesPane)
+	a.Equal(NextEntry, bindings[0].Action)
+	a.Equal("Next entry", bindings[0].Description)
+	a.Equal(PreviousMatch, bindings[len(bindings)-1].Action)
+	total := 0
+	for _, scope := range Scopes {
+		total += len(km.Bindings(scope))
+	}
+	a.Equal(len(registry)+2, total)
+}
+
+func TestOverride(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	km, err := Default().Override(map[string][]string{
+		"pull-all":       {"ctrl-r", "ctrl-r"},
+		"pull-current":   {"r"},
+		"mark-feed-read": {"R"},
+		"focus-reading":  {},
+	})
+	r.NoError(err)
+
+	a.Equal([]Key{mustParseKey("ctrl-r")}, km.Keys(PullAll))
+	a.Equal([]Key{mustParseKey("r")}, km.Keys(PullCurrent))
+
+	action, exists := km.Action(FeedsPane, tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModCtrl))
+	a.True(exists)
+	a.Equal(PullAll, action)
+
+	_, exists = km.Action(FeedsPane, tcell.NewEventKey(tcell.KeyRune, 'P', tcell.ModNone))
+	a.False(exists)
+	_, exists = km.Action(Global, tcell.NewEventKey(tcell.KeyRune, 'R', tcell.ModNone))
+	a.False(exists)
+
+	// Unbound actions are not listed.
+	for _, binding := range km.Bindings(Global) {
+		a.NotEqual(FocusReading, binding.Action)
+	}
+
+	// Overrides are applied on top of the given keymap.
+	km2, err := km.Override(map[string][]string{"pull-current": {"p"}})
+	r.NoError(err)
+	a.Equal([]Key{mustParseKey("ctrl-r")}, km2.Keys(PullAll))
+	a.Equal([]Key{mustParseKey("p")}, km2.Keys(PullCurrent))
+}
+
+func TestOverrideErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		bindings map[string][]string
+		errMsg   string
+	}{
+		{
+			name:     "unknown action",
+			bindings: map[string][]string{"pull-everything": {"P"}},
+			errMsg:   `action "pull-everything" does not exist`,
+		},
+		{
+			name:     "invalid key",
+			bindings: map[string][]string{"pull-all": {"hyper-p"}},
+			errMsg:   `action "pull-all": invalid key "hyper-p"`,
+		},
+		{
+			name:     "conflict in pane",
+			bindings: map[string][]string{"pull-all": {"p"}},
+			errMsg:   `key "p" is bound to both "pull-current" and "pull-all"`,
+		},
+		{
+			name:     "conflict with global",
+			bindings: map[string][]string{"extract-content": {"q"}},
+			errMsg:   `key "q" is bound to both "quit" and "extract-content"`,
+		},
+		{
+			name:     "conflict in shared action",
+			bindings: map[string][]string{"next-match": {"u"}},
+			errMsg:   `key "u" is bound to both "mark-entry-unread" and "next-match"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			km, err := Default().Override(test.bindings)
+			assert.Nil(t, km)
+			assert.EqualError(t, err, test.errMsg)
+		})
+	}
+}
@@ -41,6 +41,18 @@ func (m *MockOperator) EXPECT() *MockOperatorMockRecorder {
 	return m.recorder
 }
 
+// AddFeed mocks base method.
+func (m *MockOperator) AddFeed(arg0 *ui.Display, arg1 func() (*entity.Feed, bool, error), arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddFeed", arg0, arg1, arg2)
+}
+
+// AddFeed indicates an expected call of AddFeed.
+func (mr *MockOperatorMockRecorder) AddFeed(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFeed", reflect.TypeOf((*MockOperator)(nil).AddFeed), arg0, arg1, arg2)
+}
+
 // ClearStatusBar mocks base method.
 func (m *MockOperator) ClearStatusBar(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
@@ -53,6 +65,54 @@ func (mr *MockOperatorMockRecorder) ClearStatusBar(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearStatusBar", reflect.TypeOf((*MockOperator)(nil).ClearStatusBar), arg0)
 }
 
+// DeleteFeed mocks base method.
+func (m *MockOperator) DeleteFeed(arg0 *ui.Display, arg1 func() error, arg2 *entity.Feed) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteFeed", arg0, arg1, arg2)
+}
+
+// DeleteFeed indicates an expected call of DeleteFeed.
+func (mr *MockOperatorMockRecorder) DeleteFeed(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeed", reflect.TypeOf((*MockOperator)(nil).DeleteFeed), arg0, arg1, arg2)
+}
+
+// EditEntries mocks base method.
+func (m *MockOperator) EditEntries(arg0 *ui.Display, arg1 func() ([]*entity.Entry, error)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EditEntries", arg0, arg1)
+}
+
+// EditEntries indicates an expected call of EditEntries.
+func (mr *MockOperatorMockRecorder) EditEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditEntries", reflect.TypeOf((*MockOperator)(nil).EditEntries), arg0, arg1)
+}
+
+// EditFeeds mocks base method.
+func (m *MockOperator) EditFeeds(arg0 *ui.Display, arg1 func() ([]*entity.Feed, error)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EditFeeds", arg0, arg1)
+}
+
+// EditFeeds indicates an expected call of EditFeeds.
+func (mr *MockOperatorMockRecorder) EditFeeds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFeeds", reflect.TypeOf((*MockOperator)(nil).EditFeeds), arg0, arg1)
+}
+
+// ExtractEntryContent mocks base method.
+func (m *MockOperator) ExtractEntryContent(arg0 *ui.Display, arg1 func() (*entity.Entry, error), arg2 *entity.Entry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExtractEntryContent", arg0, arg1, arg2)
+}
+
+// ExtractEntryContent indicates an expected call of ExtractEntryContent.
+func (mr *MockOperatorMockRecorder) ExtractEntryContent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractEntryContent", reflect.TypeOf((*MockOperator)(nil).ExtractEntryContent), arg0, arg1, arg2)
+}
+
 // FocusEntriesPane mocks base method.
 func (m *MockOperator) FocusEntriesPane(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
@@ -113,6 +173,20 @@ func (mr *MockOperatorMockRecorder) FocusReadingPane(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FocusReadingPane", reflect.TypeOf((*MockOperator)(nil).FocusReadingPane), arg0)
 }
 
+// GetCurrentEntry mocks base method.
+func (m *MockOperator) GetCurrentEntry(arg0 *ui.Display) *entity.Entry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentEntry", arg0)
+	ret0, _ := ret[0].(*entity.Entry)
+	return ret0
+}
+
+// GetCurrentEntry indicates an expected call of GetCurrentEntry.
+func (mr *MockOperatorMockRecorder) GetCurrentEntry(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentEntry", reflect.TypeOf((*MockOperator)(nil).GetCurrentEntry), arg0)
+}
+
 // GetCurrentFeed mocks base method.
 func (m *MockOperator) GetCurrentFeed(arg0 *ui.Display) *entity.Feed {
 	m.ctrl.T.Helper()
@@ -127,6 +201,48 @@ func (mr *MockOperatorMockRecorder) GetCurrentFeed(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentFeed", reflect.TypeOf((*MockOperator)(nil).GetCurrentFeed), arg0)
 }
 
+// GetCurrentUnreadEntries mocks base method.
+func (m *MockOperator) GetCurrentUnreadEntries(arg0 *ui.Display) []*entity.Entry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUnreadEntries", arg0)
+	ret0, _ := ret[0].([]*entity.Entry)
+	return ret0
+}
+
+// GetCurrentUnreadEntries indicates an expected call of GetCurrentUnreadEntries.
+func (mr *MockOperatorMockRecorder) GetCurrentUnreadEntries(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUnreadEntries", reflect.TypeOf((*MockOperator)(nil).GetCurrentUnreadEntries), arg0)
+}
+
+// GetFeedsGrouping mocks base method.
+func (m *MockOperator) GetFeedsGrouping(arg0 *ui.Display) ui.FeedsGrouping {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedsGrouping", arg0)
+	ret0, _ := ret[0].(ui.FeedsGrouping)
+	return ret0
+}
+
+// GetFeedsGrouping indicates an expected call of GetFeedsGrouping.
+func (mr *MockOperatorMockRecorder) GetFeedsGrouping(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedsGrouping", reflect.TypeOf((*MockOperator)(nil).GetFeedsGrouping), arg0)
+}
+
+// GetSelectedEntry mocks base method.
+func (m *MockOperator) GetSelectedEntry(arg0 *ui.Display) *entity.Entry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelectedEntry", arg0)
+	ret0, _ := ret[0].(*entity.Entry)
+	return ret0
+}
+
+// GetSelectedEntry indicates an expected call of GetSelectedEntry.
+func (mr *MockOperatorMockRecorder) GetSelectedEntry(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectedEntry", reflect.TypeOf((*MockOperator)(nil).GetSelectedEntry), arg0)
+}
+
 // PopulateFeedsPane mocks base method.
 func (m *MockOperator) PopulateFeedsPane(arg0 *ui.Display, arg1 func() ([]*entity.Feed, error)) {
 	m.ctrl.T.Helper()
@@ -163,6 +279,78 @@ func (mr *MockOperatorMockRecorder) RefreshStats(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshStats", reflect.TypeOf((*MockOperator)(nil).RefreshStats), arg0, arg1)
 }
 
+// SelectNextMatch mocks base method.
+func (m *MockOperator) SelectNextMatch(arg0 *ui.Display) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SelectNextMatch", arg0)
+}
+
+// SelectNextMatch indicates an expected call of SelectNextMatch.
+func (mr *MockOperatorMockRecorder) SelectNextMatch(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectNextMatch", reflect.TypeOf((*MockOperator)(nil).SelectNextMatch), arg0)
+}
+
+// SelectPreviousMatch mocks base method.
+func (m *MockOperator) SelectPreviousMatch(arg0 *ui.Display) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SelectPreviousMatch", arg0)
+}
+
+// SelectPreviousMatch indicates an expected call of SelectPreviousMatch.
+func (mr *MockOperatorMockRecorder) SelectPreviousMatch(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectPreviousMatch", reflect.TypeOf((*MockOperator)(nil).SelectPreviousMatch), arg0)
+}
+
+// SetFeedsGrouping mocks base method.
+func (m *MockOperator) SetFeedsGrouping(arg0 *ui.Display, arg1 ui.FeedsGrouping) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeedsGrouping", arg0, arg1)
+}
+
+// SetFeedsGrouping indicates an expected call of SetFeedsGrouping.
+func (mr *MockOperatorMockRecorder) SetFeedsGrouping(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeedsGrouping", reflect.TypeOf((*MockOperator)(nil).SetFeedsGrouping), arg0, arg1)
+}
+
+// ShowAddFeedPopup mocks base method.
+func (m *MockOperator) ShowAddFeedPopup(arg0 *ui.Display, arg1 func(string, []string)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowAddFeedPopup", arg0, arg1)
+}
+
+// ShowAddFeedPopup indicates an expected call of ShowAddFeedPopup.
+func (mr *MockOperatorMockRecorder) ShowAddFeedPopup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowAddFeedPopup", reflect.TypeOf((*MockOperator)(nil).ShowAddFeedPopup), arg0, arg1)
+}
+
+// ShowDeleteFeedPopup mocks base method.
+func (m *MockOperator) ShowDeleteFeedPopup(arg0 *ui.Display, arg1 *entity.Feed, arg2 func()) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowDeleteFeedPopup", arg0, arg1, arg2)
+}
+
+// ShowDeleteFeedPopup indicates an expected call of ShowDeleteFeedPopup.
+func (mr *MockOperatorMockRecorder) ShowDeleteFeedPopup(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDeleteFeedPopup", reflect.TypeOf((*MockOperator)(nil).ShowDeleteFeedPopup), arg0, arg1, arg2)
+}
+
+// ShowEditFeedPopup mocks base method.
+func (m *MockOperator) ShowEditFeedPopup(arg0 *ui.Display, arg1 *entity.Feed, arg2 func(*entity.FeedEditOp)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowEditFeedPopup", arg0, arg1, arg2)
+}
+
+// ShowEditFeedPopup indicates an expected call of ShowEditFeedPopup.
+func (mr *MockOperatorMockRecorder) ShowEditFeedPopup(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowEditFeedPopup", reflect.TypeOf((*MockOperator)(nil).ShowEditFeedPopup), arg0, arg1, arg2)
+}
+
 // ShowIntroPopup mocks base method.
 func (m *MockOperator) ShowIntroPopup(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
@@ -175,6 +363,30 @@ func (mr *MockOperatorMockRecorder) ShowIntroPopup(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIntroPopup", reflect.TypeOf((*MockOperator)(nil).ShowIntroPopup), arg0)
 }
 
+// StartSearch mocks base method.
+func (m *MockOperator) StartSearch(arg0 *ui.Display) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartSearch", arg0)
+}
+
+// StartSearch indicates an expected call of StartSearch.
+func (mr *MockOperatorMockRecorder) StartSearch(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSearch", reflect.TypeOf((*MockOperator)(nil).StartSearch), arg0)
+}
+
+// SwitchFeedsGrouping mocks base method.
+func (m *MockOperator) SwitchFeedsGrouping(arg0 *ui.Display, arg1 ui.FeedsGrouping) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SwitchFeedsGrouping", arg0, arg1)
+}
+
+// SwitchFeedsGrouping indicates an expected call of SwitchFeedsGrouping.
+func (mr *MockOperatorMockRecorder) SwitchFeedsGrouping(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchFeedsGrouping", reflect.TypeOf((*MockOperator)(nil).SwitchFeedsGrouping), arg0, arg1)
+}
+
 // ToggleAboutPopup mocks base method.
 func (m *MockOperator) ToggleAboutPopup(arg0 *ui.Display, arg1 string) {
 	m.ctrl.T.Helper()
@@ -199,6 +411,18 @@ func (mr *MockOperatorMockRecorder) ToggleAllFeedsFold(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleAllFeedsFold", reflect.TypeOf((*MockOperator)(nil).ToggleAllFeedsFold), arg0)
 }
 
+// ToggleBookmarkedEntriesFilter mocks base method.
+func (m *MockOperator) ToggleBookmarkedEntriesFilter(arg0 *ui.Display) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ToggleBookmarkedEntriesFilter", arg0)
+}
+
+// ToggleBookmarkedEntriesFilter indicates an expected call of ToggleBookmarkedEntriesFilter.
+func (mr *MockOperatorMockRecorder) ToggleBookmarkedEntriesFilter(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleBookmarkedEntriesFilter", reflect.TypeOf((*MockOperator)(nil).ToggleBookmarkedEntriesFilter), arg0)
+}
+
 // ToggleCurrentFeedFold mocks base method.
 func (m *MockOperator) ToggleCurrentFeedFold(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
@@ -223,6 +447,18 @@ func (mr *MockOperatorMockRecorder) ToggleHelpPopup(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleHelpPopup", reflect.TypeOf((*MockOperator)(nil).ToggleHelpPopup), arg0)
 }
 
+// ToggleStarredFeedsFilter mocks base method.
+func (m *MockOperator) ToggleStarredFeedsFilter(arg0 *ui.Display) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ToggleStarredFeedsFilter", arg0)
+}
+
+// ToggleStarredFeedsFilter indicates an expected call of ToggleStarredFeedsFilter.
+func (mr *MockOperatorMockRecorder) ToggleStarredFeedsFilter(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleStarredFeedsFilter", reflect.TypeOf((*MockOperator)(nil).ToggleStarredFeedsFilter), arg0)
+}
+
 // ToggleStatsPopup mocks base method.
 func (m *MockOperator) ToggleStatsPopup(arg0 *ui.Display, arg1 func() (*entity.Stats, error)) {
 	m.ctrl.T.Helper()
@@ -247,6 +483,18 @@ func (mr *MockOperatorMockRecorder) ToggleStatusBar(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleStatusBar", reflect.TypeOf((*MockOperator)(nil).ToggleStatusBar), arg0)
 }
 
+// ToggleUnreadEntriesFilter mocks base method.
+func (m *MockOperator) ToggleUnreadEntriesFilter(arg0 *ui.Display) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ToggleUnreadEntriesFilter", arg0)
+}
+
+// ToggleUnreadEntriesFilter indicates an expected call of ToggleUnreadEntriesFilter.
+func (mr *MockOperatorMockRecorder) ToggleUnreadEntriesFilter(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleUnreadEntriesFilter", reflect.TypeOf((*MockOperator)(nil).ToggleUnreadEntriesFilter), arg0)
+}
+
 // UnfocusFront mocks base method.
 func (m *MockOperator) UnfocusFront(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 
 	"github.com/bow/neon/internal/entity"
 	bknd "github.com/bow/neon/internal/reader/backend"
+	"github.com/bow/neon/internal/reader/keymap"
 	st "github.com/bow/neon/internal/reader/state"
 	"github.com/bow/neon/internal/reader/ui"
 )
@@ -24,6 +25,7 @@ type Reader struct {
 	opr     ui.Operator
 	backend bknd.Backend
 	state   st.State
+	keymap  *keymap.Keymap
 
 	callTimeout time.Duration
 
@@ -36,6 +38,9 @@ func (r *Reader) Start() error {
 		r.opr.ShowIntroPopup(r.display)
 		defer r.state.MarkIntroSeen()
 	}
+	if grouping, err := ui.ParseFeedsGrouping(r.state.FeedsGrouping()); err == nil {
+		r.opr.SetFeedsGrouping(r.display, grouping)
+	}
 	go func() {
 		defer close(r.prestartDone)
 		ctx, cancel := r.callCtx()
@@ -55,75 +60,76 @@ func (r *Reader) globalKeyHandler() ui.KeyHandler {
 	statsPopupLock := make(chan struct{}, 1)
 
 	return func(event *tcell.EventKey) *tcell.EventKey {
-		var (
-			key  = event.Key()
-			keyr = event.Rune()
-		)
+		action, exists := r.keymap.Action(keymap.Global, event)
+		if !exists {
+			return event
+		}
 
 		// nolint:exhaustive
-		switch key {
+		switch action {
 
-		case tcell.KeyRune:
-			switch keyr {
-			case 'A':
-				r.opr.ToggleAboutPopup(r.display, r.backend.String())
-				return nil
+		case keymap.ToggleAbout:
+			r.opr.ToggleAboutPopup(r.display, r.backend.String())
+			return nil
 
-			case 'E':
-				r.opr.FocusEntriesPane(r.display)
-				return nil
+		case keymap.FocusEntries:
+			r.opr.FocusEntriesPane(r.display)
+			return nil
 
-			case 'F':
-				r.opr.FocusFeedsPane(r.display)
-				return nil
+		case keymap.FocusFeeds:
+			r.opr.FocusFeedsPane(r.display)
+			return nil
 
-			case 'R':
-				r.opr.FocusReadingPane(r.display)
-				return nil
+		case keymap.FocusReading:
+			r.opr.FocusReadingPane(r.display)
+			return nil
 
-			case 'S':
-				go func() {
-					select {
-					case statsPopupLock <- struct{}{}:
-						defer func() { <-statsPopupLock }()
-					default:
-						return
-					}
-					ctx, cancel := r.callCtx()
-					defer cancel()
-					r.opr.ToggleStatsPopup(r.display, r.backend.GetStatsF(ctx))
-					r.display.Draw()
-				}()
-				return nil
+		case keymap.FocusNextPane:
+			r.opr.FocusNextPane(r.display)
+			return nil
 
-			case 'H', '?':
-				r.opr.ToggleHelpPopup(r.display)
-				return nil
+		case keymap.FocusPreviousPane:
+			r.opr.FocusPreviousPane(r.display)
+			return nil
 
-			case 'b':
-				r.opr.ToggleStatusBar(r.display)
-				return nil
+		case keymap.ToggleStats:
+			go func() {
+				select {
+				case statsPopupLock <- struct{}{}:
+					defer func() { <-statsPopupLock }()
+				default:
+					return
+				}
+				ctx, cancel := r.callCtx()
+				defer cancel()
+				r.opr.ToggleStatsPopup(r.display, r.backend.GetStatsF(ctx))
+				r.display.Draw()
+			}()
+			return nil
 
-			case 'c':
-				r.opr.ClearStatusBar(r.display)
-				return nil
+		case keymap.ToggleHelp:
+			r.opr.ToggleHelpPopup(r.display)
+			return nil
 
-			case 'q':
-				r.display.Stop()
-				return nil
-			}
+		case keymap.ToggleStatusBar:
+			r.opr.ToggleStatusBar(r.display)
+			return nil
 
-		case tcell.KeyTab:
-			if event.Modifiers()&tcell.ModAlt == 0 {
-				r.opr.FocusNextPane(r.display)
-			} else {
-				r.opr.FocusPreviousPane(r.display)
-			}
+		case keymap.ClearStatusBar:
+			r.opr.ClearStatusBar(r.display)
+			return nil
+
+		case keymap.Search:
+			r.opr.StartSearch(r.display)
 			return nil
 
-		case tcell.KeyEscape:
+		case keymap.Unfocus:
 			r.opr.UnfocusFront(r.display)
 			return nil
+
+		case keymap.Quit:
+			r.display.Stop()
+			return nil
 		}
 
 		return event
@@ -159,26 +165,99 @@ func (r *Reader) feedsPaneKeyHandler() ui.KeyHandler {
 	}
 
 	return func(event *tcell.EventKey) *tcell.EventKey {
-		keyr := event.Rune()
+		action, exists := r.keymap.Action(keymap.FeedsPane, event)
+		if !exists {
+			return event
+		}
 
 		// nolint:exhaustive
-		switch keyr {
+		switch action {
+
+		case keymap.NextFeed:
+			return keyEvent(tcell.KeyDown)
 
-		case 'P':
+		case keymap.PreviousFeed:
+			return keyEvent(tcell.KeyUp)
+
+		case keymap.PullAll:
 			go pullFeeds(nil)
 			return nil
 
-		case 'p':
+		case keymap.PullCurrent:
 			if current := r.opr.GetCurrentFeed(r.display); current != nil {
 				go pullFeeds(current)
 			}
 			return nil
 
-		case 'Z':
+		case keymap.MarkFeedRead:
+			entries := r.opr.GetCurrentUnreadEntries(r.display)
+			if len(entries) == 0 {
+				return nil
+			}
+			isRead := true
+			ops := make([]*entity.EntryEditOp, len(entries))
+			for i, entry := range entries {
+				ops[i] = &entity.EntryEditOp{ID: entry.ID, IsRead: &isRead}
+			}
+			go r.editEntries(ops)
+			return nil
+
+		case keymap.AddFeed:
+			r.opr.ShowAddFeedPopup(r.display, func(feedURL string, tags []string) {
+				go r.addFeed(feedURL, tags)
+			})
+			return nil
+
+		case keymap.EditFeed:
+			if current := r.opr.GetCurrentFeed(r.display); current != nil {
+				r.opr.ShowEditFeedPopup(r.display, current, func(op *entity.FeedEditOp) {
+					go r.editFeeds([]*entity.FeedEditOp{op})
+				})
+			}
+			return nil
+
+		case keymap.DeleteFeed:
+			if current := r.opr.GetCurrentFeed(r.display); current != nil {
+				r.opr.ShowDeleteFeedPopup(r.display, current, func() {
+					go r.deleteFeed(current)
+				})
+			}
+			return nil
+
+		case keymap.ToggleStar:
+			if current := r.opr.GetCurrentFeed(r.display); current != nil {
+				// Tags are always replaced when editing feeds, so the current ones are kept.
+				isStarred := !current.IsStarred
+				tags := current.Tags
+				go r.editFeeds(
+					[]*entity.FeedEditOp{{ID: current.ID, Tags: &tags, IsStarred: &isStarred}},
+				)
+			}
+			return nil
+
+		case keymap.ToggleStarredFilter:
+			r.opr.ToggleStarredFeedsFilter(r.display)
+			return nil
+
+		case keymap.SwitchGrouping:
+			grouping := r.opr.GetFeedsGrouping(r.display).Next()
+			r.opr.SwitchFeedsGrouping(r.display, grouping)
+			r.state.SetFeedsGrouping(grouping.String())
+			return nil
+
+		case keymap.NextMatch:
+			r.opr.SelectNextMatch(r.display)
+			return nil
+
+		case keymap.PreviousMatch:
+			r.opr.SelectPreviousMatch(r.display)
+			return nil
+
+		case keymap.ToggleAllFolds:
 			r.opr.ToggleAllFeedsFold(r.display)
 			return nil
 
-		case 'z':
+		case keymap.ToggleFold:
 			r.opr.ToggleCurrentFeedFold(r.display)
 			return nil
 		}
@@ -187,10 +266,183 @@ func (r *Reader) feedsPaneKeyHandler() ui.KeyHandler {
 	}
 }
 
+func (r *Reader) entriesPaneKeyHandler() ui.KeyHandler {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		action, exists := r.keymap.Action(keymap.EntriesPane, event)
+		if !exists {
+			return event
+		}
+
+		// nolint:exhaustive
+		switch action {
+
+		case keymap.NextEntry:
+			return keyEvent(tcell.KeyDown)
+
+		case keymap.PreviousEntry:
+			return keyEvent(tcell.KeyUp)
+
+		case keymap.OpenEntry:
+			// Opened entries are marked read, while the entry is shown by the pane.
+			if entry := r.opr.GetSelectedEntry(r.display); entry != nil && !entry.IsRead {
+				isRead := true
+				go r.editEntries([]*entity.EntryEditOp{{ID: entry.ID, IsRead: &isRead}})
+			}
+			return keyEvent(tcell.KeyEnter)
+
+		case keymap.MarkEntryRead, keymap.MarkEntryUnread:
+			if entry := r.opr.GetSelectedEntry(r.display); entry != nil {
+				isRead := action == keymap.MarkEntryRead
+				if entry.IsRead != isRead {
+					go r.editEntries([]*entity.EntryEditOp{{ID: entry.ID, IsRead: &isRead}})
+				}
+			}
+			return nil
+
+		case keymap.ToggleBookmark:
+			if entry := r.opr.GetSelectedEntry(r.display); entry != nil {
+				isBookmarked := !entry.IsBookmarked
+				go r.editEntries(
+					[]*entity.EntryEditOp{{ID: entry.ID, IsBookmarked: &isBookmarked}},
+				)
+			}
+			return nil
+
+		case keymap.ToggleUnreadFilter:
+			r.opr.ToggleUnreadEntriesFilter(r.display)
+			return nil
+
+		case keymap.ToggleBookmarkedFilter:
+			r.opr.ToggleBookmarkedEntriesFilter(r.display)
+			return nil
+
+		case keymap.NextMatch:
+			r.opr.SelectNextMatch(r.display)
+			return nil
+
+		case keymap.PreviousMatch:
+			r.opr.SelectPreviousMatch(r.display)
+			return nil
+		}
+
+		return event
+	}
+}
+
+func (r *Reader) readingPaneKeyHandler() ui.KeyHandler {
+	extractLock := make(chan struct{}, 1)
+
+	extractContent := func(entry *entity.Entry) {
+		select {
+		case extractLock <- struct{}{}:
+			defer func() { <-extractLock }()
+		default:
+			return
+		}
+		// Extraction fetches the page of the entry, so it may take longer than other calls.
+		ctx, cancel := r.fetchCallCtx()
+		defer cancel()
+		r.opr.ExtractEntryContent(r.display, r.backend.ExtractEntryContentF(ctx, entry.ID), entry)
+		r.display.Draw()
+	}
+
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		action, exists := r.keymap.Action(keymap.ReadingPane, event)
+		if !exists {
+			return event
+		}
+
+		// nolint:exhaustive
+		switch action {
+
+		case keymap.ScrollDown:
+			return keyEvent(tcell.KeyDown)
+
+		case keymap.ScrollUp:
+			return keyEvent(tcell.KeyUp)
+
+		case keymap.ScrollToTop:
+			return keyEvent(tcell.KeyHome)
+
+		case keymap.ScrollToBottom:
+			return keyEvent(tcell.KeyEnd)
+
+		case keymap.ExtractContent:
+			if current := r.opr.GetCurrentEntry(r.display); current != nil {
+				go extractContent(current)
+			}
+			return nil
+		}
+
+		return event
+	}
+}
+
+// addFeed adds the feed with the given URL and tags, and refreshes the stats.
+func (r *Reader) addFeed(feedURL string, tags []string) {
+	// Adding a feed fetches it, so it may take longer than other calls.
+	ctxa, cancela := r.fetchCallCtx()
+	defer cancela()
+	r.opr.AddFeed(r.display, r.backend.AddFeedF(ctxa, feedURL, tags), feedURL)
+
+	r.refreshStats()
+}
+
+// editFeeds applies the given edits to feeds.
+func (r *Reader) editFeeds(ops []*entity.FeedEditOp) {
+	ctx, cancel := r.callCtx()
+	defer cancel()
+	r.opr.EditFeeds(r.display, r.backend.EditFeedsF(ctx, ops))
+
+	r.display.Draw()
+}
+
+// deleteFeed deletes the given feed and its entries, and refreshes the stats.
+func (r *Reader) deleteFeed(feed *entity.Feed) {
+	ctxd, canceld := r.callCtx()
+	defer canceld()
+	r.opr.DeleteFeed(r.display, r.backend.DeleteFeedsF(ctxd, []entity.ID{feed.ID}), feed)
+
+	r.refreshStats()
+}
+
+// editEntries applies the given edits to entries, and refreshes the stats, whose unread entry
+// counts may change.
+func (r *Reader) editEntries(ops []*entity.EntryEditOp) {
+	ctxe, cancele := r.callCtx()
+	defer cancele()
+	r.opr.EditEntries(r.display, r.backend.EditEntriesF(ctxe, ops))
+
+	r.refreshStats()
+}
+
+// refreshStats refreshes the stats, and redraws the display.
+func (r *Reader) refreshStats() {
+	ctx, cancel := r.callCtx()
+	defer cancel()
+	r.opr.RefreshStats(r.display, r.backend.GetStatsF(ctx))
+
+	r.display.Draw()
+}
+
+// minFetchTimeout is the minimum timeout of calls that fetch remote pages, such as for adding
+// feeds or extracting the content of entries.
+const minFetchTimeout = 30 * time.Second
+
 func (r *Reader) callCtx() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(r.ctx, r.callTimeout)
 }
 
+func (r *Reader) fetchCallCtx() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(r.ctx, max(r.callTimeout, minFetchTimeout))
+}
+
+// keyEvent returns an event of the given key, for actions that are carried out by the panes
+// themselves, such as moving between items.
+func keyEvent(key tcell.Key) *tcell.EventKey {
+	return tcell.NewEventKey(key, 0, tcell.ModNone)
+}
+
 func (r *Reader) mustDefinedFields() {
 	if r.display == nil {
 		panic("can not set handler with nil display")
@@ -203,12 +455,17 @@ func (r *Reader) mustDefinedFields() {
 	if r.backend == nil {
 		panic("can not set handler with nil backend")
 	}
+
+	if r.keymap == nil {
+		panic("can not set handler with nil keymap")
+	}
 }
 
 type Builder struct {
 	ctx       context.Context
 	themeName string
 	scr       tcell.Screen
+	km        *keymap.Keymap
 
 	// rpcBackend args.
 	addr           string
@@ -226,6 +483,7 @@ func NewBuilder(ctx context.Context) *Builder {
 	b := Builder{
 		ctx:         ctx,
 		themeName:   "dark",
+		km:          keymap.Default(),
 		dopts:       nil,
 		callTimeout: 3 * time.Second,
 	}
@@ -262,6 +520,11 @@ func (b *Builder) Theme(name string) *Builder {
 	return b
 }
 
+func (b *Builder) Keymap(km *keymap.Keymap) *Builder {
+	b.km = km
+	return b
+}
+
 func (b *Builder) backend(be bknd.Backend) *Builder {
 	b.be = be
 	return b
@@ -320,6 +583,7 @@ func (b *Builder) Build() (*Reader, error) {
 	if err != nil {
 		return nil, err
 	}
+	dsp.SetKeymap(b.km)
 
 	var opr ui.Operator
 	if b.opr != nil {
@@ -341,6 +605,7 @@ func (b *Builder) Build() (*Reader, error) {
 		opr:     opr,
 		backend: be,
 		state:   stt,
+		keymap:  b.km,
 
 		callTimeout: b.callTimeout,
 
@@ -349,6 +614,8 @@ func (b *Builder) Build() (*Reader, error) {
 	rdr.display.SetHandlers(
 		rdr.globalKeyHandler(),
 		rdr.feedsPaneKeyHandler(),
+		rdr.entriesPaneKeyHandler(),
+		rdr.readingPaneKeyHandler(),
 	)
 
 	return &rdr, nil
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/reader/keymap"
+	"github.com/bow/neon/internal/reader/ui"
 	"github.com/gdamore/tcell/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -123,6 +125,232 @@ func TestToggleStatsPopupCalled(t *testing.T) {
 	tw.screen.InjectKey(tcell.KeyRune, 'S', tcell.ModNone)
 }
 
+func TestEntriesPaneMarkReadCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	entry := entity.Entry{ID: 3, FeedID: 1, Title: "Entry A"}
+	isRead := true
+	ops := []*entity.EntryEditOp{{ID: 3, IsRead: &isRead}}
+
+	done := tw.expectEditEntries(rdr, ops)
+	tw.opr.EXPECT().GetSelectedEntry(rdr.display).Return(&entry)
+
+	event := rdr.entriesPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone))
+	assert.Nil(t, event)
+	waitDone(t, done)
+}
+
+func TestEntriesPaneMarkReadOnOpenCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	entry := entity.Entry{ID: 3, FeedID: 1, Title: "Entry A"}
+	isRead := true
+	ops := []*entity.EntryEditOp{{ID: 3, IsRead: &isRead}}
+
+	done := tw.expectEditEntries(rdr, ops)
+	tw.opr.EXPECT().GetSelectedEntry(rdr.display).Return(&entry)
+
+	// The event is passed on, so that the entry is also shown.
+	event := rdr.entriesPaneKeyHandler()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	assert.NotNil(t, event)
+	waitDone(t, done)
+}
+
+func TestEntriesPaneMarkUnreadSkipped(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	entry := entity.Entry{ID: 3, FeedID: 1, Title: "Entry A"}
+	tw.opr.EXPECT().GetSelectedEntry(rdr.display).Return(&entry)
+	tw.backend.EXPECT().EditEntriesF(gomock.Any(), gomock.Any()).Times(0)
+
+	event := rdr.entriesPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'u', tcell.ModNone))
+	assert.Nil(t, event)
+}
+
+func TestEntriesPaneToggleBookmarkCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	entry := entity.Entry{ID: 3, FeedID: 1, Title: "Entry A", IsBookmarked: true}
+	isBookmarked := false
+	ops := []*entity.EntryEditOp{{ID: 3, IsBookmarked: &isBookmarked}}
+
+	done := tw.expectEditEntries(rdr, ops)
+	tw.opr.EXPECT().GetSelectedEntry(rdr.display).Return(&entry)
+
+	event := rdr.entriesPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone))
+	assert.Nil(t, event)
+	waitDone(t, done)
+}
+
+func TestFeedsPaneMarkAllReadCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	entries := []*entity.Entry{{ID: 3, FeedID: 1}, {ID: 5, FeedID: 2}}
+	isRead := true
+	ops := []*entity.EntryEditOp{{ID: 3, IsRead: &isRead}, {ID: 5, IsRead: &isRead}}
+
+	done := tw.expectEditEntries(rdr, ops)
+	tw.opr.EXPECT().GetCurrentUnreadEntries(rdr.display).Return(entries)
+
+	event := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone))
+	assert.Nil(t, event)
+	waitDone(t, done)
+}
+
+func TestFeedsPaneAddFeedCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	var (
+		done    = make(chan struct{})
+		feedURL = "https://a.com/feed.xml"
+		tags    = []string{"x", "y"}
+	)
+
+	tw.opr.EXPECT().ShowAddFeedPopup(rdr.display, gomock.Any()).
+		Do(func(_ *ui.Display, onSubmit func(string, []string)) { onSubmit(feedURL, tags) })
+	tw.backend.EXPECT().AddFeedF(gomock.Any(), feedURL, tags).
+		Return(func() (*entity.Feed, bool, error) { return nil, false, nil })
+	tw.opr.EXPECT().AddFeed(rdr.display, gomock.Any(), feedURL)
+	tw.backend.EXPECT().GetStatsF(gomock.Any()).
+		Return(func() (*entity.Stats, error) { return nil, nil })
+	tw.opr.EXPECT().RefreshStats(rdr.display, gomock.Any()).
+		Do(func(*ui.Display, func() (*entity.Stats, error)) { close(done) })
+
+	event := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone))
+	assert.Nil(t, event)
+	waitDone(t, done)
+}
+
+func TestFeedsPaneEditFeedCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	var (
+		done  = make(chan struct{})
+		feed  = entity.Feed{ID: 2, Title: "Feed A", Tags: []string{"x"}}
+		title = "Feed B"
+		tags  = []string{"x", "y"}
+		op    = entity.FeedEditOp{ID: 2, Title: &title, Tags: &tags}
+	)
+
+	tw.opr.EXPECT().GetCurrentFeed(rdr.display).Return(&feed)
+	tw.opr.EXPECT().ShowEditFeedPopup(rdr.display, &feed, gomock.Any()).
+		Do(func(_ *ui.Display, _ *entity.Feed, onSubmit func(*entity.FeedEditOp)) {
+			onSubmit(&op)
+		})
+	tw.backend.EXPECT().EditFeedsF(gomock.Any(), []*entity.FeedEditOp{&op}).
+		Return(func() ([]*entity.Feed, error) { return nil, nil })
+	tw.opr.EXPECT().EditFeeds(rdr.display, gomock.Any()).
+		Do(func(*ui.Display, func() ([]*entity.Feed, error)) { close(done) })
+
+	event := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone))
+	assert.Nil(t, event)
+	waitDone(t, done)
+}
+
+func TestFeedsPaneToggleStarCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	var (
+		done      = make(chan struct{})
+		feed      = entity.Feed{ID: 2, Title: "Feed A", Tags: []string{"x"}}
+		isStarred = true
+		ops       = []*entity.FeedEditOp{{ID: 2, Tags: &feed.Tags, IsStarred: &isStarred}}
+	)
+
+	tw.opr.EXPECT().GetCurrentFeed(rdr.display).Return(&feed)
+	tw.backend.EXPECT().EditFeedsF(gomock.Any(), ops).
+		Return(func() ([]*entity.Feed, error) { return nil, nil })
+	tw.opr.EXPECT().EditFeeds(rdr.display, gomock.Any()).
+		Do(func(*ui.Display, func() ([]*entity.Feed, error)) { close(done) })
+
+	event := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone))
+	assert.Nil(t, event)
+	waitDone(t, done)
+}
+
+func TestFeedsPaneDeleteFeedCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	var (
+		done = make(chan struct{})
+		feed = entity.Feed{ID: 2, Title: "Feed A"}
+	)
+
+	tw.opr.EXPECT().GetCurrentFeed(rdr.display).Return(&feed)
+	tw.opr.EXPECT().ShowDeleteFeedPopup(rdr.display, &feed, gomock.Any()).
+		Do(func(_ *ui.Display, _ *entity.Feed, onConfirm func()) { onConfirm() })
+	tw.backend.EXPECT().DeleteFeedsF(gomock.Any(), []entity.ID{2}).
+		Return(func() error { return nil })
+	tw.opr.EXPECT().DeleteFeed(rdr.display, gomock.Any(), &feed)
+	tw.backend.EXPECT().GetStatsF(gomock.Any()).
+		Return(func() (*entity.Stats, error) { return nil, nil })
+	tw.opr.EXPECT().RefreshStats(rdr.display, gomock.Any()).
+		Do(func(*ui.Display, func() (*entity.Stats, error)) { close(done) })
+
+	event := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone))
+	assert.Nil(t, event)
+	waitDone(t, done)
+}
+
+func TestStartSearchCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	tw.opr.EXPECT().StartSearch(rdr.display)
+
+	tw.screen.InjectKey(tcell.KeyRune, '/', tcell.ModNone)
+}
+
+func TestFeedsPaneSearchKeysCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	tw.opr.EXPECT().ToggleStarredFeedsFilter(rdr.display)
+	tw.opr.EXPECT().SelectNextMatch(rdr.display)
+	tw.opr.EXPECT().SelectPreviousMatch(rdr.display)
+
+	handler := rdr.feedsPaneKeyHandler()
+	for _, keyr := range []rune{'*', 'n', 'N'} {
+		assert.Nil(t, handler(tcell.NewEventKey(tcell.KeyRune, keyr, tcell.ModNone)))
+	}
+}
+
+func TestEntriesPaneSearchKeysCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	tw.opr.EXPECT().ToggleUnreadEntriesFilter(rdr.display)
+	tw.opr.EXPECT().ToggleBookmarkedEntriesFilter(rdr.display)
+	tw.opr.EXPECT().SelectNextMatch(rdr.display)
+	tw.opr.EXPECT().SelectPreviousMatch(rdr.display)
+
+	handler := rdr.entriesPaneKeyHandler()
+	for _, keyr := range []rune{'U', 'B', 'n', 'N'} {
+		assert.Nil(t, handler(tcell.NewEventKey(tcell.KeyRune, keyr, tcell.ModNone)))
+	}
+}
+
 func TestToggleStatusBarCalled(t *testing.T) {
 	tw := setupReaderTest(t)
 
@@ -142,6 +370,27 @@ func TestShowIntroPopupCalled(t *testing.T) {
 	tw.draw()
 }
 
+func TestSetFeedsGroupingCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	tw.feedsGrouping = "tag"
+	tw.opr.EXPECT().SetFeedsGrouping(gomock.Any(), ui.GroupByTag)
+	tw.draw()
+}
+
+func TestFeedsPaneSwitchGroupingCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	tw.opr.EXPECT().GetFeedsGrouping(rdr.display).Return(ui.GroupByStarred)
+	tw.opr.EXPECT().SwitchFeedsGrouping(rdr.display, ui.GroupFlat)
+	tw.state.EXPECT().SetFeedsGrouping("flat")
+
+	event := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone))
+	assert.Nil(t, event)
+}
+
 func TestUnfocusFrontCalled(t *testing.T) {
 	tw := setupReaderTest(t)
 
@@ -152,6 +401,49 @@ func TestUnfocusFrontCalled(t *testing.T) {
 	tw.screen.InjectKey(tcell.KeyEscape, ' ', tcell.ModNone)
 }
 
+func TestReboundKeysCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	km, err := keymap.Default().Override(map[string][]string{
+		"focus-feeds":      {"alt-f"},
+		"clear-status-bar": {"F"},
+	})
+	require.NoError(t, err)
+	tw.keymap = km
+
+	rdr := tw.draw()
+
+	tw.opr.EXPECT().FocusFeedsPane(rdr.display)
+	tw.opr.EXPECT().ClearStatusBar(rdr.display)
+
+	tw.screen.InjectKey(tcell.KeyRune, 'f', tcell.ModAlt)
+	tw.screen.InjectKey(tcell.KeyRune, 'F', tcell.ModNone)
+	// Keys that are no longer bound pass through.
+	tw.screen.InjectKey(tcell.KeyRune, 'c', tcell.ModNone)
+}
+
+func TestPaneNavigationKeys(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	tests := []struct {
+		handler ui.KeyHandler
+		keyr    rune
+		key     tcell.Key
+	}{
+		{rdr.feedsPaneKeyHandler(), 'j', tcell.KeyDown},
+		{rdr.entriesPaneKeyHandler(), 'k', tcell.KeyUp},
+		{rdr.readingPaneKeyHandler(), 'g', tcell.KeyHome},
+		{rdr.readingPaneKeyHandler(), 'G', tcell.KeyEnd},
+	}
+	for _, test := range tests {
+		event := test.handler(tcell.NewEventKey(tcell.KeyRune, test.keyr, tcell.ModNone))
+		require.NotNil(t, event)
+		assert.Equal(t, test.key, event.Key())
+	}
+}
+
 func TestStartSmoke(t *testing.T) {
 	tw := setupReaderTest(t)
 
@@ -190,6 +482,32 @@ func TestStartSmoke(t *testing.T) {
 	assert.Eventually(t, empty, pollTimeout, tickFreq)
 }
 
+// expectEditEntries sets up the calls for applying the given entry edits, returning a channel that
+// is closed once they are all done.
+func (tw *testWrapper) expectEditEntries(rdr *Reader, ops []*entity.EntryEditOp) <-chan struct{} {
+	done := make(chan struct{})
+
+	tw.backend.EXPECT().EditEntriesF(gomock.Any(), ops).
+		Return(func() ([]*entity.Entry, error) { return nil, nil })
+	tw.opr.EXPECT().EditEntries(rdr.display, gomock.Any())
+	tw.backend.EXPECT().GetStatsF(gomock.Any()).
+		Return(func() (*entity.Stats, error) { return nil, nil })
+	tw.opr.EXPECT().RefreshStats(rdr.display, gomock.Any()).
+		Do(func(*ui.Display, func() (*entity.Stats, error)) { close(done) })
+
+	return done
+}
+
+func waitDone(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for calls")
+	}
+}
+
 type testWrapper struct {
 	screen  tcell.SimulationScreen
 	opr     *MockOperator
@@ -197,7 +515,9 @@ type testWrapper struct {
 	state   *MockState
 	draw    func() *Reader
 
-	introSeen bool
+	introSeen     bool
+	feedsGrouping string
+	keymap        *keymap.Keymap
 }
 
 func setupReaderTest(t *testing.T) *testWrapper {
@@ -218,12 +538,15 @@ func setupReaderTest(t *testing.T) *testWrapper {
 	var startWG, setupWG sync.WaitGroup
 
 	drawf := func() *Reader {
-		rdr, err := NewBuilder(context.Background()).
+		builder := NewBuilder(context.Background()).
 			backend(be).
 			screen(screen).
 			operator(opr).
-			state(stt).
-			Build()
+			state(stt)
+		if tw.keymap != nil {
+			builder = builder.Keymap(tw.keymap)
+		}
+		rdr, err := builder.Build()
 		r.NoError(err)
 		r.NotNil(rdr)
 
@@ -237,6 +560,7 @@ func setupReaderTest(t *testing.T) *testWrapper {
 			defer startWG.Done()
 
 			stt.EXPECT().IntroSeen().Return(tw.introSeen)
+			stt.EXPECT().FeedsGrouping().Return(tw.feedsGrouping)
 
 			be.EXPECT().GetStatsF(gomock.Any()).
 				Return(func() (*entity.Stats, error) { return nil, nil })
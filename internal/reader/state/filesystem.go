@@ -6,10 +6,12 @@ package state
 import (
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 type FileSystemState struct {
-	initPath string
+	initPath     string
+	groupingPath string
 }
 
 func newFileSystemState() (*FileSystemState, error) {
@@ -27,7 +29,10 @@ func newFileSystemState() (*FileSystemState, error) {
 		}
 	}
 
-	fst := FileSystemState{initPath: filepath.Join(sd, initFileName)}
+	fst := FileSystemState{
+		initPath:     filepath.Join(sd, initFileName),
+		groupingPath: filepath.Join(sd, groupingFileName),
+	}
 
 	return &fst, nil
 }
@@ -45,6 +50,23 @@ func (s *FileSystemState) IntroSeen() bool {
 	return true
 }
 
+// FeedsGrouping returns the name of the last chosen grouping of the feeds pane, or an empty
+// string if none was chosen.
+func (s *FileSystemState) FeedsGrouping() string {
+	raw, err := os.ReadFile(s.groupingPath)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+func (s *FileSystemState) SetFeedsGrouping(name string) {
+	_ = os.WriteFile(s.groupingPath, []byte(name+"\n"), 0o600)
+}
+
 var _ State = new(FileSystemState)
 
-var initFileName = "reader.initialized"
+var (
+	initFileName     = "reader.initialized"
+	groupingFileName = "reader.grouping"
+)
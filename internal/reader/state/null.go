@@ -11,4 +11,8 @@ func (s *NullState) MarkIntroSeen() {}
 
 func (s *NullState) IntroSeen() bool { return true }
 
+func (s *NullState) FeedsGrouping() string { return "" }
+
+func (s *NullState) SetFeedsGrouping(string) {}
+
 var _ State = new(NullState)
@@ -7,6 +7,8 @@ package state
 type State interface {
 	MarkIntroSeen()
 	IntroSeen() bool
+	FeedsGrouping() string
+	SetFeedsGrouping(string)
 }
 
 func NewState() State {
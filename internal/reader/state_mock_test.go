@@ -39,6 +39,20 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// FeedsGrouping mocks base method.
+func (m *MockState) FeedsGrouping() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeedsGrouping")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// FeedsGrouping indicates an expected call of FeedsGrouping.
+func (mr *MockStateMockRecorder) FeedsGrouping() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedsGrouping", reflect.TypeOf((*MockState)(nil).FeedsGrouping))
+}
+
 // IntroSeen mocks base method.
 func (m *MockState) IntroSeen() bool {
 	m.ctrl.T.Helper()
@@ -64,3 +78,15 @@ func (mr *MockStateMockRecorder) MarkIntroSeen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkIntroSeen", reflect.TypeOf((*MockState)(nil).MarkIntroSeen))
 }
+
+// SetFeedsGrouping mocks base method.
+func (m *MockState) SetFeedsGrouping(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeedsGrouping", arg0)
+}
+
+// SetFeedsGrouping indicates an expected call of SetFeedsGrouping.
+func (mr *MockStateMockRecorder) SetFeedsGrouping(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeedsGrouping", reflect.TypeOf((*MockState)(nil).SetFeedsGrouping), arg0)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/bow/neon/internal"
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/reader/keymap"
 )
 
 type Display struct {
@@ -34,10 +35,13 @@ type Display struct {
 	barVisible bool
 	eventsCh   chan *event
 
+	searchPrompt *tview.InputField
+
 	aboutPopup *popup
 	helpPopup  *popup
 	introPopup *popup
 	statsPopup *popup
+	formPopup  *popup
 
 	handlersSet bool
 
@@ -67,9 +71,20 @@ func NewDisplay(screen tcell.Screen, theme string) (*Display, error) {
 func (d *Display) SetHandlers(
 	globalKeyHandler KeyHandler,
 	feedsPaneKeyHandler KeyHandler,
+	entriesPaneKeyHandler KeyHandler,
+	readingPaneKeyHandler KeyHandler,
 ) {
-	d.inner.SetInputCapture(globalKeyHandler)
+	d.inner.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// Keys typed into forms and the search prompt must reach their fields, instead of
+		// triggering global actions.
+		if d.frontPageName() == formPageName || d.isSearching() {
+			return event
+		}
+		return globalKeyHandler(event)
+	})
 	d.feedsPane.SetInputCapture(feedsPaneKeyHandler)
+	d.entriesPane.SetInputCapture(entriesPaneKeyHandler)
+	d.readingPane.SetInputCapture(readingPaneKeyHandler)
 	d.handlersSet = true
 }
 
@@ -80,7 +95,7 @@ func (d *Display) Start() error {
 	stopv := d.startEventPoll()
 	defer stopv()
 
-	stopf := d.feedsPane.startPoll()
+	stopf := d.feedsPane.startPoll(d.queueUpdateDraw)
 	defer stopf()
 
 	return d.inner.Run()
@@ -94,15 +109,24 @@ func (d *Display) Stop() {
 	d.inner.Stop()
 }
 
+// queueUpdateDraw applies f in the event loop of the display, which owns the widgets and the
+// feeds they show, and redraws the display. It waits until f is applied, so it must not be called
+// from the event loop itself.
+func (d *Display) queueUpdateDraw(f func()) {
+	d.inner.QueueUpdateDraw(f)
+}
+
 func (d *Display) dimMainPage() {
 	d.theme.dim()
 	d.feedsPane.refreshColors()
+	d.entriesPane.refreshColors()
 	d.bar.refreshColors()
 }
 
 func (d *Display) normalizeMainPage() {
 	d.theme.normalize()
 	d.feedsPane.refreshColors()
+	d.entriesPane.refreshColors()
 	d.bar.refreshColors()
 }
 
@@ -112,6 +136,7 @@ const (
 	helpPageName  = "help"
 	introPageName = "intro"
 	statsPageName = "stats"
+	formPageName  = "form"
 
 	longDateFormat = "2 January 2006 · 15:04:05 MST"
 
@@ -130,13 +155,25 @@ var (
 func (d *Display) setRoot() {
 	pages := tview.NewPages()
 	d.setMainPage()
-	d.setHelpPopup()
-	d.setIntroPopup()
 
 	d.bar = newStatusBar(d.theme)
 	d.bar.setChangedFunc(func() { d.inner.Draw() })
 	d.addStatusBar()
 
+	d.helpPopup = newPopup(
+		d.lang.helpPopupTitle,
+		d.theme.popupTitleFG,
+		1, 1,
+		0, 0,
+	)
+	d.introPopup = newPopup(
+		d.lang.introPopupTitle,
+		d.theme.popupTitleFG,
+		1, 1,
+		-1, -3,
+	)
+	d.SetKeymap(keymap.Default())
+
 	d.aboutPopup = newPopup(
 		d.lang.aboutPopupTitle,
 		d.theme.popupTitleFG,
@@ -149,13 +186,20 @@ func (d *Display) setRoot() {
 		1, 1,
 		-1, -3,
 	)
+	d.formPopup = newPopup(
+		"",
+		d.theme.popupTitleFG,
+		1, 1,
+		-1, -3,
+	)
 
 	pages.
 		AddAndSwitchToPage(mainPageName, d.mainPage, true).
 		AddPage(helpPageName, d.helpPopup, true, false).
 		AddPage(aboutPageName, d.aboutPopup, true, false).
 		AddPage(statsPageName, d.statsPopup, true, false).
-		AddPage(introPageName, d.introPopup, true, false)
+		AddPage(introPageName, d.introPopup, true, false).
+		AddPage(formPageName, d.formPopup, true, false)
 
 	d.root = pages
 	d.inner = d.inner.SetRoot(pages, true)
@@ -215,10 +259,9 @@ func (d *Display) setMainPage() {
 
 func (d *Display) startEventPoll() (stop func()) {
 	done := make(chan struct{})
-	stop = func() {
-		defer close(done)
-		done <- struct{}{}
-	}
+	// The poll may be waiting on a queued update when the event loop has already stopped, so
+	// stopping must not wait for it.
+	stop = func() { close(done) }
 
 	go func() {
 		for {
@@ -226,7 +269,7 @@ func (d *Display) startEventPoll() (stop func()) {
 			case <-done:
 				return
 			case ev := <-d.eventsCh:
-				d.bar.showEvent(ev)
+				d.queueUpdateDraw(func() { d.bar.showEvent(ev) })
 			}
 		}
 	}()
@@ -252,86 +295,31 @@ func (d *Display) toggleStatusBar() {
 	}
 }
 
-func (d *Display) clearEvent() {
-	d.bar.clearLatestEvent()
+// updateEntries replaces the given entries wherever earlier versions of them are shown. It is
+// called outside of the event loop, so the replacements are queued in it.
+func (d *Display) updateEntries(entries []*entity.Entry) {
+	d.queueUpdateDraw(func() {
+		for _, entry := range entries {
+			d.feedsPane.store.upsertEntry(entry)
+			d.entriesPane.refreshEntry(entry)
+			d.readingPane.refreshEntry(entry)
+		}
+		d.feedsPane.refreshUnreadCounts()
+	})
 }
 
-func (d *Display) setHelpPopup() {
-	// TODO: Consider moving the content out into where handlers are defined.
-	helpText := `[aqua]Feeds pane[-]
-[yellow]j/k[-]: Next / previous item
-[yellow]p[-]  : Pull current feed
-[yellow]P[-]  : Pull all feeds
-[yellow]R[-]  : Mark all entries in current feed read
-[yellow]s[-]  : Star / unstar feed
-[yellow]a[-]  : Add feed
-[yellow]e[-]  : Edit feed
-[yellow]d[-]  : Delete feed
-[yellow]Z[-]  : Expand / collapse all feeds
-
-[aqua]Entries pane[-]
-[yellow]j/k[-]: Next / previous entry
-[yellow]r[-]  : Mark current entry read
-[yellow]u[-]  : Mark current entry unread
-[yellow]b[-]  : Add / remove current entry from bookmarks
-
-[aqua]Reading pane[-]
-[yellow]j/k[-]: Scroll down / up
-[yellow]g[-]  : Go to top
-[yellow]G[-]  : Go to bottom
-
-[aqua]Global[-]
-[yellow]F[-]       : Set focus to feeds pane
-[yellow]E[-]       : Set focus to entries pane
-[yellow]R[-]       : Set focus to reading pane
-[yellow]Tab[-]     : Switch to next pane
-[yellow]Alt-Tab[-] : Switch to previous pane
-[yellow]b[-]       : Toggle status bar
-[yellow]c[-]       : Clear status bar
-[yellow]X[-]       : Export feeds to OPML
-[yellow]I[-]       : Import feeds from OPML
-[yellow]Esc[-]     : Unset current focus or close open frame
-[yellow]S[-]       : Toggle stats popup and show latest values
-[yellow]A[-]       : Toggle 'about' popup
-[yellow]H,?[-]     : Toggle this help
-[yellow]q,Ctrl-C[-]: Quit reader`
-
-	helpWidget := tview.NewTextView().
-		SetDynamicColors(true).
-		SetText(helpText)
-
-	d.helpPopup = newFilledPopup(
-		d.lang.helpPopupTitle,
-		helpWidget,
-		d.theme.popupTitleFG,
-		popupWidth(helpWidget.GetText(true)),
-		popupHeight(helpText),
-		1, 1,
-		0, 0,
-	)
+// removeFeed removes the feed with the given ID from all panes. It is called outside of the event
+// loop, so the removal is queued in it.
+func (d *Display) removeFeed(id entity.ID) {
+	d.queueUpdateDraw(func() {
+		d.feedsPane.removeFeed(id)
+		d.entriesPane.removeFeed(id)
+		d.readingPane.removeFeed(id)
+	})
 }
 
-func (d *Display) setIntroPopup() {
-	introText := fmt.Sprintf(`Hello and welcome the %s reader.
-
-For help, press [yellow]?[-] or go to [yellow]%s[-].
-
-To close this message, press [yellow]<Esc>[-].
-`, internal.AppName(), internal.AppHomepage())
-
-	introWidget := tview.NewTextView().
-		SetDynamicColors(true).
-		SetText(introText)
-
-	d.introPopup = newFilledPopup(
-		d.lang.introPopupTitle,
-		introWidget,
-		d.theme.popupTitleFG,
-		popupWidth(introWidget.GetText(true)),
-		popupHeight(introText),
-		1, 1,
-		-1, -3,
-	)
+func (d *Display) clearEvent() {
+	d.bar.clearLatestEvent()
 }
 
 func (d *Display) setAboutPopupText(name string) {
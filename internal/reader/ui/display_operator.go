@@ -13,10 +13,43 @@ func NewDisplayOperator() *DisplayOperator {
 	return &DisplayOperator{}
 }
 
+func (do *DisplayOperator) AddFeed(
+	d *Display,
+	f func() (*entity.Feed, bool, error),
+	feedURL string,
+) {
+	d.infoEventf("Adding %s", feedURL)
+
+	feed, isAdded, err := f()
+	if err != nil {
+		d.errEventf("Failed to add %s: %s", feedURL, err)
+		return
+	}
+	go func() { d.feedsCh <- feed }()
+
+	if isAdded {
+		d.infoEventf("Added %q", feed.Title)
+	} else {
+		d.warnEventf("Feed %q already exists", feed.Title)
+	}
+}
+
 func (do *DisplayOperator) ClearStatusBar(d *Display) {
 	d.clearEvent()
 }
 
+func (do *DisplayOperator) DeleteFeed(d *Display, f func() error, hint *entity.Feed) {
+	d.infoEventf("Deleting %q", hint.Title)
+
+	if err := f(); err != nil {
+		d.errEventf("Failed to delete %q: %s", hint.Title, err)
+		return
+	}
+	d.removeFeed(hint.ID)
+
+	d.infoEventf("Deleted %q", hint.Title)
+}
+
 func (do *DisplayOperator) FocusFeedsPane(d *Display) {
 	d.focusPane(d.feedsPane)
 }
@@ -37,10 +70,68 @@ func (do *DisplayOperator) FocusReadingPane(d *Display) {
 	d.focusPane(d.readingPane)
 }
 
+func (do *DisplayOperator) EditEntries(d *Display, f func() ([]*entity.Entry, error)) {
+	entries, err := f()
+	if err != nil {
+		d.errEvent(err)
+		return
+	}
+	d.updateEntries(entries)
+}
+
+func (do *DisplayOperator) EditFeeds(d *Display, f func() ([]*entity.Feed, error)) {
+	feeds, err := f()
+	if err != nil {
+		d.errEvent(err)
+		return
+	}
+	go func() {
+		for _, feed := range feeds {
+			d.feedsCh <- feed
+		}
+	}()
+	for _, feed := range feeds {
+		d.infoEventf("Updated %q", feed.Title)
+	}
+}
+
+func (do *DisplayOperator) ExtractEntryContent(
+	d *Display,
+	f func() (*entity.Entry, error),
+	hint *entity.Entry,
+) {
+	d.infoEventf("Extracting content of %q", hint.Title)
+
+	entry, err := f()
+	if err != nil {
+		d.errEventf("Content extraction failed for %q: %s", hint.Title, err)
+		return
+	}
+	d.updateEntries([]*entity.Entry{entry})
+
+	d.infoEventf("Extracted content of %q", entry.Title)
+}
+
+func (do *DisplayOperator) GetCurrentEntry(d *Display) *entity.Entry {
+	return d.readingPane.getCurrentEntry()
+}
+
 func (do *DisplayOperator) GetCurrentFeed(d *Display) *entity.Feed {
 	return d.feedsPane.getCurrentFeed()
 }
 
+func (do *DisplayOperator) GetFeedsGrouping(d *Display) FeedsGrouping {
+	return d.feedsPane.grouping
+}
+
+func (do *DisplayOperator) GetCurrentUnreadEntries(d *Display) []*entity.Entry {
+	return d.feedsPane.getCurrentUnreadEntries()
+}
+
+func (do *DisplayOperator) GetSelectedEntry(d *Display) *entity.Entry {
+	return d.entriesPane.getSelectedEntry()
+}
+
 func (do *DisplayOperator) PopulateFeedsPane(d *Display, f func() ([]*entity.Feed, error)) {
 	feeds, err := f()
 	if err != nil {
@@ -73,12 +164,20 @@ func (do *DisplayOperator) RefreshFeeds(
 		return
 	}
 	for pr := range ch {
-		if perr := pr.Error(); perr != nil {
-			d.errEventf("Pull failed for %s: %s", pr.URL(), perr)
+		switch pr.Status() {
+		case entity.PullFail:
+			d.errEventf("Pull failed for %s: %s", pr.URL(), pr.Error())
 			errc++
-		} else {
+		case entity.PullSkipped:
+			d.infoEventf("Skipped %s", pr.URL())
+		case entity.PullNotModified:
+			d.infoEventf("Pulled %s, no changes", pr.URL())
+			okc++
+		default:
 			d.infoEventf("Pulled %s", pr.URL())
-			go func() { d.feedsCh <- pr.Feed() }()
+			if feed := pr.Feed(); feed != nil {
+				go func() { d.feedsCh <- feed }()
+			}
 			okc++
 		}
 	}
@@ -110,10 +209,47 @@ func (do *DisplayOperator) RefreshStats(d *Display, f func() (*entity.Stats, err
 	d.setStats(stats)
 }
 
+func (do *DisplayOperator) SelectNextMatch(d *Display) {
+	d.selectMatch(false)
+}
+
+func (do *DisplayOperator) SelectPreviousMatch(d *Display) {
+	d.selectMatch(true)
+}
+
+func (do *DisplayOperator) SetFeedsGrouping(d *Display, grouping FeedsGrouping) {
+	d.feedsPane.setGrouping(grouping)
+}
+
+func (do *DisplayOperator) ShowAddFeedPopup(d *Display, onSubmit func(string, []string)) {
+	d.showAddFeedPopup(onSubmit)
+}
+
+func (do *DisplayOperator) ShowDeleteFeedPopup(d *Display, feed *entity.Feed, onConfirm func()) {
+	d.showDeleteFeedPopup(feed, onConfirm)
+}
+
+func (do *DisplayOperator) ShowEditFeedPopup(
+	d *Display,
+	feed *entity.Feed,
+	onSubmit func(*entity.FeedEditOp),
+) {
+	d.showEditFeedPopup(feed, onSubmit)
+}
+
 func (do *DisplayOperator) ShowIntroPopup(d *Display) {
 	d.showPopup(introPageName)
 }
 
+func (do *DisplayOperator) StartSearch(d *Display) {
+	d.startSearch()
+}
+
+func (do *DisplayOperator) SwitchFeedsGrouping(d *Display, grouping FeedsGrouping) {
+	d.feedsPane.setGrouping(grouping)
+	d.infoEventf("%s", grouping.text(d.lang))
+}
+
 func (do *DisplayOperator) ToggleAboutPopup(d *Display, backend string) {
 	if name := d.frontPageName(); name == aboutPageName {
 		d.hidePopup(name)
@@ -127,6 +263,10 @@ func (do *DisplayOperator) ToggleAllFeedsFold(d *Display) {
 	d.feedsPane.toggleAllFeedsFold()
 }
 
+func (do *DisplayOperator) ToggleBookmarkedEntriesFilter(d *Display) {
+	d.entriesPane.toggleBookmarkedFilter()
+}
+
 func (do *DisplayOperator) ToggleCurrentFeedFold(d *Display) {
 	d.feedsPane.toggleCurrentFeedFold()
 }
@@ -139,6 +279,10 @@ func (do *DisplayOperator) ToggleHelpPopup(d *Display) {
 	}
 }
 
+func (do *DisplayOperator) ToggleStarredFeedsFilter(d *Display) {
+	d.feedsPane.toggleStarredFilter()
+}
+
 func (do *DisplayOperator) ToggleStatsPopup(d *Display, f func() (*entity.Stats, error)) {
 	if name := d.frontPageName(); name == statsPageName {
 		d.hidePopup(name)
@@ -152,6 +296,10 @@ func (do *DisplayOperator) ToggleStatusBar(d *Display) {
 	d.toggleStatusBar()
 }
 
+func (do *DisplayOperator) ToggleUnreadEntriesFilter(d *Display) {
+	d.entriesPane.toggleUnreadFilter()
+}
+
 func (do *DisplayOperator) UnfocusFront(d *Display) {
 	name := d.frontPageName()
 	if name == mainPageName || name == "" {
@@ -5,6 +5,8 @@ package ui
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -16,10 +18,40 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/reader/keymap"
 )
 
 const screenW, screenH = 210, 60
 
+func TestAddFeed(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	opr.AddFeed(
+		dsp,
+		func() (*entity.Feed, bool, error) { return nil, false, fmt.Errorf("nope") },
+		"https://a.com/feed.xml",
+	)
+	a.Empty(dsp.feedsPane.store.items)
+
+	feed := entity.Feed{ID: 2, Title: "Feed A", FeedURL: "https://a.com/feed.xml", Updated: &now}
+	opr.AddFeed(
+		dsp,
+		func() (*entity.Feed, bool, error) { return &feed, true, nil },
+		"https://a.com/feed.xml",
+	)
+	a.Eventually(
+		func() bool { return dsp.feedsPane.getFirstFeedNode() != nil },
+		2*time.Second,
+		100*time.Millisecond,
+	)
+	a.Equal(&feed, feedOf(dsp.feedsPane.getFirstFeedNode()))
+}
+
 func TestClearStatusBar(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +70,271 @@ func TestClearStatusBar(t *testing.T) {
 	r.Empty(w.GetText(true))
 }
 
+func TestDeleteFeed(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	entry := entity.Entry{ID: 3, FeedID: 1, Title: "Entry A1"}
+	feedA := entity.Feed{
+		ID:      1,
+		Title:   "Feed A",
+		Updated: &now,
+		Entries: map[entity.ID]*entity.Entry{3: &entry},
+	}
+	feedB := entity.Feed{ID: 2, Title: "Feed B", Updated: &yesterday}
+	dsp.feedsPane.store.upsert(&feedA)
+	dsp.feedsPane.store.upsert(&feedB)
+	dsp.feedsPane.refreshFeeds()
+	dsp.feedsPane.SetCurrentNode(dsp.feedsPane.getFirstFeedNode())
+	dsp.entriesPane.setFeed(&feedA)
+	dsp.readingPane.setEntry(&entry, feedA.Title)
+
+	opr.DeleteFeed(dsp, func() error { return fmt.Errorf("nope") }, &feedA)
+	a.Len(dsp.feedsPane.store.items, 2)
+	a.Len(dsp.entriesPane.store.all(), 1)
+
+	opr.DeleteFeed(dsp, func() error { return nil }, &feedA)
+	dsp.queueUpdateDraw(func() {
+		a.Len(dsp.feedsPane.store.items, 1)
+		a.Empty(dsp.entriesPane.store.all())
+		a.Nil(opr.GetCurrentEntry(dsp))
+		current := opr.GetCurrentFeed(dsp)
+		r.NotNil(current)
+		a.Equal(entity.ID(2), current.ID)
+	})
+}
+
+func TestDeleteFeedDuringPull(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	feedA := entity.Feed{ID: 1, Title: "Feed A", Updated: &now}
+	feedB := entity.Feed{ID: 2, Title: "Feed B", Updated: &yesterday}
+	dsp.queueUpdateDraw(func() {
+		dsp.feedsPane.store.upsert(&feedA)
+		dsp.feedsPane.refreshFeeds()
+	})
+
+	// The event loop is kept busy, so that the pulled feed is pending in it while the other feed
+	// is deleted. With the race detector enabled, this fails if the deletion is not applied in
+	// the event loop as well.
+	blocked, release := make(chan struct{}), make(chan struct{})
+	go dsp.inner.QueueUpdate(func() {
+		close(blocked)
+		<-release
+	})
+	<-blocked
+
+	// The deletion call takes a while, during which the pulled feed is queued. Waiting on the
+	// deletion would order it before the pulled feed, so it is given time instead.
+	deleted := make(chan struct{})
+	go func() {
+		defer close(deleted)
+		opr.DeleteFeed(
+			dsp,
+			func() error {
+				time.Sleep(50 * time.Millisecond)
+				return nil
+			},
+			&feedA,
+		)
+	}()
+	dsp.feedsCh <- &feedB
+	time.Sleep(150 * time.Millisecond)
+	close(release)
+	<-deleted
+
+	var ids []entity.ID
+	dsp.queueUpdateDraw(func() { ids = slices.Collect(maps.Keys(dsp.feedsPane.store.items)) })
+	a.Equal([]entity.ID{2}, ids)
+}
+
+func TestEditEntries(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	feed := entity.Feed{
+		ID:      1,
+		Title:   "Feed A",
+		Updated: &now,
+		Entries: map[entity.ID]*entity.Entry{
+			3: {ID: 3, FeedID: 1, Title: "Entry A1"},
+			5: {ID: 5, FeedID: 1, Title: "Entry A2"},
+		},
+	}
+	dsp.feedsPane.store.upsert(&feed)
+	dsp.feedsPane.refreshFeeds()
+	dsp.entriesPane.setFeed(&feed)
+
+	feedNodeText := func() string {
+		return dsp.feedsPane.GetRoot().GetChildren()[0].GetChildren()[0].GetText()
+	}
+	a.Equal("Feed A (2)", feedNodeText())
+
+	dsp.feedsPane.SetCurrentNode(dsp.feedsPane.getFirstFeedNode())
+	r.Len(opr.GetCurrentUnreadEntries(dsp), 2)
+
+	opr.EditEntries(dsp, func() ([]*entity.Entry, error) {
+		return []*entity.Entry{{ID: 3, FeedID: 1, Title: "Entry A1", IsRead: true}}, nil
+	})
+	a.Equal("Feed A (1)", feedNodeText())
+	r.Len(opr.GetCurrentUnreadEntries(dsp), 1)
+
+	row := slices.IndexFunc(dsp.entriesPane.store.all(), func(e *entity.Entry) bool {
+		return e.ID == 5
+	})
+	dsp.entriesPane.Select(row, 0)
+	selected := opr.GetSelectedEntry(dsp)
+	r.NotNil(selected)
+	a.Equal(entity.ID(5), selected.ID)
+
+	opr.EditEntries(dsp, func() ([]*entity.Entry, error) {
+		return []*entity.Entry{
+			{ID: selected.ID, FeedID: 1, Title: selected.Title, IsRead: true, IsBookmarked: true},
+		}, nil
+	})
+	a.Equal("Feed A", feedNodeText())
+	a.Empty(opr.GetCurrentUnreadEntries(dsp))
+	a.True(opr.GetSelectedEntry(dsp).IsBookmarked)
+	a.Contains(dsp.entriesPane.GetCell(row, 0).Text, bookmarkMarker)
+}
+
+func TestEditEntriesDuringPull(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	pulledFeed := func(isRead bool) *entity.Feed {
+		return &entity.Feed{
+			ID:      1,
+			Title:   "Feed A",
+			Updated: &now,
+			Entries: map[entity.ID]*entity.Entry{
+				3: {ID: 3, FeedID: 1, Title: "Entry A1", IsRead: isRead},
+				5: {ID: 5, FeedID: 1, Title: "Entry A2", IsRead: isRead},
+			},
+		}
+	}
+	dsp.feedsCh <- pulledFeed(false)
+
+	// Pulled feeds and edited entries are applied to the same feed, so with the race detector
+	// enabled, this fails if they are not applied in the event loop.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for range 50 {
+			dsp.feedsCh <- pulledFeed(false)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range 50 {
+			opr.EditEntries(dsp, func() ([]*entity.Entry, error) {
+				return []*entity.Entry{{ID: 3, FeedID: 1, Title: "Entry A1", IsRead: true}}, nil
+			})
+		}
+	}()
+	wg.Wait()
+
+	dsp.feedsCh <- pulledFeed(true)
+
+	feedNodeText := func() string {
+		var text string
+		dsp.queueUpdateDraw(func() { text = dsp.feedsPane.getFirstFeedNode().GetText() })
+		return text
+	}
+	a.Eventually(
+		func() bool { return feedNodeText() == "Feed A" },
+		2*time.Second,
+		100*time.Millisecond,
+	)
+}
+
+func TestEditFeeds(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	feed := entity.Feed{
+		ID:      1,
+		Title:   "Feed A",
+		Updated: &now,
+		Entries: map[entity.ID]*entity.Entry{3: {ID: 3, FeedID: 1, Title: "Entry A1"}},
+	}
+	dsp.feedsPane.store.upsert(&feed)
+	dsp.feedsPane.refreshFeeds()
+
+	feedNodeText := func() string {
+		return dsp.feedsPane.getFirstFeedNode().GetText()
+	}
+	a.Equal("Feed A (1)", feedNodeText())
+
+	opr.EditFeeds(dsp, func() ([]*entity.Feed, error) { return nil, fmt.Errorf("nope") })
+	a.Equal("Feed A (1)", feedNodeText())
+
+	opr.EditFeeds(dsp, func() ([]*entity.Feed, error) {
+		return []*entity.Feed{{ID: 1, Title: "Feed B", Updated: &now, IsStarred: true}}, nil
+	})
+	a.Eventually(
+		func() bool { return feedNodeText() == "Feed B (1)" },
+		2*time.Second,
+		100*time.Millisecond,
+	)
+	a.True(feed.IsStarred)
+}
+
+func TestExtractEntryContent(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	entry := entity.Entry{ID: 3, FeedID: 1, Title: "Entry A", Content: pointer("Truncated")}
+	dsp.readingPane.setEntry(&entry, "Feed A")
+
+	opr.ExtractEntryContent(
+		dsp,
+		func() (*entity.Entry, error) { return nil, fmt.Errorf("nope") },
+		&entry,
+	)
+	a.Equal(&entry, opr.GetCurrentEntry(dsp))
+
+	extracted := entity.Entry{ID: 3, FeedID: 1, Title: "Entry A", Content: pointer("Full")}
+	opr.ExtractEntryContent(
+		dsp,
+		func() (*entity.Entry, error) { return &extracted, nil },
+		&entry,
+	)
+	current := opr.GetCurrentEntry(dsp)
+	r.NotNil(current)
+	a.Equal(pointer("Full"), current.Content)
+	a.Contains(dsp.readingPane.GetText(true), "Full")
+}
+
 func TestFocusEntriesPane(t *testing.T) {
 	t.Parallel()
 
@@ -221,6 +518,154 @@ func TestPopulateFeedsPaneOk(t *testing.T) {
 	a.Len(feedNodes(), 4)
 }
 
+func TestSelectNextMatch(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	feed := entity.Feed{
+		ID:      1,
+		Title:   "Feed A",
+		Updated: &now,
+		Entries: map[entity.ID]*entity.Entry{
+			3: {ID: 3, FeedID: 1, Title: "Go 1", Published: &yesterday},
+			5: {ID: 5, FeedID: 1, Title: "Rust", Published: &twoWeeksAgo},
+			7: {ID: 7, FeedID: 1, Title: "Go 2", Published: &threeDaysAgo},
+		},
+	}
+	dsp.entriesPane.setFeed(&feed)
+	opr.FocusEntriesPane(dsp)
+
+	selectedTitle := func() string { return opr.GetSelectedEntry(dsp).Title }
+
+	// Without a query, there are no matches to select.
+	dsp.entriesPane.Select(0, 0)
+	first := selectedTitle()
+	opr.SelectNextMatch(dsp)
+	a.Equal(first, selectedTitle())
+
+	dsp.entriesPane.setQuery("go")
+	a.Len(dsp.entriesPane.shown, 2)
+
+	dsp.entriesPane.Select(0, 0)
+	first = selectedTitle()
+	opr.SelectNextMatch(dsp)
+	second := selectedTitle()
+	a.NotEqual(first, second)
+	opr.SelectNextMatch(dsp)
+	a.Equal(first, selectedTitle())
+	opr.SelectPreviousMatch(dsp)
+	a.Equal(second, selectedTitle())
+}
+
+func TestShowAddFeedPopup(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	var (
+		submittedURL  string
+		submittedTags []string
+	)
+	opr.ShowAddFeedPopup(dsp, func(feedURL string, tags []string) {
+		submittedURL = feedURL
+		submittedTags = tags
+	})
+	a.Equal(formPageName, dsp.frontPageName())
+
+	form, ok := dsp.formPopup.content.(*tview.Form)
+	r.True(ok)
+	form.GetFormItem(1).(*tview.InputField).SetText("x, y,,x")
+
+	// Feeds without URLs are not submitted.
+	pressButton(form, langEN.addButton)
+	a.Equal(formPageName, dsp.frontPageName())
+	a.Empty(submittedURL)
+
+	form.GetFormItem(0).(*tview.InputField).SetText(" https://a.com/feed.xml ")
+	pressButton(form, langEN.addButton)
+	a.Equal(mainPageName, dsp.frontPageName())
+	a.Equal("https://a.com/feed.xml", submittedURL)
+	a.Equal([]string{"x", "y"}, submittedTags)
+}
+
+func TestShowDeleteFeedPopup(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	var confirmed bool
+	feed := entity.Feed{ID: 1, Title: "Feed A"}
+
+	opr.ShowDeleteFeedPopup(dsp, &feed, func() { confirmed = true })
+	a.Equal(formPageName, dsp.frontPageName())
+
+	content, ok := dsp.formPopup.content.(*tview.Flex)
+	r.True(ok)
+	form, ok := content.GetItem(2).(*tview.Form)
+	r.True(ok)
+
+	pressButton(form, langEN.cancelButton)
+	a.Equal(mainPageName, dsp.frontPageName())
+	a.False(confirmed)
+
+	opr.ShowDeleteFeedPopup(dsp, &feed, func() { confirmed = true })
+	content, ok = dsp.formPopup.content.(*tview.Flex)
+	r.True(ok)
+	form, ok = content.GetItem(2).(*tview.Form)
+	r.True(ok)
+
+	pressButton(form, langEN.deleteButton)
+	a.Equal(mainPageName, dsp.frontPageName())
+	a.True(confirmed)
+}
+
+func TestShowEditFeedPopup(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	var submitted *entity.FeedEditOp
+	feed := entity.Feed{ID: 1, Title: "Feed A", Tags: []string{"x", "y"}}
+
+	opr.ShowEditFeedPopup(dsp, &feed, func(op *entity.FeedEditOp) { submitted = op })
+	a.Equal(formPageName, dsp.frontPageName())
+
+	form, ok := dsp.formPopup.content.(*tview.Form)
+	r.True(ok)
+	a.Equal("Feed A", form.GetFormItem(0).(*tview.InputField).GetText())
+	a.Equal("", form.GetFormItem(1).(*tview.InputField).GetText())
+	a.Equal("x, y", form.GetFormItem(2).(*tview.InputField).GetText())
+	a.False(form.GetFormItem(3).(*tview.Checkbox).IsChecked())
+
+	form.GetFormItem(0).(*tview.InputField).SetText("Feed B")
+	form.GetFormItem(3).(*tview.Checkbox).SetChecked(true)
+	pressButton(form, langEN.saveButton)
+
+	a.Equal(mainPageName, dsp.frontPageName())
+	r.NotNil(submitted)
+	a.Equal(entity.ID(1), submitted.ID)
+	a.Equal(pointer("Feed B"), submitted.Title)
+	a.Nil(submitted.Description)
+	a.Equal(&[]string{"x", "y"}, submitted.Tags)
+	a.Equal(pointer(true), submitted.IsStarred)
+}
+
 func TestShowIntroPopup(t *testing.T) {
 	t.Parallel()
 
@@ -243,6 +688,167 @@ func TestShowIntroPopup(t *testing.T) {
 	r.Equal(dsp.mainPage, item)
 }
 
+func TestStartSearch(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	feedTitles := func() []string {
+		titles := make([]string, 0)
+		for _, gnode := range dsp.feedsPane.GetRoot().GetChildren() {
+			for _, fnode := range gnode.GetChildren() {
+				titles = append(titles, feedOf(fnode).Title)
+			}
+		}
+		return titles
+	}
+
+	dsp.feedsPane.store.upsert(&entity.Feed{ID: 1, Title: "Go blog", Updated: &now})
+	dsp.feedsPane.store.upsert(
+		&entity.Feed{ID: 2, Title: "Feed B", Tags: []string{"golang"}, Updated: &yesterday},
+	)
+	dsp.feedsPane.store.upsert(&entity.Feed{ID: 3, Title: "Feed C", Updated: &twoWeeksAgo})
+	dsp.feedsPane.refreshFeeds()
+	opr.FocusFeedsPane(dsp)
+
+	// The query is cleared when the search is escaped.
+	opr.StartSearch(dsp)
+	prompt := dsp.searchPrompt
+	r.NotNil(prompt)
+	a.Equal(prompt, dsp.inner.GetFocus())
+	prompt.SetText("GO")
+	a.ElementsMatch([]string{"Go blog", "Feed B"}, feedTitles())
+
+	prompt.InputHandler()(tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone), nil)
+	a.Nil(dsp.searchPrompt)
+	a.Equal(dsp.feedsPane, dsp.inner.GetFocus())
+	a.Empty(dsp.feedsPane.getQuery())
+	a.Len(feedTitles(), 3)
+
+	// The query is kept when the search is entered.
+	opr.StartSearch(dsp)
+	prompt = dsp.searchPrompt
+	r.NotNil(prompt)
+	prompt.SetText("feed c")
+	prompt.InputHandler()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), nil)
+	a.Nil(dsp.searchPrompt)
+	a.True(dsp.barVisible)
+	a.Equal(dsp.feedsPane, dsp.inner.GetFocus())
+	a.Equal("feed c", dsp.feedsPane.getQuery())
+	a.Equal([]string{"Feed C"}, feedTitles())
+	a.Equal("Feeds (/feed c)", filteredTitle("Feeds", dsp.feedsPane.filter.labels(dsp.lang)))
+}
+
+func TestSwitchFeedsGrouping(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	unread := func(ids ...entity.ID) map[entity.ID]*entity.Entry {
+		entries := make(map[entity.ID]*entity.Entry)
+		for _, id := range ids {
+			entries[id] = &entity.Entry{ID: id}
+		}
+		return entries
+	}
+	feeds := []*entity.Feed{
+		{
+			ID:      1,
+			Title:   "feed b",
+			Tags:    []string{"news", "Go"},
+			Updated: &now,
+			Entries: unread(11, 12),
+		},
+		{ID: 2, Title: "Feed A", IsStarred: true, Updated: &twoWeeksAgo, Entries: unread(21)},
+		{ID: 3, Title: "Feed C", Tags: []string{"go"}, Updated: &yesterday, Entries: unread()},
+	}
+	for _, feed := range feeds {
+		dsp.feedsPane.store.upsert(feed)
+	}
+
+	type group struct {
+		label  string
+		feeds  []entity.ID
+		unread int
+	}
+	groups := func() []group {
+		gs := make([]group, 0)
+		for _, gnode := range dsp.feedsPane.GetRoot().GetChildren() {
+			g := group{label: string(*groupOf(gnode)), unread: countGroupUnread(gnode)}
+			for _, fnode := range gnode.GetChildren() {
+				g.feeds = append(g.feeds, feedOf(fnode).ID)
+			}
+			gs = append(gs, g)
+		}
+		return gs
+	}
+
+	a.Equal(GroupByPeriod, opr.GetFeedsGrouping(dsp))
+	opr.SwitchFeedsGrouping(dsp, GroupByTag)
+	a.Equal(GroupByTag, opr.GetFeedsGrouping(dsp))
+	a.Equal(
+		[]group{
+			{label: "Go", feeds: []entity.ID{1}, unread: 2},
+			{label: "go", feeds: []entity.ID{3}, unread: 0},
+			{label: "news", feeds: []entity.ID{1}, unread: 2},
+			{label: langEN.untaggedGroupText, feeds: []entity.ID{2}, unread: 1},
+		},
+		groups(),
+	)
+
+	// The current feed stays selected in its first group.
+	dsp.feedsPane.SetCurrentNode(dsp.feedsPane.GetRoot().GetChildren()[2].GetChildren()[0])
+	current := dsp.feedsPane.getCurrentGroupNode()
+	r.NotNil(current)
+	a.Equal(groupLabel("news"), *groupOf(current))
+
+	opr.SwitchFeedsGrouping(dsp, GroupByStarred)
+	a.Equal(
+		[]group{
+			{label: langEN.starredGroupText, feeds: []entity.ID{2}, unread: 1},
+			{label: langEN.unstarredGroupText, feeds: []entity.ID{1, 3}, unread: 2},
+		},
+		groups(),
+	)
+	a.Equal(entity.ID(1), dsp.feedsPane.getCurrentFeed().ID)
+
+	opr.SetFeedsGrouping(dsp, GroupFlat)
+	a.Equal(
+		[]group{{label: langEN.allFeedsGroupText, feeds: []entity.ID{2, 1, 3}, unread: 3}},
+		groups(),
+	)
+
+	opr.SwitchFeedsGrouping(dsp, GroupByPeriod)
+	a.Len(groups(), 3)
+}
+
+func TestFeedsGrouping(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	grouping := GroupByPeriod
+	for _, name := range []string{"tag", "starred", "flat", "period"} {
+		grouping = grouping.Next()
+		a.Equal(name, grouping.String())
+
+		parsed, err := ParseFeedsGrouping(name)
+		a.NoError(err)
+		a.Equal(grouping, parsed)
+	}
+
+	_, err := ParseFeedsGrouping("")
+	a.EqualError(err, `feeds grouping "" does not exist`)
+}
+
 func TestToggleAboutPopup(t *testing.T) {
 	t.Parallel()
 
@@ -284,6 +890,56 @@ func TestToggleAboutPopup(t *testing.T) {
 	a.Contains(c2.GetText(true), bn2)
 }
 
+func TestToggleEntriesFilters(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	feed := entity.Feed{
+		ID:      1,
+		Title:   "Feed A",
+		Updated: &now,
+		Entries: map[entity.ID]*entity.Entry{
+			3: {ID: 3, FeedID: 1, Title: "Entry A1", IsRead: true, IsBookmarked: true},
+			5: {ID: 5, FeedID: 1, Title: "Entry A2"},
+			7: {ID: 7, FeedID: 1, Title: "Entry A3", IsBookmarked: true},
+		},
+	}
+	dsp.entriesPane.setFeed(&feed)
+
+	shownIDs := func() []entity.ID {
+		ids := make([]entity.ID, 0)
+		for _, entry := range dsp.entriesPane.shown {
+			ids = append(ids, entry.ID)
+		}
+		return ids
+	}
+	a.ElementsMatch([]entity.ID{3, 5, 7}, shownIDs())
+
+	opr.ToggleUnreadEntriesFilter(dsp)
+	a.ElementsMatch([]entity.ID{5, 7}, shownIDs())
+
+	opr.ToggleBookmarkedEntriesFilter(dsp)
+	a.ElementsMatch([]entity.ID{7}, shownIDs())
+	a.Equal(
+		[]string{langEN.unreadFilterLabel, langEN.bookmarkedFilterLabel},
+		dsp.entriesPane.filter.labels(dsp.lang),
+	)
+
+	// Filters persist across feeds.
+	dsp.entriesPane.setFeed(&feed)
+	a.ElementsMatch([]entity.ID{7}, shownIDs())
+
+	opr.ToggleUnreadEntriesFilter(dsp)
+	a.ElementsMatch([]entity.ID{3, 7}, shownIDs())
+
+	opr.ToggleBookmarkedEntriesFilter(dsp)
+	a.ElementsMatch([]entity.ID{3, 5, 7}, shownIDs())
+}
+
 func TestToggleHelpPopup(t *testing.T) {
 	t.Parallel()
 
@@ -308,6 +964,68 @@ func TestToggleHelpPopup(t *testing.T) {
 	r.Equal(dsp.mainPage, item)
 }
 
+func TestSetKeymap(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	_, _, dsp := setupDisplayOperatorTest(t)
+
+	popupText := func(p *popup) string {
+		textView, ok := p.content.(*tview.TextView)
+		r.True(ok)
+		return textView.GetText(true)
+	}
+
+	helpText := popupText(dsp.helpPopup)
+	a.Contains(helpText, "Feeds pane\nj: Next item\nk: Previous item\n")
+	a.Contains(helpText, "\nP: Pull all feeds\n")
+	a.Contains(helpText, "\n?,H     : Toggle this help\n")
+	a.Contains(helpText, "Set focus to reading pane")
+	a.Contains(popupText(dsp.introPopup), "For help, press ? or go to")
+
+	km, err := keymap.Default().Override(map[string][]string{
+		"pull-all":      {"ctrl-r"},
+		"focus-reading": {},
+		"toggle-help":   {"f1"},
+	})
+	r.NoError(err)
+	dsp.SetKeymap(km)
+
+	helpText = popupText(dsp.helpPopup)
+	a.Contains(helpText, "\nCtrl-R: Pull all feeds\n")
+	a.Contains(helpText, "\nF1      : Toggle this help\n")
+	a.NotContains(helpText, "Set focus to reading pane")
+	a.Contains(popupText(dsp.introPopup), "For help, press F1 or go to")
+}
+
+func TestToggleStarredFeedsFilter(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	dsp.feedsPane.store.upsert(&entity.Feed{ID: 1, Title: "Feed A", Updated: &now})
+	dsp.feedsPane.store.upsert(
+		&entity.Feed{ID: 2, Title: "Feed B", IsStarred: true, Updated: &twoWeeksAgo},
+	)
+	dsp.feedsPane.refreshFeeds()
+	a.Len(dsp.feedsPane.GetRoot().GetChildren(), 2)
+	dsp.feedsPane.SetCurrentNode(dsp.feedsPane.getFirstFeedNode())
+	a.Equal(entity.ID(1), dsp.feedsPane.getCurrentFeed().ID)
+
+	// The selection moves away from feeds that are filtered out.
+	opr.ToggleStarredFeedsFilter(dsp)
+	groups := dsp.feedsPane.GetRoot().GetChildren()
+	a.Len(groups, 1)
+	a.Equal(entity.ID(2), dsp.feedsPane.getCurrentFeed().ID)
+
+	opr.ToggleStarredFeedsFilter(dsp)
+	a.Len(dsp.feedsPane.GetRoot().GetChildren(), 2)
+}
+
 func TestToggleStatsPopup(t *testing.T) {
 	t.Parallel()
 
@@ -438,6 +1156,12 @@ func setupDisplayOperatorTest(t *testing.T) (
 	return drawf, NewDisplayOperator(), dsp
 }
 
+// pressButton presses the button of the given form with the given label.
+func pressButton(form *tview.Form, label string) {
+	button := form.GetButton(form.GetButtonIndex(label))
+	button.InputHandler()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), nil)
+}
+
 func newTestDisplay(t *testing.T, screen tcell.Screen) *Display {
 	t.Helper()
 
@@ -448,6 +1172,8 @@ func newTestDisplay(t *testing.T, screen tcell.Screen) *Display {
 	dsp.SetHandlers(
 		func(ek *tcell.EventKey) *tcell.EventKey { return ek },
 		func(ek *tcell.EventKey) *tcell.EventKey { return ek },
+		func(ek *tcell.EventKey) *tcell.EventKey { return ek },
+		func(ek *tcell.EventKey) *tcell.EventKey { return ek },
 	)
 	return dsp
 }
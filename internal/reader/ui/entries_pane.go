@@ -18,7 +18,11 @@ type entriesPane struct {
 	theme *Theme
 	lang  *Lang
 
-	store *entriesStore
+	store     *entriesStore
+	filter    entriesFilter
+	shown     []*entity.Entry
+	feedID    entity.ID
+	feedTitle string
 
 	readingPane *readingPane
 }
@@ -43,16 +47,38 @@ func newEntriesPane(theme *Theme, lang *Lang, rp *readingPane) *entriesPane {
 	return &ep
 }
 
-func (ep *entriesPane) setEntries(entries []*entity.Entry) {
-	ep.store.set(entries)
+// setFeed lists the entries of the given feed.
+func (ep *entriesPane) setFeed(feed *entity.Feed) {
+	ep.feedID = feed.ID
+	ep.feedTitle = feed.Title
+	ep.store.set(feed.EntriesSlice())
 	ep.refreshEntries()
 }
 
+// removeFeed clears the pane if it lists the entries of the feed with the given ID.
+func (ep *entriesPane) removeFeed(id entity.ID) {
+	if ep.feedID != id {
+		return
+	}
+	ep.feedID = 0
+	ep.feedTitle = ""
+	ep.store.set(make([]*entity.Entry, 0))
+	ep.refreshEntries()
+}
+
+// refreshEntries lists the entries that pass the current filter.
 func (ep *entriesPane) refreshEntries() {
 	rowf := ep.makeRowFuncs()
 
+	ep.shown = make([]*entity.Entry, 0)
+	for _, entry := range ep.store.all() {
+		if ep.filter.matches(entry) {
+			ep.shown = append(ep.shown, entry)
+		}
+	}
+
 	ep.Clear()
-	for i, entry := range ep.store.all() {
+	for i, entry := range ep.shown {
 
 		colIdx := 0
 		addCell := func(cell *tview.TableCell) {
@@ -69,6 +95,83 @@ func (ep *entriesPane) refreshEntries() {
 	}
 }
 
+// refreshEntry replaces the listed entry with the same ID as the given updated entry, keeping the
+// current selection. The entry stays listed even if it no longer passes the filter, until the
+// entries are refreshed.
+func (ep *entriesPane) refreshEntry(entry *entity.Entry) {
+	for i, item := range ep.store.all() {
+		if item.ID == entry.ID {
+			ep.store.items[i] = entry
+		}
+	}
+	for i, item := range ep.shown {
+		if item.ID != entry.ID {
+			continue
+		}
+		ep.shown[i] = entry
+		ep.setRow(i, ep.makeRowFuncs(), entry)
+	}
+}
+
+func (ep *entriesPane) refreshColors() {
+	rowf := ep.makeRowFuncs()
+	for i, entry := range ep.shown {
+		ep.setRow(i, rowf, entry)
+	}
+}
+
+func (ep *entriesPane) getQuery() string {
+	return ep.filter.query
+}
+
+// setQuery lists only the entries whose titles contain the given query.
+func (ep *entriesPane) setQuery(query string) {
+	ep.filter.query = query
+	ep.refreshEntries()
+}
+
+func (ep *entriesPane) toggleUnreadFilter() {
+	ep.filter.unreadOnly = !ep.filter.unreadOnly
+	ep.refreshEntries()
+}
+
+func (ep *entriesPane) toggleBookmarkedFilter() {
+	ep.filter.bookmarkedOnly = !ep.filter.bookmarkedOnly
+	ep.refreshEntries()
+}
+
+// selectMatch moves the selection to the next listed entry matching the current query, or to the
+// previous one if reverse is true.
+func (ep *entriesPane) selectMatch(reverse bool) {
+	if ep.filter.query == "" || len(ep.shown) == 0 {
+		return
+	}
+	row, _ := ep.GetSelection()
+	ep.Select(adjacentIndex(row, len(ep.shown), reverse), 0)
+}
+
+func (ep *entriesPane) setRow(
+	row int,
+	rowf func(*entity.Entry) []*tview.TableCell,
+	entry *entity.Entry,
+) {
+	for j, cell := range rowf(entry) {
+		cell.SetReference(entry)
+		ep.SetCell(row, j, cell)
+	}
+}
+
+// getSelectedEntry returns the entry in the selected row, if any.
+func (ep *entriesPane) getSelectedEntry() *entity.Entry {
+	row, _ := ep.GetSelection()
+	cell := ep.GetCell(row, 0)
+	if cell == nil {
+		return nil
+	}
+	entry, _ := cell.GetReference().(*entity.Entry)
+	return entry
+}
+
 func (ep *entriesPane) initTable() {
 	table := tview.NewTable().SetSelectable(true, false)
 
@@ -76,7 +179,7 @@ func (ep *entriesPane) initTable() {
 		func(row, column int) {
 			entry, ok := table.GetCell(row, column).GetReference().(*entity.Entry)
 			if ok {
-				ep.readingPane.setEntry(entry)
+				ep.readingPane.setEntry(entry, ep.feedTitle)
 			}
 		},
 	)
@@ -104,7 +207,11 @@ func (ep *entriesPane) makeRowFuncs() func(*entity.Entry) []*tview.TableCell {
 
 	return func(entry *entity.Entry) []*tview.TableCell {
 
-		titleCol := tview.NewTableCell(fmt.Sprintf("%-*s", titleW, entry.Title)).
+		title := entry.Title
+		if entry.IsBookmarked {
+			title = bookmarkMarker + title
+		}
+		titleCol := tview.NewTableCell(fmt.Sprintf("%-*s", titleW, title)).
 			SetAlign(tview.AlignLeft).
 			SetMaxWidth(titleW)
 
@@ -120,27 +227,39 @@ func (ep *entriesPane) makeRowFuncs() func(*entity.Entry) []*tview.TableCell {
 			SetAlign(tview.AlignRight).
 			SetMaxWidth(timeW)
 
-		return []*tview.TableCell{titleCol, pubDateCol}
+		cells := []*tview.TableCell{titleCol, pubDateCol}
+		for _, cell := range cells {
+			if entry.IsRead {
+				cell.SetTextColor(ep.theme.entryReadFG)
+			} else {
+				cell.SetTextColor(ep.theme.entryUnreadFG).SetAttributes(tcell.AttrBold)
+			}
+		}
+
+		return cells
 	}
 }
 
+// bookmarkMarker precedes the titles of bookmarked entries.
+const bookmarkMarker = "★ "
+
 // nolint:dupl
 func (ep *entriesPane) makeDrawFuncs() (focusf, unfocusf drawFunc) {
 
-	titleUF, titleF := fmtPaneTitle(ep.lang.entriesPaneTitle)
-
 	drawf := func(
 		focused bool,
 	) func(screen tcell.Screen, x int, y int, width int, height int) (int, int, int, int) {
 
-		var title string
-		if focused {
-			title = titleF
-		} else {
-			title = titleUF
-		}
-
 		return func(screen tcell.Screen, x int, y int, width int, height int) (int, int, int, int) {
+			// The title shows the active filters, so it is formatted anew on each draw.
+			titleUF, titleF := fmtPaneTitle(
+				filteredTitle(ep.lang.entriesPaneTitle, ep.filter.labels(ep.lang)),
+			)
+			title := titleUF
+			if focused {
+				title = titleF
+			}
+
 			style := ep.theme.lineStyle()
 			// Draw top and optionally bottom borders.
 			for cx := x; cx < x+width; cx++ {
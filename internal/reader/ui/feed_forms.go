@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// formPopupWidth is the width of form popups, including their borders.
+const formPopupWidth = 72
+
+// showAddFeedPopup shows the form for adding a feed. The given function is called with the URL
+// and tags of the feed when the form is submitted.
+func (d *Display) showAddFeedPopup(onSubmit func(feedURL string, tags []string)) {
+	urlField := d.newInputField(d.lang.urlLabel, "")
+	tagsField := d.newInputField(d.lang.tagsLabel, "").
+		SetPlaceholder(d.lang.tagsPlaceholder)
+
+	form := d.newForm().
+		AddFormItem(urlField).
+		AddFormItem(tagsField)
+
+	form.
+		AddButton(d.lang.addButton, func() {
+			feedURL := strings.TrimSpace(urlField.GetText())
+			if feedURL == "" {
+				d.warnEventf("Feed URL must not be empty")
+				return
+			}
+			d.hidePopup(formPageName)
+			onSubmit(feedURL, parseTags(tagsField.GetText()))
+		}).
+		AddButton(d.lang.cancelButton, func() { d.hidePopup(formPageName) })
+
+	d.showFormPopup(
+		d.lang.addFeedPopupTitle,
+		form,
+		form,
+		formPopupHeight(form.GetFormItemCount()),
+	)
+}
+
+// showEditFeedPopup shows the form for editing the title, description, tags, and starred status
+// of the given feed. The given function is called with the edits when the form is submitted. The
+// edits always contain the tags, as they are replaced in full.
+func (d *Display) showEditFeedPopup(feed *entity.Feed, onSubmit func(*entity.FeedEditOp)) {
+	var desc string
+	if feed.Description != nil {
+		desc = *feed.Description
+	}
+
+	titleField := d.newInputField(d.lang.titleLabel, feed.Title)
+	descField := d.newInputField(d.lang.descriptionLabel, desc)
+	tagsField := d.newInputField(d.lang.tagsLabel, strings.Join(feed.Tags, ", ")).
+		SetPlaceholder(d.lang.tagsPlaceholder)
+	starredField := tview.NewCheckbox().
+		SetLabel(d.lang.starredLabel).
+		SetChecked(feed.IsStarred)
+
+	form := d.newForm().
+		AddFormItem(titleField).
+		AddFormItem(descField).
+		AddFormItem(tagsField).
+		AddFormItem(starredField)
+
+	form.
+		AddButton(d.lang.saveButton, func() {
+			title := strings.TrimSpace(titleField.GetText())
+			if title == "" {
+				d.warnEventf("Feed title must not be empty")
+				return
+			}
+			var (
+				tags      = parseTags(tagsField.GetText())
+				isStarred = starredField.IsChecked()
+				op        = entity.FeedEditOp{
+					ID:        feed.ID,
+					Title:     &title,
+					Tags:      &tags,
+					IsStarred: &isStarred,
+				}
+			)
+			// An absent description is left as it is, unless one is entered.
+			if desc := strings.TrimSpace(descField.GetText()); feed.Description != nil || desc != "" {
+				op.Description = &desc
+			}
+			d.hidePopup(formPageName)
+			onSubmit(&op)
+		}).
+		AddButton(d.lang.cancelButton, func() { d.hidePopup(formPageName) })
+
+	d.showFormPopup(
+		d.lang.editFeedPopupTitle,
+		form,
+		form,
+		formPopupHeight(form.GetFormItemCount()),
+	)
+}
+
+// showDeleteFeedPopup asks for confirmation before deleting the given feed. The given function is
+// called when the deletion is confirmed.
+func (d *Display) showDeleteFeedPopup(feed *entity.Feed, onConfirm func()) {
+	text := fmt.Sprintf(d.lang.deleteFeedText, feed.Title)
+
+	textView := tview.NewTextView().
+		SetWordWrap(true).
+		SetText(text)
+
+	form := d.newForm()
+	form.
+		AddButton(d.lang.deleteButton, func() {
+			d.hidePopup(formPageName)
+			onConfirm()
+		}).
+		AddButton(d.lang.cancelButton, func() { d.hidePopup(formPageName) })
+	// Deletion can not be undone, so it is not the default.
+	form.SetFocus(1)
+
+	textWidth := formPopupWidth - leftPopupMargin - rightPopupMargin - 2
+	textRows := (len(text) + textWidth - 1) / textWidth
+
+	content := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(textView, textRows, 0, false).
+		AddItem(nil, 1, 0, false).
+		AddItem(form, 1, 0, true)
+
+	d.showFormPopup(
+		d.lang.deleteFeedPopupTitle,
+		content,
+		form,
+		textRows+2+verticalPopupPadding,
+	)
+}
+
+// showFormPopup shows the given content in the form popup, and focuses its form.
+func (d *Display) showFormPopup(
+	title string,
+	content tview.Primitive,
+	form *tview.Form,
+	height int,
+) {
+	d.formPopup.setTitle(title)
+	d.formPopup.setWidth(formPopupWidth)
+	d.formPopup.setHeight(height)
+	d.formPopup.setContent(content)
+	d.switchPopup(formPageName, d.frontPageName())
+	d.inner.SetFocus(form)
+}
+
+func (d *Display) newForm() *tview.Form {
+	form := tview.NewForm().
+		SetLabelColor(d.theme.formLabelFG).
+		SetFieldStyle(
+			tcell.StyleDefault.Foreground(d.theme.formFieldFG).Background(d.theme.formFieldBG),
+		).
+		SetButtonStyle(
+			tcell.StyleDefault.Foreground(d.theme.formButtonFG).Background(d.theme.formButtonBG),
+		).
+		SetButtonActivatedStyle(
+			tcell.StyleDefault.
+				Foreground(d.theme.formButtonFG).
+				Background(d.theme.formButtonFocusBG),
+		).
+		SetCancelFunc(func() { d.hidePopup(formPageName) })
+
+	form.SetBorderPadding(0, 0, 0, 0)
+
+	return form
+}
+
+func (d *Display) newInputField(label, text string) *tview.InputField {
+	return tview.NewInputField().
+		SetLabel(label).
+		SetText(text).
+		SetPlaceholderStyle(
+			tcell.StyleDefault.Foreground(d.theme.contentDimFG).Background(d.theme.formFieldBG),
+		)
+}
+
+// formPopupHeight returns the height of a form popup with the given number of items, each of them
+// one row high and separated by a blank row from the next, followed by a row of buttons.
+func formPopupHeight(numItems int) int {
+	return 2*numItems + 1 + verticalPopupPadding
+}
+
+// parseTags returns the tags in the given comma-separated text, without duplicates.
+func parseTags(text string) []string {
+	tags := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, item := range strings.Split(text, ",") {
+		tag := strings.TrimSpace(item)
+		if tag == "" {
+			continue
+		}
+		if _, exists := seen[tag]; exists {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+	}
+	return tags
+}
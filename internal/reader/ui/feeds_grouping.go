@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+// FeedsGrouping is the way feeds are grouped in the feeds pane.
+type FeedsGrouping uint8
+
+const (
+	// GroupByPeriod groups feeds by how recently they were updated.
+	GroupByPeriod FeedsGrouping = iota
+	// GroupByTag groups feeds by their tags. Feeds with several tags are listed under each of them.
+	GroupByTag
+	// GroupByStarred groups feeds by whether they are starred.
+	GroupByStarred
+	// GroupFlat lists all feeds in a single group, in alphabetical order.
+	GroupFlat
+)
+
+var feedsGroupingNames = []string{"period", "tag", "starred", "flat"}
+
+func (g FeedsGrouping) String() string {
+	if int(g) < len(feedsGroupingNames) {
+		return feedsGroupingNames[g]
+	}
+	return fmt.Sprintf("FeedsGrouping(%d)", g)
+}
+
+// Next returns the grouping that follows the given one when switching between them.
+func (g FeedsGrouping) Next() FeedsGrouping {
+	return FeedsGrouping((int(g) + 1) % len(feedsGroupingNames))
+}
+
+// ParseFeedsGrouping returns the grouping with the given name.
+func ParseFeedsGrouping(name string) (FeedsGrouping, error) {
+	idx := slices.Index(feedsGroupingNames, name)
+	if idx < 0 {
+		return GroupByPeriod, fmt.Errorf("feeds grouping %q does not exist", name)
+	}
+	return FeedsGrouping(idx), nil // #nosec: G115
+}
+
+func (g FeedsGrouping) text(lang *Lang) string {
+	switch g {
+	case GroupByTag:
+		return lang.groupByTagText
+	case GroupByStarred:
+		return lang.groupByStarredText
+	case GroupFlat:
+		return lang.groupFlatText
+	default:
+		return lang.groupByPeriodText
+	}
+}
+
+func (fp *feedsPane) setGrouping(grouping FeedsGrouping) {
+	fp.grouping = grouping
+	fp.refreshFeeds()
+}
+
+// feedsBy returns the feeds in the store grouped in the given way, with the feeds of each group in
+// the order they are listed.
+func (lfs *feedStore) feedsBy(grouping FeedsGrouping, lang *Lang) []feedGroup[string] {
+	switch grouping {
+	case GroupByTag:
+		return labelGroups(lfs.feedsByTag(), func(tag string) string {
+			if tag == "" {
+				return lang.untaggedGroupText
+			}
+			return tag
+		})
+	case GroupByStarred:
+		return labelGroups(lfs.feedsByStarred(), func(isStarred bool) string {
+			if isStarred {
+				return lang.starredGroupText
+			}
+			return lang.unstarredGroupText
+		})
+	case GroupFlat:
+		feeds := lfs.feedsByTitle()
+		if len(feeds) == 0 {
+			return nil
+		}
+		return []feedGroup[string]{newFeedGroup(lang.allFeedsGroupText, feeds)}
+	default:
+		return labelGroups(lfs.feedsByPeriod(), func(period feedUpdatePeriod) string {
+			return period.Text(lang)
+		})
+	}
+}
+
+// feedsByTag groups feeds by their tags, in alphabetical order of the tags. Untagged feeds are
+// grouped under an empty tag, which comes last.
+func (lfs *feedStore) feedsByTag() []feedGroup[string] {
+	m := make(map[string][]*entity.Feed)
+	for _, feed := range lfs.items {
+		if len(feed.Tags) == 0 {
+			m[""] = append(m[""], feed)
+			continue
+		}
+		for _, tag := range feed.Tags {
+			m[tag] = append(m[tag], feed)
+		}
+	}
+
+	tags := make([]string, 0, len(m))
+	for tag := range m {
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	slices.SortFunc(tags, func(t1, t2 string) int {
+		if c := strings.Compare(strings.ToLower(t1), strings.ToLower(t2)); c != 0 {
+			return c
+		}
+		return strings.Compare(t1, t2)
+	})
+	if _, hasUntagged := m[""]; hasUntagged {
+		tags = append(tags, "")
+	}
+
+	groups := make([]feedGroup[string], 0, len(tags))
+	for _, tag := range tags {
+		groups = append(groups, newFeedGroup(tag, m[tag]))
+	}
+	return groups
+}
+
+// feedsByStarred groups feeds by whether they are starred, with starred feeds first.
+func (lfs *feedStore) feedsByStarred() []feedGroup[bool] {
+	m := make(map[bool][]*entity.Feed)
+	for _, feed := range lfs.items {
+		m[feed.IsStarred] = append(m[feed.IsStarred], feed)
+	}
+
+	groups := make([]feedGroup[bool], 0)
+	for _, isStarred := range []bool{true, false} {
+		if feeds, hasFeeds := m[isStarred]; hasFeeds {
+			groups = append(groups, newFeedGroup(isStarred, feeds))
+		}
+	}
+	return groups
+}
+
+// feedsByTitle returns all feeds in alphabetical order of their titles.
+func (lfs *feedStore) feedsByTitle() []*entity.Feed {
+	feeds := make([]*entity.Feed, 0, len(lfs.items))
+	for _, feed := range lfs.items {
+		feeds = append(feeds, feed)
+	}
+
+	title := func(f1, f2 *entity.Feed) int {
+		return strings.Compare(strings.ToLower(f1.Title), strings.ToLower(f2.Title))
+	}
+	id := func(f1, f2 *entity.Feed) int {
+		return int(f1.ID) - int(f2.ID)
+	}
+	sliceutil.Ordered[*entity.Feed]().
+		By(title, id).
+		Sort(feeds)
+
+	return feeds
+}
+
+// labelGroups returns the given groups labelled with the text of their labels, with their feeds in
+// the order they are listed.
+func labelGroups[T any](groups []feedGroup[T], text func(T) string) []feedGroup[string] {
+	labelled := make([]feedGroup[string], 0, len(groups))
+	for _, group := range groups {
+		labelled = append(labelled, newFeedGroup(text(group.label), group.feedsSlice()))
+	}
+	return labelled
+}
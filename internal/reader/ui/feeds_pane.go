@@ -22,6 +22,8 @@ type feedsPane struct {
 
 	incoming <-chan *entity.Feed
 	store    *feedStore
+	filter   feedsFilter
+	grouping FeedsGrouping
 
 	entriesPane *entriesPane
 }
@@ -54,7 +56,7 @@ func newFeedsPane(
 			if current == nil || current == fp.GetRoot() {
 				if target := fp.getFirstFeedNode(); target != nil {
 					if feed := feedOf(target); feed != nil {
-						fp.entriesPane.setEntries(feed.EntriesSlice())
+						fp.entriesPane.setFeed(feed)
 					}
 					fp.SetCurrentNode(target)
 				}
@@ -66,12 +68,13 @@ func newFeedsPane(
 	return &fp
 }
 
-func (fp *feedsPane) startPoll() (stop func()) {
+// startPoll starts applying incoming feeds to the pane, through the given function that queues
+// them in the event loop of the display.
+func (fp *feedsPane) startPoll(queue func(func())) (stop func()) {
 	done := make(chan struct{})
-	stop = func() {
-		defer close(done)
-		done <- struct{}{}
-	}
+	// The poll may be waiting on a queued update when the event loop has already stopped, so
+	// stopping must not wait for it.
+	stop = func() { close(done) }
 
 	go func() {
 		for {
@@ -79,8 +82,10 @@ func (fp *feedsPane) startPoll() (stop func()) {
 			case <-done:
 				return
 			case feed := <-fp.incoming:
-				fp.store.upsert(feed)
-				fp.refreshFeeds()
+				queue(func() {
+					fp.store.upsert(feed)
+					fp.refreshFeeds()
+				})
 			}
 		}
 	}()
@@ -98,20 +103,90 @@ func (fp *feedsPane) refreshFeeds() {
 
 	root.ClearChildren()
 
-	for _, group := range fp.store.feedsByPeriod() {
-		gnode := groupNode(group.label, fp.theme, fp.lang)
-		root.AddChild(gnode)
+	var currentFound bool
+	for _, group := range fp.store.feedsBy(fp.grouping, fp.lang) {
+		gnode := groupNode(group.label, fp.theme)
 
-		for _, feed := range group.feedsSlice() {
+		for _, feed := range group.items {
+			if !fp.filter.matches(feed) {
+				continue
+			}
 			fnode := feedNode(feed, fp.theme)
 			setFeedNodeDisplay(fnode, fp.theme)
-			fnode.SetSelectedFunc(func() { fp.entriesPane.setEntries(feed.EntriesSlice()) })
+			fnode.SetSelectedFunc(func() { fp.entriesPane.setFeed(feed) })
 			gnode.AddChild(fnode)
-			if currentFeedID != nil && feed.ID == *currentFeedID {
+			if currentFeedID != nil && feed.ID == *currentFeedID && !currentFound {
 				fp.SetCurrentNode(fnode)
+				currentFound = true
 			}
 		}
+
+		if len(gnode.GetChildren()) > 0 {
+			root.AddChild(gnode)
+		}
 	}
+
+	// The current feed may have been removed or filtered out.
+	if currentFeedID != nil && !currentFound {
+		fp.SetCurrentNode(fp.getFirstFeedNode())
+	}
+}
+
+func (fp *feedsPane) getQuery() string {
+	return fp.filter.query
+}
+
+// setQuery lists only the feeds whose titles or tags contain the given query.
+func (fp *feedsPane) setQuery(query string) {
+	fp.filter.query = query
+	fp.refreshFeeds()
+}
+
+func (fp *feedsPane) toggleStarredFilter() {
+	fp.filter.starredOnly = !fp.filter.starredOnly
+	fp.refreshFeeds()
+}
+
+// selectMatch moves the selection to the next listed feed matching the current query, or to the
+// previous one if reverse is true, expanding its group if needed.
+func (fp *feedsPane) selectMatch(reverse bool) {
+	if fp.filter.query == "" {
+		return
+	}
+	var (
+		fnodes  []*tview.TreeNode
+		gnodes  []*tview.TreeNode
+		current = fp.GetCurrentNode()
+		idx     = -1
+	)
+	for _, gnode := range fp.GetRoot().GetChildren() {
+		for _, fnode := range gnode.GetChildren() {
+			if fnode == current {
+				idx = len(fnodes)
+			}
+			fnodes = append(fnodes, fnode)
+			gnodes = append(gnodes, gnode)
+		}
+	}
+	if len(fnodes) == 0 {
+		return
+	}
+
+	target := adjacentIndex(idx, len(fnodes), reverse)
+	if gnode := gnodes[target]; !gnode.IsExpanded() {
+		if label := groupOf(gnode); label != nil {
+			gnode.SetText(string(*label))
+		}
+		gnode.Expand()
+	}
+	fp.SetCurrentNode(fnodes[target])
+}
+
+// removeFeed removes the feed with the given ID, and moves the selection to the first feed if the
+// removed feed was selected.
+func (fp *feedsPane) removeFeed(id entity.ID) {
+	fp.store.remove(id)
+	fp.refreshFeeds()
 }
 
 func (fp *feedsPane) initTree() {
@@ -152,14 +227,16 @@ func (fp *feedsPane) getCurrentGroupNode() *tview.TreeNode {
 	if current == nil {
 		return nil
 	}
-	switch t := current.GetReference().(type) {
-	case feedUpdatePeriod:
+	switch current.GetReference().(type) {
+	case groupLabel:
 		return current
 	case *entity.Feed:
-		targetGroup := whenUpdated(t)
+		// Feeds may be listed in several groups, so their own nodes are looked up.
 		for _, gnode := range root.GetChildren() {
-			if group := periodOf(gnode); group != nil && targetGroup == *group {
-				return gnode
+			for _, fnode := range gnode.GetChildren() {
+				if fnode == current {
+					return gnode
+				}
 			}
 		}
 	}
@@ -170,6 +247,52 @@ func (fp *feedsPane) getCurrentFeed() *entity.Feed {
 	return feedOf(fp.GetCurrentNode())
 }
 
+// getCurrentUnreadEntries returns the unread entries of the current feed, or of all the feeds in
+// the current group.
+func (fp *feedsPane) getCurrentUnreadEntries() []*entity.Entry {
+	current := fp.GetCurrentNode()
+	if current == nil {
+		return nil
+	}
+
+	var feeds []*entity.Feed
+	if feed := feedOf(current); feed != nil {
+		feeds = append(feeds, feed)
+	} else if groupOf(current) != nil {
+		for _, fnode := range current.GetChildren() {
+			if feed := feedOf(fnode); feed != nil {
+				feeds = append(feeds, feed)
+			}
+		}
+	}
+
+	var entries []*entity.Entry
+	for _, feed := range feeds {
+		for _, entry := range feed.EntriesSlice() {
+			if !entry.IsRead {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// refreshUnreadCounts updates the unread entry counts shown in the feed and group nodes.
+func (fp *feedsPane) refreshUnreadCounts() {
+	for _, gnode := range fp.GetRoot().GetChildren() {
+		for _, fnode := range gnode.GetChildren() {
+			setFeedNodeDisplay(fnode, fp.theme)
+		}
+		if label := groupOf(gnode); label != nil && !gnode.IsExpanded() {
+			if unread := countGroupUnread(gnode); unread > 0 {
+				gnode.SetText(fmt.Sprintf("%s (%d)", *label, unread))
+			} else {
+				gnode.SetText(string(*label))
+			}
+		}
+	}
+}
+
 func (fp *feedsPane) getFoldState() foldState {
 	root := fp.GetRoot()
 	if root == nil {
@@ -208,8 +331,8 @@ func (fp *feedsPane) toggleAllFeedsFold() {
 
 	case foldMixed, foldAllCollapsed:
 		for _, gnode := range root.GetChildren() {
-			if period := periodOf(gnode); period != nil {
-				gnode.SetText(period.Text(fp.lang))
+			if label := groupOf(gnode); label != nil {
+				gnode.SetText(string(*label))
 			}
 			gnode.Expand()
 		}
@@ -219,8 +342,8 @@ func (fp *feedsPane) toggleAllFeedsFold() {
 		current := fp.getCurrentGroupNode()
 		for _, gnode := range root.GetChildren() {
 			if unread := countGroupUnread(gnode); unread > 0 {
-				if period := periodOf(gnode); period != nil {
-					gnode.SetText(fmt.Sprintf("%s (%d)", period.Text(fp.lang), unread))
+				if label := groupOf(gnode); label != nil {
+					gnode.SetText(fmt.Sprintf("%s (%d)", *label, unread))
 				}
 			}
 			gnode.Collapse()
@@ -246,14 +369,14 @@ func (fp *feedsPane) toggleCurrentFeedFold() {
 	if gnode := fp.getCurrentGroupNode(); gnode != nil {
 		if gnode.IsExpanded() {
 			if unread := countGroupUnread(gnode); unread > 0 {
-				if period := periodOf(gnode); period != nil {
-					gnode.SetText(fmt.Sprintf("%s (%d)", period.Text(fp.lang), unread))
+				if label := groupOf(gnode); label != nil {
+					gnode.SetText(fmt.Sprintf("%s (%d)", *label, unread))
 				}
 			}
 			gnode.Collapse()
 		} else {
-			if period := periodOf(gnode); period != nil {
-				gnode.SetText(period.Text(fp.lang))
+			if label := groupOf(gnode); label != nil {
+				gnode.SetText(string(*label))
 			}
 			gnode.Expand()
 		}
@@ -265,20 +388,20 @@ func (fp *feedsPane) toggleCurrentFeedFold() {
 // nolint:dupl
 func (fp *feedsPane) makeDrawFuncs() (focusf, unfocusf drawFunc) {
 
-	titleUF, titleF := fmtPaneTitle(fp.lang.feedsPaneTitle)
-
 	drawf := func(
 		focused bool,
 	) func(screen tcell.Screen, x int, y int, width int, height int) (int, int, int, int) {
 
-		var title string
-		if focused {
-			title = titleF
-		} else {
-			title = titleUF
-		}
-
 		return func(screen tcell.Screen, x int, y int, width int, height int) (int, int, int, int) {
+			// The title shows the active filters, so it is formatted anew on each draw.
+			titleUF, titleF := fmtPaneTitle(
+				filteredTitle(fp.lang.feedsPaneTitle, fp.filter.labels(fp.lang)),
+			)
+			title := titleUF
+			if focused {
+				title = titleF
+			}
+
 			lineStyle := fp.theme.lineStyle()
 			// Draw top and optionally bottom borders.
 			for cx := x; cx < x+width; cx++ {
@@ -371,19 +494,23 @@ func setFeedNodeDisplay(fnode *tview.TreeNode, theme *Theme) {
 		fnode.SetText(feed.Title).
 			SetColor(theme.feedNode)
 	}
+	// Feeds whose last pull failed are flagged, so broken subscriptions stand out.
+	if feed.IsFailing() {
+		fnode.SetText("! " + fnode.GetText()).
+			SetColor(theme.eventWarnFG)
+	}
 }
 
-func groupNode(period feedUpdatePeriod, theme *Theme, lang *Lang) *tview.TreeNode {
-	return tview.NewTreeNode(period.Text(lang)).
-		SetReference(period).
+func groupNode(label string, theme *Theme) *tview.TreeNode {
+	return tview.NewTreeNode(label).
+		SetReference(groupLabel(label)).
 		SetColor(theme.feedGroupNode).
 		SetSelectable(true)
 }
 
 func countGroupUnread(gnode *tview.TreeNode) int {
 	var unread int
-	period := periodOf(gnode)
-	if period == nil {
+	if groupOf(gnode) == nil {
 		return 0
 	}
 	for _, fnode := range gnode.GetChildren() {
@@ -432,15 +559,18 @@ func feedOf(node *tview.TreeNode) *entity.Feed {
 	return feed
 }
 
-func periodOf(node *tview.TreeNode) *feedUpdatePeriod {
+// groupLabel is the reference of group nodes, holding the text shown for them.
+type groupLabel string
+
+func groupOf(node *tview.TreeNode) *groupLabel {
 	if node == nil {
 		return nil
 	}
-	period, ok := node.GetReference().(feedUpdatePeriod)
+	label, ok := node.GetReference().(groupLabel)
 	if !ok {
 		return nil
 	}
-	return &period
+	return &label
 }
 
 type feedStore struct {
@@ -485,6 +615,17 @@ func (lfs *feedStore) upsert(incoming *entity.Feed) {
 	lfs.merge(existing, incoming)
 }
 
+// upsertEntry replaces the entry with the same ID as the given updated entry in its feed.
+func (lfs *feedStore) upsertEntry(entry *entity.Entry) {
+	if feed, exists := lfs.items[entry.FeedID]; exists {
+		feed.Entries[entry.ID] = entry
+	}
+}
+
+func (lfs *feedStore) remove(id entity.ID) {
+	delete(lfs.items, id)
+}
+
 func (lfs *feedStore) merge(existing, incoming *entity.Feed) {
 	existing.Title = incoming.Title
 	existing.Description = incoming.Description
@@ -495,6 +636,14 @@ func (lfs *feedStore) merge(existing, incoming *entity.Feed) {
 	existing.Updated = incoming.Updated
 	existing.IsStarred = incoming.IsStarred
 	existing.Tags = incoming.Tags
+	existing.PullInterval = incoming.PullInterval
+	existing.Retention = incoming.Retention
+	existing.ConsecutiveFailures = incoming.ConsecutiveFailures
+	existing.LastError = incoming.LastError
+	existing.BackoffUntil = incoming.BackoffUntil
+	existing.IsPaused = incoming.IsPaused
+	existing.NextPullTime = incoming.NextPullTime
+	existing.FetchContent = incoming.FetchContent
 
 	for eid, e := range incoming.Entries {
 		existing.Entries[eid] = e
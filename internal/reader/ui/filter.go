@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// feedsFilter narrows down the feeds listed in the feeds pane.
+type feedsFilter struct {
+	// query is matched against the titles and tags of feeds, ignoring case.
+	query       string
+	starredOnly bool
+}
+
+func (f *feedsFilter) matches(feed *entity.Feed) bool {
+	if f.starredOnly && !feed.IsStarred {
+		return false
+	}
+	if f.query == "" || containsFold(feed.Title, f.query) {
+		return true
+	}
+	for _, tag := range feed.Tags {
+		if containsFold(tag, f.query) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *feedsFilter) labels(lang *Lang) []string {
+	labels := make([]string, 0)
+	if f.starredOnly {
+		labels = append(labels, lang.starredFilterLabel)
+	}
+	if f.query != "" {
+		labels = append(labels, "/"+f.query)
+	}
+	return labels
+}
+
+// entriesFilter narrows down the entries listed in the entries pane.
+type entriesFilter struct {
+	// query is matched against the titles of entries, ignoring case.
+	query          string
+	unreadOnly     bool
+	bookmarkedOnly bool
+}
+
+func (f *entriesFilter) matches(entry *entity.Entry) bool {
+	if f.unreadOnly && entry.IsRead {
+		return false
+	}
+	if f.bookmarkedOnly && !entry.IsBookmarked {
+		return false
+	}
+	return f.query == "" || containsFold(entry.Title, f.query)
+}
+
+func (f *entriesFilter) labels(lang *Lang) []string {
+	labels := make([]string, 0)
+	if f.unreadOnly {
+		labels = append(labels, lang.unreadFilterLabel)
+	}
+	if f.bookmarkedOnly {
+		labels = append(labels, lang.bookmarkedFilterLabel)
+	}
+	if f.query != "" {
+		labels = append(labels, "/"+f.query)
+	}
+	return labels
+}
+
+// searchTarget is a pane whose items can be searched from the search prompt.
+type searchTarget interface {
+	tview.Primitive
+	getQuery() string
+	setQuery(string)
+}
+
+// startSearch shows the search prompt in place of the status bar. The items of the feeds pane are
+// searched if it has the focus, and those of the entries pane otherwise. The target pane is
+// filtered as the query is typed. The query is kept when it is entered, and cleared when the
+// prompt is escaped.
+func (d *Display) startSearch() {
+	if d.frontPageName() != mainPageName {
+		return
+	}
+	if d.searchPrompt != nil {
+		d.stopSearch()
+	}
+
+	var target searchTarget = d.entriesPane
+	if d.inner.GetFocus() == d.feedsPane {
+		target = d.feedsPane
+	}
+
+	prompt := tview.NewInputField().
+		SetLabel("/").
+		SetLabelColor(d.theme.titleFG).
+		SetFieldStyle(tcell.StyleDefault.Foreground(d.theme.formFieldFG).Background(d.theme.bg)).
+		SetText(target.getQuery())
+
+	prompt.
+		SetChangedFunc(func(text string) { target.setQuery(text) }).
+		SetDoneFunc(func(key tcell.Key) {
+			if key == tcell.KeyEscape {
+				target.setQuery("")
+			}
+			d.stopSearch()
+			d.inner.SetFocus(target)
+		})
+
+	if d.barVisible {
+		d.mainPage.RemoveItem(d.bar)
+	}
+	d.mainPage.SetRows(0, 1).AddItem(prompt, 1, 0, 1, 1, 0, 0, true)
+	d.searchPrompt = prompt
+	d.inner.SetFocus(prompt)
+}
+
+// stopSearch removes the search prompt, restoring the status bar if it was visible.
+func (d *Display) stopSearch() {
+	d.mainPage.RemoveItem(d.searchPrompt)
+	d.searchPrompt = nil
+	if d.barVisible {
+		d.addStatusBar()
+	} else {
+		d.mainPage.SetRows(0)
+	}
+}
+
+// isSearching checks whether the search prompt has the focus.
+func (d *Display) isSearching() bool {
+	return d.searchPrompt != nil && d.inner.GetFocus() == d.searchPrompt
+}
+
+// selectMatch moves the selection of the focused pane to the next matching item, or to the
+// previous one if reverse is true.
+func (d *Display) selectMatch(reverse bool) {
+	switch d.inner.GetFocus() {
+	case d.feedsPane:
+		d.feedsPane.selectMatch(reverse)
+	case d.entriesPane:
+		d.entriesPane.selectMatch(reverse)
+	}
+}
+
+// filteredTitle returns the given pane title, followed by the labels of the active filters.
+func filteredTitle(title string, labels []string) string {
+	if len(labels) == 0 {
+		return title
+	}
+	return tview.Escape(fmt.Sprintf("%s (%s)", title, strings.Join(labels, ", ")))
+}
+
+// adjacentIndex returns the index after the given one among n items, or before it if reverse is
+// true, wrapping around at both ends. A negative index is before the first item.
+func adjacentIndex(idx, n int, reverse bool) int {
+	if reverse {
+		if idx <= 0 {
+			return n - 1
+		}
+		return idx - 1
+	}
+	return (idx + 1) % n
+}
+
+func containsFold(text, query string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(query))
+}
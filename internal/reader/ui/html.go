@@ -0,0 +1,583 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// minRenderWidth is the narrowest width that rendered content is wrapped to, however deeply it is
+// indented.
+const minRenderWidth = 20
+
+// htmlTagPattern matches what looks like the start of an HTML tag or entity, to tell HTML content
+// apart from plain text.
+var htmlTagPattern = regexp.MustCompile(`<[a-zA-Z!/]|&[a-zA-Z]+;|&#[0-9a-fA-F]+;`)
+
+// renderHTML renders the given HTML content as text with tview style tags, wrapped to the given
+// width. Links are numbered in the text and listed at the end, with relative URLs resolved against
+// the given base URL, if any. Content without any HTML is rendered as plain text paragraphs.
+func renderHTML(content string, width int, base *url.URL, theme *Theme, lang *Lang) string {
+	r := newHTMLRenderer(width, base, theme, lang)
+
+	if htmlTagPattern.MatchString(content) {
+		doc, err := html.Parse(strings.NewReader(content))
+		if err == nil {
+			r.render(doc)
+		} else {
+			r.renderPlain(content)
+		}
+	} else {
+		r.renderPlain(content)
+	}
+	r.renderLinks()
+
+	return strings.Join(r.lines, "\n")
+}
+
+// htmlRenderer holds the state of rendering a single HTML document.
+type htmlRenderer struct {
+	width int
+	base  *url.URL
+	theme *Theme
+	lang  *Lang
+
+	lines []string
+	blank bool
+
+	// Words of the paragraph being rendered, and whether whitespace precedes the next one.
+	words []htmlWord
+	space bool
+
+	prefixes []*linePrefix
+	styles   []textStyle
+	lists    []*htmlList
+
+	// pre holds the raw text of the preformatted block being rendered, if any.
+	pre *strings.Builder
+
+	links   []string
+	linkNum map[string]int
+}
+
+// htmlWord is a word of rendered text, with its style tags and escapes.
+type htmlWord struct {
+	text  string
+	width int
+	glued bool
+}
+
+// linePrefix is the text that starts every line of a block, such as the bar of a quote or the
+// bullet of a list item. The first line of the block may have a different prefix than the rest.
+type linePrefix struct {
+	first string
+	rest  string
+	width int
+	used  bool
+}
+
+// textStyle is the foreground color and attributes of rendered text, as used in tview style tags.
+type textStyle struct {
+	fg    string
+	attrs string
+}
+
+type htmlList struct {
+	ordered bool
+	next    int
+}
+
+func newHTMLRenderer(width int, base *url.URL, theme *Theme, lang *Lang) *htmlRenderer {
+	return &htmlRenderer{
+		width:   width,
+		base:    base,
+		theme:   theme,
+		lang:    lang,
+		blank:   true,
+		linkNum: make(map[string]int),
+	}
+}
+
+// Elements whose contents are not rendered.
+var skippedElements = map[atom.Atom]bool{
+	atom.Head: true, atom.Script: true, atom.Style: true, atom.Noscript: true,
+	atom.Template: true, atom.Iframe: true, atom.Svg: true, atom.Math: true,
+	atom.Object: true, atom.Embed: true, atom.Canvas: true, atom.Form: true,
+	atom.Button: true, atom.Input: true, atom.Select: true, atom.Textarea: true,
+}
+
+// Elements that are separated from the surrounding content by blank lines.
+var paragraphElements = map[atom.Atom]bool{
+	atom.P: true, atom.Figure: true, atom.Table: true, atom.Dl: true, atom.Details: true,
+	atom.Address: true,
+}
+
+// Elements that start and end on lines of their own.
+var lineElements = map[atom.Atom]bool{
+	atom.Div: true, atom.Section: true, atom.Article: true, atom.Main: true, atom.Header: true,
+	atom.Footer: true, atom.Aside: true, atom.Nav: true, atom.Tr: true, atom.Dt: true,
+	atom.Figcaption: true, atom.Summary: true, atom.Caption: true, atom.Center: true,
+}
+
+// Attributes of the text inside inline elements.
+var inlineAttrs = map[atom.Atom]string{
+	atom.B: "b", atom.Strong: "b", atom.Th: "b", atom.Dt: "b",
+	atom.I: "i", atom.Em: "i", atom.Cite: "i", atom.Dfn: "i", atom.Var: "i",
+	atom.U: "u", atom.Ins: "u",
+	atom.S: "s", atom.Strike: "s", atom.Del: "s",
+}
+
+func (r *htmlRenderer) render(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		r.addText(n.Data)
+		return
+	case html.DocumentNode:
+		r.renderChildren(n)
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	if skippedElements[n.DataAtom] {
+		return
+	}
+
+	// nolint:exhaustive
+	switch n.DataAtom {
+
+	case atom.Br:
+		r.lineBreak()
+
+	case atom.Hr:
+		r.blockBreak()
+		width := max(r.width-r.prefixWidth(), minRenderWidth)
+		r.emit(styleText(strings.Repeat("─", width), textStyle{fg: r.theme.contentDimFG.CSS()}))
+		r.blockBreak()
+
+	case atom.Img:
+		r.addImage(n)
+
+	case atom.A:
+		r.renderLink(n)
+
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		attrs := "b"
+		if n.DataAtom == atom.H1 {
+			attrs = "bu"
+		}
+		r.blockBreak()
+		r.withStyle(textStyle{fg: r.theme.contentHeadingFG.CSS(), attrs: attrs}, func() {
+			r.renderChildren(n)
+		})
+		r.blockBreak()
+
+	case atom.Pre:
+		r.renderPre(n)
+
+	case atom.Code, atom.Kbd, atom.Samp, atom.Tt:
+		r.withStyle(textStyle{fg: r.theme.contentCodeFG.CSS()}, func() { r.renderChildren(n) })
+
+	case atom.Blockquote:
+		r.blockBreak()
+		bar := styleText("│", textStyle{fg: r.theme.contentQuoteFG.CSS()}) + " "
+		r.pushPrefix(bar, bar, 2)
+		r.withStyle(textStyle{attrs: "i"}, func() { r.renderChildren(n) })
+		r.flush()
+		r.dropBlankLine()
+		r.popPrefix()
+		r.blockBreak()
+
+	case atom.Ul, atom.Ol:
+		r.renderList(n)
+
+	case atom.Li:
+		r.renderListItem(n)
+
+	case atom.Dd:
+		r.flush()
+		r.pushPrefix("    ", "    ", 4)
+		r.renderChildren(n)
+		r.flush()
+		r.popPrefix()
+
+	case atom.Td, atom.Th:
+		if hasPrevElement(n, atom.Td, atom.Th) {
+			r.addWord("│", textStyle{fg: r.theme.contentDimFG.CSS()}, false)
+		}
+		r.withStyle(textStyle{attrs: inlineAttrs[n.DataAtom]}, func() { r.renderChildren(n) })
+
+	default:
+		switch {
+		case paragraphElements[n.DataAtom]:
+			r.blockBreak()
+			r.renderChildren(n)
+			r.blockBreak()
+		case lineElements[n.DataAtom]:
+			r.flush()
+			r.withStyle(textStyle{attrs: inlineAttrs[n.DataAtom]}, func() { r.renderChildren(n) })
+			r.flush()
+		default:
+			r.withStyle(textStyle{attrs: inlineAttrs[n.DataAtom]}, func() { r.renderChildren(n) })
+		}
+	}
+}
+
+func (r *htmlRenderer) renderChildren(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		r.render(c)
+	}
+}
+
+// renderPlain renders the given plain text, keeping its line breaks.
+func (r *htmlRenderer) renderPlain(text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		if strings.TrimSpace(line) == "" {
+			r.blockBreak()
+			continue
+		}
+		r.addText(line)
+		r.flush()
+	}
+}
+
+// renderLink renders the text of a link, followed by the number of its URL in the list of links.
+func (r *htmlRenderer) renderLink(n *html.Node) {
+	style := textStyle{fg: r.theme.contentLinkFG.CSS(), attrs: "u"}
+	r.withStyle(style, func() { r.renderChildren(n) })
+
+	if num := r.addLinkURL(attrOf(n, "href")); num > 0 {
+		r.addWord(
+			fmt.Sprintf("[%d]", num),
+			textStyle{fg: r.theme.contentDimFG.CSS()},
+			len(r.words) > 0 && !r.space,
+		)
+	}
+}
+
+// addLinkURL adds the given link URL to the list of links, returning its number in the list. The
+// same URL is listed only once. It returns 0 if the URL does not point to another page.
+func (r *htmlRenderer) addLinkURL(href string) int {
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(href, "javascript:") {
+		return 0
+	}
+	if r.base != nil {
+		if u, err := r.base.Parse(href); err == nil {
+			href = u.String()
+		}
+	}
+	if num, exists := r.linkNum[href]; exists {
+		return num
+	}
+	r.links = append(r.links, href)
+	r.linkNum[href] = len(r.links)
+	return len(r.links)
+}
+
+// renderLinks renders the numbered list of the URLs of the links in the content.
+func (r *htmlRenderer) renderLinks() {
+	r.blockBreak()
+	if len(r.links) == 0 {
+		r.trimBlankLines()
+		return
+	}
+	r.emit(styleText(r.lang.linksLabel, textStyle{fg: r.theme.contentHeadingFG.CSS(), attrs: "b"}))
+	dim := textStyle{fg: r.theme.contentDimFG.CSS()}
+	for i, link := range r.links {
+		r.emit(styleText(fmt.Sprintf("[%d]", i+1), dim) + " " + tview.Escape(link))
+	}
+}
+
+// addImage renders a placeholder for an image, showing its alternative text.
+func (r *htmlRenderer) addImage(n *html.Node) {
+	text := r.lang.imageText
+	if alt := strings.Join(strings.Fields(attrOf(n, "alt")), " "); alt != "" {
+		text = fmt.Sprintf("%s: %s", text, alt)
+	}
+	r.addWord("["+text+"]", textStyle{fg: r.theme.contentDimFG.CSS()}, false)
+}
+
+// renderPre renders a preformatted block as is, without wrapping its lines.
+func (r *htmlRenderer) renderPre(n *html.Node) {
+	r.blockBreak()
+
+	r.pre = &strings.Builder{}
+	r.renderChildren(n)
+	text := r.pre.String()
+	r.pre = nil
+
+	text = strings.TrimRight(strings.TrimPrefix(text, "\n"), " \t\r\n")
+	text = strings.ReplaceAll(text, "\t", "    ")
+	style := textStyle{fg: r.theme.contentCodeFG.CSS()}
+	for _, line := range strings.Split(text, "\n") {
+		r.emit("  " + styleText(tview.Escape(strings.TrimRight(line, "\r")), style))
+	}
+
+	r.blockBreak()
+}
+
+func (r *htmlRenderer) renderList(n *html.Node) {
+	nested := len(r.lists) > 0
+	if nested {
+		r.flush()
+	} else {
+		r.blockBreak()
+	}
+
+	list := htmlList{ordered: n.DataAtom == atom.Ol, next: 1}
+	if start, err := strconv.Atoi(attrOf(n, "start")); err == nil {
+		list.next = start
+	}
+	r.lists = append(r.lists, &list)
+	r.renderChildren(n)
+	r.lists = r.lists[:len(r.lists)-1]
+
+	if nested {
+		r.flush()
+	} else {
+		r.blockBreak()
+	}
+}
+
+func (r *htmlRenderer) renderListItem(n *html.Node) {
+	r.flush()
+
+	marker := "•"
+	if len(r.lists) > 0 {
+		if list := r.lists[len(r.lists)-1]; list.ordered {
+			marker = fmt.Sprintf("%d.", list.next)
+			list.next++
+		}
+	}
+	width := tview.TaggedStringWidth(marker) + 1
+	r.pushPrefix(marker+" ", strings.Repeat(" ", width), width)
+	r.renderChildren(n)
+	r.flush()
+	r.popPrefix()
+}
+
+// addText adds the words of the given text to the paragraph being rendered, in the current style.
+func (r *htmlRenderer) addText(text string) {
+	if r.pre != nil {
+		r.pre.WriteString(text)
+		return
+	}
+	if text == "" {
+		return
+	}
+	if startsWithSpace(text) {
+		r.space = true
+	}
+	style := r.style()
+	for i, field := range strings.Fields(text) {
+		r.addWord(field, style, i == 0 && len(r.words) > 0 && !r.space)
+	}
+	if endsWithSpace(text) {
+		r.space = true
+	}
+}
+
+// addWord adds a single word to the paragraph being rendered. Glued words are never separated from
+// the preceding word.
+func (r *htmlRenderer) addWord(text string, style textStyle, glued bool) {
+	if r.pre != nil {
+		r.pre.WriteString(text)
+		return
+	}
+	escaped := tview.Escape(text)
+	r.words = append(r.words, htmlWord{
+		text:  styleText(escaped, style),
+		width: tview.TaggedStringWidth(escaped),
+		glued: glued,
+	})
+	r.space = false
+}
+
+// flush wraps the words of the paragraph being rendered into lines.
+func (r *htmlRenderer) flush() {
+	if len(r.words) == 0 {
+		return
+	}
+
+	units := make([]htmlWord, 0, len(r.words))
+	for _, word := range r.words {
+		if word.glued && len(units) > 0 {
+			last := &units[len(units)-1]
+			last.text += word.text
+			last.width += word.width
+			continue
+		}
+		units = append(units, word)
+	}
+	r.words = r.words[:0]
+	r.space = false
+
+	var (
+		line      strings.Builder
+		lineWidth int
+		available = max(r.width-r.prefixWidth(), minRenderWidth)
+	)
+	for _, unit := range units {
+		if lineWidth > 0 && lineWidth+1+unit.width > available {
+			r.emit(line.String())
+			line.Reset()
+			lineWidth = 0
+		}
+		if lineWidth > 0 {
+			line.WriteByte(' ')
+			lineWidth++
+		}
+		line.WriteString(unit.text)
+		lineWidth += unit.width
+	}
+	r.emit(line.String())
+}
+
+// lineBreak ends the current line, or adds an empty line if the current line is empty.
+func (r *htmlRenderer) lineBreak() {
+	if r.pre != nil {
+		r.pre.WriteByte('\n')
+		return
+	}
+	if len(r.words) > 0 {
+		r.flush()
+		return
+	}
+	r.emit("")
+}
+
+// blockBreak ends the current paragraph, and separates it from the next one by a blank line.
+func (r *htmlRenderer) blockBreak() {
+	r.flush()
+	if r.blank {
+		return
+	}
+	var sb strings.Builder
+	for _, prefix := range r.prefixes {
+		sb.WriteString(prefix.rest)
+	}
+	r.lines = append(r.lines, strings.TrimRight(sb.String(), " "))
+	r.blank = true
+}
+
+// emit adds a line of text, preceded by the prefixes of the blocks it is in.
+func (r *htmlRenderer) emit(text string) {
+	var sb strings.Builder
+	for _, prefix := range r.prefixes {
+		if prefix.used {
+			sb.WriteString(prefix.rest)
+		} else {
+			sb.WriteString(prefix.first)
+			prefix.used = true
+		}
+	}
+	sb.WriteString(text)
+	r.lines = append(r.lines, strings.TrimRight(sb.String(), " "))
+	r.blank = false
+}
+
+// dropBlankLine removes the last line if it is a blank line separating paragraphs.
+func (r *htmlRenderer) dropBlankLine() {
+	if r.blank && len(r.lines) > 0 {
+		r.lines = r.lines[:len(r.lines)-1]
+		r.blank = false
+	}
+}
+
+func (r *htmlRenderer) trimBlankLines() {
+	for len(r.lines) > 0 && strings.TrimSpace(r.lines[len(r.lines)-1]) == "" {
+		r.lines = r.lines[:len(r.lines)-1]
+	}
+}
+
+func (r *htmlRenderer) pushPrefix(first, rest string, width int) {
+	r.prefixes = append(r.prefixes, &linePrefix{first: first, rest: rest, width: width})
+}
+
+func (r *htmlRenderer) popPrefix() {
+	r.prefixes = r.prefixes[:len(r.prefixes)-1]
+}
+
+func (r *htmlRenderer) prefixWidth() int {
+	var width int
+	for _, prefix := range r.prefixes {
+		width += prefix.width
+	}
+	return width
+}
+
+// withStyle renders the text added by the given function in the given style, on top of the
+// current one.
+func (r *htmlRenderer) withStyle(style textStyle, f func()) {
+	r.styles = append(r.styles, style)
+	f()
+	r.styles = r.styles[:len(r.styles)-1]
+}
+
+// style returns the current style, which combines the styles of all the enclosing elements.
+func (r *htmlRenderer) style() textStyle {
+	var combined textStyle
+	for _, style := range r.styles {
+		if style.fg != "" {
+			combined.fg = style.fg
+		}
+		for _, attr := range style.attrs {
+			if !strings.ContainsRune(combined.attrs, attr) {
+				combined.attrs += string(attr)
+			}
+		}
+	}
+	return combined
+}
+
+// styleText wraps the given escaped text in the tags of the given style.
+func styleText(text string, style textStyle) string {
+	if style.fg == "" && style.attrs == "" {
+		return text
+	}
+	return fmt.Sprintf("[%s::%s]%s[-::-]", style.fg, style.attrs, text)
+}
+
+func attrOf(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// hasPrevElement checks whether any of the preceding siblings of the given node is one of the
+// given elements.
+func hasPrevElement(n *html.Node, elements ...atom.Atom) bool {
+	for s := n.PrevSibling; s != nil; s = s.PrevSibling {
+		if s.Type != html.ElementNode {
+			continue
+		}
+		for _, el := range elements {
+			if s.DataAtom == el {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func startsWithSpace(text string) bool {
+	return strings.TrimLeft(text, " \t\r\n\f") != text
+}
+
+func endsWithSpace(text string) bool {
+	return strings.TrimRight(text, " \t\r\n\f") != text
+}
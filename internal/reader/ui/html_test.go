@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderHTMLBlocks(t *testing.T) {
+	t.Parallel()
+
+	content := `
+<h2>Heading</h2>
+<p>The quick brown fox jumps over the lazy dog, and then <em>jumps</em> back again.</p>
+<ul>
+  <li>First item</li>
+  <li>Second item
+    <ol start="3"><li>Nested item</li><li>Another nested item</li></ol>
+  </li>
+</ul>
+<blockquote><p>A quote that is long enough to be wrapped onto a second line.</p></blockquote>
+<pre><code>func main() {
+	fmt.Println("[red]not a tag[-]")
+}</code></pre>
+<p>Line one<br>Line two</p>
+<hr>
+<p><img src="/a.png" alt="A chart"> <img src="/b.png"></p>
+<script>alert("nope")</script>`
+
+	want := `Heading
+
+The quick brown fox jumps over the lazy
+dog, and then jumps back again.
+
+• First item
+• Second item
+  3. Nested item
+  4. Another nested item
+
+│ A quote that is long enough to be
+│ wrapped onto a second line.
+
+  func main() {
+      fmt.Println("[red]not a tag[-]")
+  }
+
+Line one
+Line two
+
+────────────────────────────────────────
+
+[image: A chart] [image]`
+
+	got := renderHTML(content, 40, nil, DarkTheme, langEN)
+	assert.Equal(t, want, stripTags(got))
+}
+
+func TestRenderHTMLLinks(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	base, err := url.Parse("http://a.com/posts/a.html")
+	r.NoError(err)
+
+	content := `<p>See <a href="/b.html">this post</a>, <a href="http://c.com">that one</a>,
+<a href="/b.html">this post again</a> and <a href="#top">the top</a>.</p>`
+
+	want := `See this post[1], that one[2], this post again[1] and the top.
+
+Links
+[1] http://a.com/b.html
+[2] http://c.com`
+
+	got := renderHTML(content, 80, base, DarkTheme, langEN)
+	assert.Equal(t, want, stripTags(got))
+}
+
+func TestRenderHTMLEscapesTags(t *testing.T) {
+	t.Parallel()
+
+	content := `<p>Arrays like [red] and [::b] stay as they are.</p>`
+
+	got := renderHTML(content, 80, nil, DarkTheme, langEN)
+	assert.Equal(t, "Arrays like [red] and [::b] stay as they are.", stripTags(got))
+	assert.NotContains(t, got, "[red]")
+}
+
+func TestRenderHTMLEntities(t *testing.T) {
+	t.Parallel()
+
+	got := renderHTML(`Fish &amp; chips&hellip;`, 80, nil, DarkTheme, langEN)
+	assert.Equal(t, "Fish & chips…", stripTags(got))
+}
+
+func TestRenderHTMLPlainText(t *testing.T) {
+	t.Parallel()
+
+	content := "First paragraph\nstill the first.\n\nSecond paragraph."
+	want := "First paragraph\nstill the first.\n\nSecond paragraph."
+
+	got := renderHTML(content, 80, nil, DarkTheme, langEN)
+	assert.Equal(t, want, stripTags(got))
+}
+
+func TestRenderHTMLStyles(t *testing.T) {
+	t.Parallel()
+
+	content := `<p><strong>Bold <em>both</em></strong> <code>x</code></p>`
+
+	got := renderHTML(content, 80, nil, DarkTheme, langEN)
+
+	a := assert.New(t)
+	a.Contains(got, "[::b]Bold[-::-]")
+	a.Contains(got, "[::bi]both[-::-]")
+	a.Contains(got, "["+DarkTheme.contentCodeFG.CSS()+"::]x[-::-]")
+}
+
+// stripTags returns the given text without its tview style tags and escapes.
+func stripTags(text string) string {
+	tv := tview.NewTextView().SetDynamicColors(true).SetText(text)
+	lines := strings.Split(tv.GetText(true), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
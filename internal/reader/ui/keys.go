@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/bow/neon/internal"
+	"github.com/bow/neon/internal/reader/keymap"
+)
+
+// SetKeymap sets the keymap whose bindings are shown in the help and intro popups.
+func (d *Display) SetKeymap(km *keymap.Keymap) {
+	d.setHelpPopupText(km)
+	d.setIntroPopupText(km)
+}
+
+// setHelpPopupText sets the text of the help popup to the actions of the given keymap that are
+// bound to keys, listed by scope.
+func (d *Display) setHelpPopupText(km *keymap.Keymap) {
+	sections := make([]string, 0, len(keymap.Scopes))
+	for _, scope := range keymap.Scopes {
+		bindings := km.Bindings(scope)
+		if len(bindings) == 0 {
+			continue
+		}
+
+		keysWidth := 0
+		for _, binding := range bindings {
+			keysWidth = max(keysWidth, len(keysText(binding.Keys)))
+		}
+
+		lines := []string{fmt.Sprintf("[aqua]%s[-]", scopeText(scope, d.lang))}
+		for _, binding := range bindings {
+			keys := keysText(binding.Keys)
+			lines = append(
+				lines,
+				fmt.Sprintf(
+					"[yellow]%s[-]%s: %s",
+					tview.Escape(keys),
+					strings.Repeat(" ", keysWidth-len(keys)),
+					binding.Description,
+				),
+			)
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	helpText := strings.Join(sections, "\n\n")
+
+	helpWidget := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(helpText)
+
+	d.helpPopup.setWidth(popupWidth(helpWidget.GetText(true)))
+	d.helpPopup.setHeight(popupHeight(helpText))
+	d.helpPopup.setContent(helpWidget)
+}
+
+// setIntroPopupText sets the text of the intro popup, which refers to the keys of the given keymap
+// for showing the help and closing the popup.
+func (d *Display) setIntroPopupText(km *keymap.Keymap) {
+	helpHint := fmt.Sprintf("go to [yellow]%s[-]", internal.AppHomepage())
+	if keys := km.Keys(keymap.ToggleHelp); len(keys) > 0 {
+		helpHint = fmt.Sprintf("press [yellow]%s[-] or %s", tview.Escape(keys[0].String()), helpHint)
+	}
+	closeHint := ""
+	if keys := km.Keys(keymap.Unfocus); len(keys) > 0 {
+		closeHint = fmt.Sprintf(
+			"\nTo close this message, press [yellow]<%s>[-].\n",
+			tview.Escape(keys[0].String()),
+		)
+	}
+
+	introText := fmt.Sprintf(`Hello and welcome the %s reader.
+
+For help, %s.
+%s`, internal.AppName(), helpHint, closeHint)
+
+	introWidget := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(introText)
+
+	d.introPopup.setWidth(popupWidth(introWidget.GetText(true)))
+	d.introPopup.setHeight(popupHeight(introText))
+	d.introPopup.setContent(introWidget)
+}
+
+func scopeText(scope keymap.Scope, lang *Lang) string {
+	switch scope {
+	case keymap.FeedsPane:
+		return lang.feedsPaneKeysText
+	case keymap.EntriesPane:
+		return lang.entriesPaneKeysText
+	case keymap.ReadingPane:
+		return lang.readingPaneKeysText
+	default:
+		return lang.globalKeysText
+	}
+}
+
+func keysText(keys []keymap.Key) string {
+	texts := make([]string, len(keys))
+	for i, k := range keys {
+		texts[i] = k.String()
+	}
+	return strings.Join(texts, ",")
+}
@@ -13,11 +13,59 @@ type Lang struct {
 	statsPopupTitle string
 	introPopupTitle string
 
+	addFeedPopupTitle    string
+	editFeedPopupTitle   string
+	deleteFeedPopupTitle string
+
 	updatedTodayText     string
 	updatedThisWeekText  string
 	updatedThisMonthText string
 	updatedEarlierText   string
 	updatedUnknownText   string
+
+	untaggedGroupText  string
+	starredGroupText   string
+	unstarredGroupText string
+	allFeedsGroupText  string
+
+	groupByPeriodText  string
+	groupByTagText     string
+	groupByStarredText string
+	groupFlatText      string
+
+	feedsPaneKeysText   string
+	entriesPaneKeysText string
+	readingPaneKeysText string
+	globalKeysText      string
+
+	feedLabel       string
+	authorsLabel    string
+	dateLabel       string
+	categoriesLabel string
+	enclosuresLabel string
+	linkLabel       string
+	linksLabel      string
+
+	imageText     string
+	noContentText string
+
+	urlLabel         string
+	titleLabel       string
+	descriptionLabel string
+	tagsLabel        string
+	starredLabel     string
+	tagsPlaceholder  string
+
+	addButton    string
+	saveButton   string
+	deleteButton string
+	cancelButton string
+
+	deleteFeedText string
+
+	starredFilterLabel    string
+	unreadFilterLabel     string
+	bookmarkedFilterLabel string
 }
 
 var langEN = &Lang{
@@ -30,9 +78,57 @@ var langEN = &Lang{
 	statsPopupTitle: "Stats",
 	introPopupTitle: "Welcome",
 
+	addFeedPopupTitle:    "Add feed",
+	editFeedPopupTitle:   "Edit feed",
+	deleteFeedPopupTitle: "Delete feed",
+
 	updatedTodayText:     "Updated today",
 	updatedThisWeekText:  "Updated this week",
 	updatedThisMonthText: "Updated this month",
 	updatedEarlierText:   "Updated earlier",
 	updatedUnknownText:   "Unknown",
+
+	untaggedGroupText:  "Untagged",
+	starredGroupText:   "Starred",
+	unstarredGroupText: "Not starred",
+	allFeedsGroupText:  "All feeds",
+
+	groupByPeriodText:  "Feeds grouped by update period",
+	groupByTagText:     "Feeds grouped by tag",
+	groupByStarredText: "Feeds grouped by starred status",
+	groupFlatText:      "Feeds listed alphabetically",
+
+	feedsPaneKeysText:   "Feeds pane",
+	entriesPaneKeysText: "Entries pane",
+	readingPaneKeysText: "Reading pane",
+	globalKeysText:      "Global",
+
+	feedLabel:       "Feed",
+	authorsLabel:    "By",
+	dateLabel:       "Date",
+	categoriesLabel: "Tags",
+	enclosuresLabel: "Attachments",
+	linkLabel:       "Link",
+	linksLabel:      "Links",
+
+	imageText:     "image",
+	noContentText: "No content",
+
+	urlLabel:         "URL",
+	titleLabel:       "Title",
+	descriptionLabel: "Description",
+	tagsLabel:        "Tags",
+	starredLabel:     "Starred",
+	tagsPlaceholder:  "comma-separated",
+
+	addButton:    "Add",
+	saveButton:   "Save",
+	deleteButton: "Delete",
+	cancelButton: "Cancel",
+
+	deleteFeedText: "Delete %q and all of its entries?",
+
+	starredFilterLabel:    "starred",
+	unreadFilterLabel:     "unread",
+	bookmarkedFilterLabel: "bookmarked",
 }
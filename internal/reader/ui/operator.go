@@ -7,22 +7,42 @@ import "github.com/bow/neon/internal/entity"
 
 // Operator describes high-level UI operations.
 type Operator interface {
+	AddFeed(*Display, func() (*entity.Feed, bool, error), string)
 	ClearStatusBar(*Display)
+	DeleteFeed(*Display, func() error, *entity.Feed)
 	FocusFeedsPane(*Display)
 	FocusEntriesPane(*Display)
 	FocusNextPane(*Display)
 	FocusPreviousPane(*Display)
 	FocusReadingPane(*Display)
+	EditEntries(*Display, func() ([]*entity.Entry, error))
+	EditFeeds(*Display, func() ([]*entity.Feed, error))
+	ExtractEntryContent(*Display, func() (*entity.Entry, error), *entity.Entry)
+	GetCurrentEntry(*Display) *entity.Entry
 	GetCurrentFeed(*Display) *entity.Feed
+	GetFeedsGrouping(*Display) FeedsGrouping
+	GetCurrentUnreadEntries(*Display) []*entity.Entry
+	GetSelectedEntry(*Display) *entity.Entry
 	PopulateFeedsPane(*Display, func() ([]*entity.Feed, error))
 	RefreshFeeds(*Display, func() (<-chan entity.PullResult, error), *entity.Feed)
 	RefreshStats(*Display, func() (*entity.Stats, error))
+	SelectNextMatch(*Display)
+	SelectPreviousMatch(*Display)
+	SetFeedsGrouping(*Display, FeedsGrouping)
+	ShowAddFeedPopup(*Display, func(string, []string))
+	ShowDeleteFeedPopup(*Display, *entity.Feed, func())
+	ShowEditFeedPopup(*Display, *entity.Feed, func(*entity.FeedEditOp))
 	ShowIntroPopup(*Display)
+	StartSearch(*Display)
+	SwitchFeedsGrouping(*Display, FeedsGrouping)
 	ToggleAboutPopup(*Display, string)
 	ToggleAllFeedsFold(*Display)
+	ToggleBookmarkedEntriesFilter(*Display)
 	ToggleCurrentFeedFold(*Display)
 	ToggleHelpPopup(*Display)
+	ToggleStarredFeedsFilter(*Display)
 	ToggleStatsPopup(*Display, func() (*entity.Stats, error))
 	ToggleStatusBar(*Display)
+	ToggleUnreadEntriesFilter(*Display)
 	UnfocusFront(*Display)
 }
@@ -34,6 +34,10 @@ func (p *popup) setContent(prim tview.Primitive) {
 	p.content = prim
 }
 
+func (p *popup) setTitle(title string) {
+	p.frame.SetTitle(fmt.Sprintf(" %s ", title))
+}
+
 func (p *popup) setWidth(w int) {
 	p.SetColumns(0, w, 0)
 }
@@ -4,6 +4,10 @@
 package ui
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	"github.com/bow/neon/internal/entity"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -15,9 +19,18 @@ type readingPane struct {
 	theme *Theme
 	lang  *Lang
 
+	entry     *entity.Entry
+	feedTitle string
+
+	// width is the width that the content is wrapped to, which follows the width of the pane.
+	width int
+
 	narrowBranchPoint int
 }
 
+// defaultRenderWidth is the width that content is wrapped to before the pane is first drawn.
+const defaultRenderWidth = 80
+
 func newReadingPane(theme *Theme, lang *Lang, narrowBranchPoint int) *readingPane {
 	rp := readingPane{
 		theme: theme,
@@ -26,7 +39,7 @@ func newReadingPane(theme *Theme, lang *Lang, narrowBranchPoint int) *readingPan
 		narrowBranchPoint: narrowBranchPoint,
 	}
 
-	rp.TextView = tview.NewTextView()
+	rp.TextView = tview.NewTextView().SetDynamicColors(true)
 
 	focusf, unfocusf := rp.makeDrawFuncs()
 	rp.SetDrawFunc(unfocusf)
@@ -36,16 +49,134 @@ func newReadingPane(theme *Theme, lang *Lang, narrowBranchPoint int) *readingPan
 	return &rp
 }
 
-func (rp *readingPane) setEntry(entry *entity.Entry) {
-	if content := entry.Content; content != nil {
-		rp.SetText(*content)
+// setEntry shows the given entry of the feed with the given title.
+func (rp *readingPane) setEntry(entry *entity.Entry, feedTitle string) {
+	rp.entry = entry
+	rp.feedTitle = feedTitle
+	rp.render()
+	rp.ScrollToBeginning()
+}
+
+// render renders the current entry, wrapped to the current width of the pane.
+func (rp *readingPane) render() {
+	entry := rp.entry
+	if entry == nil {
 		return
 	}
-	if url := entry.URL; url != nil {
-		rp.SetText(*url)
-		return
+
+	width := rp.width
+	if width <= 0 {
+		width = defaultRenderWidth
+	}
+
+	var base *url.URL
+	if entry.URL != nil {
+		base, _ = url.Parse(*entry.URL)
+	}
+
+	var body string
+	switch {
+	case entry.Content != nil && strings.TrimSpace(*entry.Content) != "":
+		body = renderHTML(*entry.Content, width, base, rp.theme, rp.lang)
+	case entry.Description != nil && strings.TrimSpace(*entry.Description) != "":
+		body = renderHTML(*entry.Description, width, base, rp.theme, rp.lang)
+	default:
+		body = styleText(rp.lang.noContentText, textStyle{fg: rp.theme.contentDimFG.CSS()})
+	}
+
+	rp.SetText(rp.entryHeader(entry) + "\n" + body)
+}
+
+// getCurrentEntry returns the entry shown in the pane, if any.
+func (rp *readingPane) getCurrentEntry() *entity.Entry {
+	return rp.entry
+}
+
+// refreshEntry shows the given updated entry if the pane is showing an earlier version of it.
+func (rp *readingPane) refreshEntry(entry *entity.Entry) {
+	if rp.entry != nil && rp.entry.ID == entry.ID {
+		rp.entry = entry
+		rp.render()
 	}
-	rp.SetText("<no-content>")
+}
+
+// removeFeed clears the pane if it shows an entry of the feed with the given ID.
+func (rp *readingPane) removeFeed(id entity.ID) {
+	if rp.entry != nil && rp.entry.FeedID == id {
+		rp.entry = nil
+		rp.feedTitle = ""
+		rp.Clear()
+	}
+}
+
+// entryHeader formats the title, feed, authors, date, categories, enclosures, and URL of the
+// entry, for display above its content.
+func (rp *readingPane) entryHeader(entry *entity.Entry) string {
+	var sb strings.Builder
+
+	label := func(text string) string {
+		return styleText(text+":", textStyle{fg: rp.theme.contentDimFG.CSS()})
+	}
+
+	if entry.Title != "" {
+		style := textStyle{fg: rp.theme.contentHeadingFG.CSS(), attrs: "b"}
+		fmt.Fprintf(&sb, "%s\n", styleText(tview.Escape(entry.Title), style))
+	}
+	if rp.feedTitle != "" {
+		fmt.Fprintf(&sb, "%s %s\n", label(rp.lang.feedLabel), tview.Escape(rp.feedTitle))
+	}
+	if len(entry.Authors) > 0 {
+		authors := tview.Escape(strings.Join(entry.Authors, ", "))
+		fmt.Fprintf(&sb, "%s %s\n", label(rp.lang.authorsLabel), authors)
+	}
+	date := entry.Published
+	if date == nil {
+		date = entry.Updated
+	}
+	if date != nil {
+		fmt.Fprintf(&sb, "%s %s\n", label(rp.lang.dateLabel), date.Local().Format(longDateFormat))
+	}
+	if len(entry.Categories) > 0 {
+		categories := tview.Escape(strings.Join(entry.Categories, ", "))
+		fmt.Fprintf(&sb, "%s %s\n", label(rp.lang.categoriesLabel), categories)
+	}
+	if len(entry.Enclosures) > 0 {
+		fmt.Fprintf(&sb, "%s\n", label(rp.lang.enclosuresLabel))
+		for _, enc := range entry.Enclosures {
+			details := make([]string, 0, 2)
+			if enc.MIMEType != nil {
+				details = append(details, *enc.MIMEType)
+			}
+			if enc.Length != nil {
+				details = append(details, fmtByteSize(*enc.Length))
+			}
+			encURL := tview.Escape(enc.URL)
+			if len(details) > 0 {
+				fmt.Fprintf(&sb, "  • %s (%s)\n", encURL, tview.Escape(strings.Join(details, ", ")))
+			} else {
+				fmt.Fprintf(&sb, "  • %s\n", encURL)
+			}
+		}
+	}
+	if entry.URL != nil {
+		fmt.Fprintf(&sb, "%s %s\n", label(rp.lang.linkLabel), tview.Escape(*entry.URL))
+	}
+
+	return sb.String()
+}
+
+// fmtByteSize formats the given number of bytes using binary prefixes.
+func fmtByteSize(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
 }
 
 func (rp *readingPane) makeDrawFuncs() (focusf, unfocusf drawFunc) {
@@ -64,6 +195,12 @@ func (rp *readingPane) makeDrawFuncs() (focusf, unfocusf drawFunc) {
 		}
 
 		return func(screen tcell.Screen, x int, y int, width int, height int) (int, int, int, int) {
+			// Rewrap the content when the pane is resized.
+			if innerWidth := width - 2; innerWidth != rp.width {
+				rp.width = innerWidth
+				rp.render()
+			}
+
 			style := rp.theme.lineStyle()
 			// Draw top and optionally bottom borders.
 			for cx := x; cx < x+width; cx++ {
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestReadingPaneSetEntryNoMetadata(t *testing.T) {
+	t.Parallel()
+
+	rp := newReadingPane(DarkTheme, langEN, 0)
+	rp.setEntry(&entity.Entry{Content: pointer("Hello")}, "")
+
+	assert.Equal(t, "\nHello", rp.GetText(true))
+}
+
+func TestReadingPaneSetEntryNoContent(t *testing.T) {
+	t.Parallel()
+
+	rp := newReadingPane(DarkTheme, langEN, 0)
+	rp.setEntry(&entity.Entry{Title: "Entry A", Description: pointer("<p>Summary</p>")}, "")
+	assert.Equal(t, "Entry A\n\nSummary", rp.GetText(true))
+
+	rp.setEntry(&entity.Entry{Title: "Entry A"}, "")
+	assert.Equal(t, "Entry A\n\nNo content", rp.GetText(true))
+}
+
+func TestReadingPaneSetEntryMetadata(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2026, 10, 1, 8, 0, 0, 0, time.Local)
+	rp := newReadingPane(DarkTheme, langEN, 0)
+	rp.setEntry(
+		&entity.Entry{
+			Title:      "Episode [1]",
+			URL:        pointer("http://a.com/ep1.html"),
+			Published:  &published,
+			Content:    pointer(`<p>Listen <a href="/ep1.mp3">here</a>.</p>`),
+			Authors:    []string{"Alice <alice@a.com>", "Bob"},
+			Categories: []string{"tech"},
+			Enclosures: []*entity.Enclosure{
+				{
+					URL:      "http://a.com/ep1.mp3",
+					MIMEType: pointer("audio/mpeg"),
+					Length:   pointer(uint64(3 * 1024 * 1024)),
+				},
+				{URL: "http://a.com/ep1.txt"},
+			},
+		},
+		"Podcast A",
+	)
+
+	want := `Episode [1]
+Feed: Podcast A
+By: Alice <alice@a.com>, Bob
+Date: ` + published.Format(longDateFormat) + `
+Tags: tech
+Attachments:
+  • http://a.com/ep1.mp3 (audio/mpeg, 3.0 MiB)
+  • http://a.com/ep1.txt
+Link: http://a.com/ep1.html
+
+Listen here[1].
+
+Links
+[1] http://a.com/ep1.mp3`
+	assert.Equal(t, want, rp.GetText(true))
+}
+
+func TestReadingPaneRefreshEntry(t *testing.T) {
+	t.Parallel()
+
+	rp := newReadingPane(DarkTheme, langEN, 0)
+	rp.setEntry(&entity.Entry{ID: 1, Content: pointer("Truncated")}, "")
+
+	rp.refreshEntry(&entity.Entry{ID: 2, Content: pointer("Other")})
+	assert.Equal(t, "\nTruncated", rp.GetText(true))
+
+	rp.refreshEntry(&entity.Entry{ID: 1, Content: pointer("Full")})
+	assert.Equal(t, "\nFull", rp.GetText(true))
+}
+
+func TestFmtByteSize(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "512 B", fmtByteSize(512))
+	assert.Equal(t, "1.5 KiB", fmtByteSize(1536))
+	assert.Equal(t, "2.0 GiB", fmtByteSize(2*1024*1024*1024))
+}
+
+// pointer returns a pointer to the value.
+func pointer[T any](value T) *T { return &value }
@@ -55,8 +55,9 @@ func newStatusBar(theme *Theme) *statusBar {
 	return &bar
 }
 
+// setChangedFunc sets the function called when the stats shown in the bar change. Events are shown
+// in the event loop, which redraws the display after, so they do not call it.
 func (b *statusBar) setChangedFunc(f func()) {
-	b.eventsWidget.SetChangedFunc(f)
 	b.readStatusWidget.SetChangedFunc(f)
 	b.lastPullWidget.SetChangedFunc(f)
 }
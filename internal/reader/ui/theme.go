@@ -33,6 +33,14 @@ type Theme struct {
 	feedGroupNodeNormal tcell.Color
 	feedGroupNodeDim    tcell.Color
 
+	entryUnreadFG       tcell.Color
+	entryUnreadNormalFG tcell.Color
+	entryUnreadDimFG    tcell.Color
+
+	entryReadFG       tcell.Color
+	entryReadNormalFG tcell.Color
+	entryReadDimFG    tcell.Color
+
 	statusBarFG       tcell.Color
 	statusBarNormalFG tcell.Color
 	statusBarDimFG    tcell.Color
@@ -52,6 +60,19 @@ type Theme struct {
 	popupTitleFG  tcell.Color
 	popupBorderFG tcell.Color
 
+	formLabelFG       tcell.Color
+	formFieldFG       tcell.Color
+	formFieldBG       tcell.Color
+	formButtonFG      tcell.Color
+	formButtonBG      tcell.Color
+	formButtonFocusBG tcell.Color
+
+	contentHeadingFG tcell.Color
+	contentLinkFG    tcell.Color
+	contentCodeFG    tcell.Color
+	contentQuoteFG   tcell.Color
+	contentDimFG     tcell.Color
+
 	wideViewMinWidth int
 }
 
@@ -67,6 +88,9 @@ func (t *Theme) dim() {
 	t.feedNode = t.feedNodeDim
 	t.feedNodeUnread = t.feedNodeUnreadDim
 	t.feedGroupNode = t.feedGroupNodeDim
+
+	t.entryUnreadFG = t.entryUnreadDimFG
+	t.entryReadFG = t.entryReadDimFG
 }
 
 func (t *Theme) normalize() {
@@ -81,6 +105,9 @@ func (t *Theme) normalize() {
 	t.feedNode = t.feedNodeNormal
 	t.feedNodeUnread = t.feedNodeUnreadNormal
 	t.feedGroupNode = t.feedGroupNodeNormal
+
+	t.entryUnreadFG = t.entryUnreadNormalFG
+	t.entryReadFG = t.entryReadNormalFG
 }
 
 func (t *Theme) lineStyle() tcell.Style {
@@ -121,6 +148,14 @@ var DarkTheme = &Theme{
 	feedGroupNodeNormal: tcell.ColorGrey,
 	feedGroupNodeDim:    darkForegroundDim,
 
+	entryUnreadFG:       tcell.ColorWhite,
+	entryUnreadNormalFG: tcell.ColorWhite,
+	entryUnreadDimFG:    darkForegroundDim,
+
+	entryReadFG:       tcell.ColorGray,
+	entryReadNormalFG: tcell.ColorGray,
+	entryReadDimFG:    darkForegroundDim,
+
 	statusBarFG:       tcell.ColorGray,
 	statusBarNormalFG: tcell.ColorGray,
 	statusBarDimFG:    darkForegroundDim,
@@ -140,6 +175,19 @@ var DarkTheme = &Theme{
 	popupBorderFG: tcell.ColorGray,
 	popupTitleFG:  tcell.ColorAqua,
 
+	formLabelFG:       tcell.ColorGray,
+	formFieldFG:       tcell.ColorWhite,
+	formFieldBG:       tcell.ColorDarkSlateGray,
+	formButtonFG:      tcell.ColorBlack,
+	formButtonBG:      tcell.ColorGray,
+	formButtonFocusBG: tcell.ColorAqua,
+
+	contentHeadingFG: tcell.ColorYellow,
+	contentLinkFG:    tcell.ColorDeepSkyBlue,
+	contentCodeFG:    tcell.ColorYellowGreen,
+	contentQuoteFG:   tcell.ColorGray,
+	contentDimFG:     tcell.ColorGray,
+
 	wideViewMinWidth: 150,
 }
 
@@ -44,9 +44,9 @@ func (m *MockDatastore) EXPECT() *MockDatastoreMockRecorder {
 }
 
 // AddFeed mocks base method.
-func (m *MockDatastore) AddFeed(ctx context.Context, feedURL string, title, desc *string, tags []string, isStarred *bool, pullTimeout *time.Duration) (*entity.Feed, bool, error) {
+func (m *MockDatastore) AddFeed(ctx context.Context, feedURL string, title, desc *string, tags []string, isStarred *bool, creds *entity.FeedCredentials, exec *entity.ExecOptions, scrape *entity.ScrapeSpec, pullTimeout *time.Duration) (*entity.Feed, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddFeed", ctx, feedURL, title, desc, tags, isStarred, pullTimeout)
+	ret := m.ctrl.Call(m, "AddFeed", ctx, feedURL, title, desc, tags, isStarred, creds, exec, scrape, pullTimeout)
 	ret0, _ := ret[0].(*entity.Feed)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
@@ -54,9 +54,9 @@ func (m *MockDatastore) AddFeed(ctx context.Context, feedURL string, title, desc
 }
 
 // AddFeed indicates an expected call of AddFeed.
-func (mr *MockDatastoreMockRecorder) AddFeed(ctx, feedURL, title, desc, tags, isStarred, pullTimeout any) *gomock.Call {
+func (mr *MockDatastoreMockRecorder) AddFeed(ctx, feedURL, title, desc, tags, isStarred, creds, exec, scrape, pullTimeout any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFeed", reflect.TypeOf((*MockDatastore)(nil).AddFeed), ctx, feedURL, title, desc, tags, isStarred, pullTimeout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFeed", reflect.TypeOf((*MockDatastore)(nil).AddFeed), ctx, feedURL, title, desc, tags, isStarred, creds, exec, scrape, pullTimeout)
 }
 
 // DeleteFeeds mocks base method.
@@ -73,6 +73,21 @@ func (mr *MockDatastoreMockRecorder) DeleteFeeds(ctx, ids any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeds", reflect.TypeOf((*MockDatastore)(nil).DeleteFeeds), ctx, ids)
 }
 
+// DiscoverFeeds mocks base method.
+func (m *MockDatastore) DiscoverFeeds(ctx context.Context, pageURL string, timeout *time.Duration) ([]*entity.FeedCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverFeeds", ctx, pageURL, timeout)
+	ret0, _ := ret[0].([]*entity.FeedCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverFeeds indicates an expected call of DiscoverFeeds.
+func (mr *MockDatastoreMockRecorder) DiscoverFeeds(ctx, pageURL, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverFeeds", reflect.TypeOf((*MockDatastore)(nil).DiscoverFeeds), ctx, pageURL, timeout)
+}
+
 // EditEntries mocks base method.
 func (m *MockDatastore) EditEntries(ctx context.Context, ops []*entity.EntryEditOp) ([]*entity.Entry, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +133,21 @@ func (mr *MockDatastoreMockRecorder) ExportSubscription(ctx, title any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSubscription", reflect.TypeOf((*MockDatastore)(nil).ExportSubscription), ctx, title)
 }
 
+// ExtractEntryContent mocks base method.
+func (m *MockDatastore) ExtractEntryContent(ctx context.Context, id entity.ID) (*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractEntryContent", ctx, id)
+	ret0, _ := ret[0].(*entity.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractEntryContent indicates an expected call of ExtractEntryContent.
+func (mr *MockDatastoreMockRecorder) ExtractEntryContent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractEntryContent", reflect.TypeOf((*MockDatastore)(nil).ExtractEntryContent), ctx, id)
+}
+
 // GetEntry mocks base method.
 func (m *MockDatastore) GetEntry(ctx context.Context, id entity.ID) (*entity.Entry, error) {
 	m.ctrl.T.Helper()
@@ -194,18 +224,107 @@ func (mr *MockDatastoreMockRecorder) ListFeeds(ctx, maxEntriesPerFeed any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockDatastore)(nil).ListFeeds), ctx, maxEntriesPerFeed)
 }
 
+// ListWebSubSubscriptions mocks base method.
+func (m *MockDatastore) ListWebSubSubscriptions(ctx context.Context) ([]*entity.WebSubSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebSubSubscriptions", ctx)
+	ret0, _ := ret[0].([]*entity.WebSubSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebSubSubscriptions indicates an expected call of ListWebSubSubscriptions.
+func (mr *MockDatastoreMockRecorder) ListWebSubSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebSubSubscriptions", reflect.TypeOf((*MockDatastore)(nil).ListWebSubSubscriptions), ctx)
+}
+
+// PruneEntries mocks base method.
+func (m *MockDatastore) PruneEntries(ctx context.Context, feedIDs []entity.ID, dryRun bool) ([]*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneEntries", ctx, feedIDs, dryRun)
+	ret0, _ := ret[0].([]*entity.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEntries indicates an expected call of PruneEntries.
+func (mr *MockDatastoreMockRecorder) PruneEntries(ctx, feedIDs, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEntries", reflect.TypeOf((*MockDatastore)(nil).PruneEntries), ctx, feedIDs, dryRun)
+}
+
 // PullFeeds mocks base method.
-func (m *MockDatastore) PullFeeds(ctx context.Context, ids []entity.ID, entryReadStatus *bool, maxEntriesPerFeed *uint32, timeoutPerFeed *time.Duration) <-chan entity.PullResult {
+func (m *MockDatastore) PullFeeds(ctx context.Context, ids []entity.ID, entryReadStatus *bool, maxEntriesPerFeed *uint32, timeoutPerFeed *time.Duration, force bool) <-chan entity.PullResult {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PullFeeds", ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed)
+	ret := m.ctrl.Call(m, "PullFeeds", ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed, force)
 	ret0, _ := ret[0].(<-chan entity.PullResult)
 	return ret0
 }
 
 // PullFeeds indicates an expected call of PullFeeds.
-func (mr *MockDatastoreMockRecorder) PullFeeds(ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed any) *gomock.Call {
+func (mr *MockDatastoreMockRecorder) PullFeeds(ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockDatastore)(nil).PullFeeds), ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed, force)
+}
+
+// ScrapeFeed mocks base method.
+func (m *MockDatastore) ScrapeFeed(ctx context.Context, pageURL string, spec *entity.ScrapeSpec, timeout *time.Duration) ([]*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScrapeFeed", ctx, pageURL, spec, timeout)
+	ret0, _ := ret[0].([]*entity.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScrapeFeed indicates an expected call of ScrapeFeed.
+func (mr *MockDatastoreMockRecorder) ScrapeFeed(ctx, pageURL, spec, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrapeFeed", reflect.TypeOf((*MockDatastore)(nil).ScrapeFeed), ctx, pageURL, spec, timeout)
+}
+
+// SearchEntries mocks base method.
+func (m *MockDatastore) SearchEntries(ctx context.Context, query string, feedIDs []entity.ID, tags []string, maxResults *uint32) ([]*entity.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEntries", ctx, query, feedIDs, tags, maxResults)
+	ret0, _ := ret[0].([]*entity.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntries indicates an expected call of SearchEntries.
+func (mr *MockDatastoreMockRecorder) SearchEntries(ctx, query, feedIDs, tags, maxResults any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntries", reflect.TypeOf((*MockDatastore)(nil).SearchEntries), ctx, query, feedIDs, tags, maxResults)
+}
+
+// StorePushedFeed mocks base method.
+func (m *MockDatastore) StorePushedFeed(ctx context.Context, feedID entity.ID, content []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePushedFeed", ctx, feedID, content)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StorePushedFeed indicates an expected call of StorePushedFeed.
+func (mr *MockDatastoreMockRecorder) StorePushedFeed(ctx, feedID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePushedFeed", reflect.TypeOf((*MockDatastore)(nil).StorePushedFeed), ctx, feedID, content)
+}
+
+// UpdateWebSubSubscription mocks base method.
+func (m *MockDatastore) UpdateWebSubSubscription(ctx context.Context, sub *entity.WebSubSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebSubSubscription", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebSubSubscription indicates an expected call of UpdateWebSubSubscription.
+func (mr *MockDatastoreMockRecorder) UpdateWebSubSubscription(ctx, sub any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockDatastore)(nil).PullFeeds), ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebSubSubscription", reflect.TypeOf((*MockDatastore)(nil).UpdateWebSubSubscription), ctx, sub)
 }
 
 // MockeditableTable is a mock of editableTable interface.
@@ -39,9 +39,13 @@ func unwrapError(err error) (codes.Code, error) {
 		return codes.Unknown, nil
 	}
 	switch cerr := err.(type) {
-	case entity.FeedNotFoundError, entity.EntryNotFoundError:
+	case entity.FeedNotFoundError, entity.EntryNotFoundError, entity.NoFeedFoundError,
+		entity.NoArticleContentError:
 		return codes.NotFound, cerr
-	case xml.UnmarshalError, *xml.SyntaxError:
+	case entity.AmbiguousFeedError, entity.NoEntryURLError:
+		return codes.FailedPrecondition, cerr
+	case xml.UnmarshalError, *xml.SyntaxError, entity.InvalidSearchQueryError,
+		entity.InvalidFeedCredentialsError, entity.InvalidFeedSourceError:
 		return codes.InvalidArgument, cerr
 	default:
 		var (
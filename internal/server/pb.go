@@ -6,6 +6,7 @@ package server
 import (
 	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bow/neon/api"
@@ -25,6 +26,15 @@ func toFeedPb(feed *entity.Feed) *api.Feed {
 		LastPullTime: timestamppb.New(feed.LastPulled),
 		UpdateTime:   toTimestampPb(feed.Updated),
 		Entries:      toEntryPbs(feed.EntriesSlice()),
+		PullInterval: toDurationPb(feed.PullInterval),
+		Retention:    toRetentionPolicyPb(&feed.Retention),
+
+		ConsecutiveFailures: feed.ConsecutiveFailures,
+		LastError:           feed.LastError,
+		BackoffUntil:        toTimestampPb(feed.BackoffUntil),
+		IsPaused:            feed.IsPaused,
+		NextPullTime:        toTimestampPb(feed.NextPullTime),
+		FetchContent:        feed.FetchContent,
 	}
 }
 
@@ -36,6 +46,18 @@ func toFeedPbs(feeds []*entity.Feed) []*api.Feed {
 	return pbs
 }
 
+func toFeedCandidatePbs(cands []*entity.FeedCandidate) []*api.DiscoverFeedsResponse_Candidate {
+	pbs := make([]*api.DiscoverFeedsResponse_Candidate, len(cands))
+	for i, cand := range cands {
+		pbs[i] = &api.DiscoverFeedsResponse_Candidate{
+			Url:    cand.URL,
+			Title:  cand.Title,
+			Format: cand.Format,
+		}
+	}
+	return pbs
+}
+
 func fromFeedEditOpPb(pb *api.EditFeedsRequest_Op) *entity.FeedEditOp {
 	return &entity.FeedEditOp{
 		ID:          pb.Id,
@@ -43,6 +65,12 @@ func fromFeedEditOpPb(pb *api.EditFeedsRequest_Op) *entity.FeedEditOp {
 		Description: pb.Fields.Description,
 		Tags:        &pb.Fields.Tags,
 		IsStarred:   pb.Fields.IsStarred,
+
+		PullInterval: entity.FromDurationPb(pb.Fields.PullInterval),
+		Retention:    entity.FromRetentionPolicyPb(pb.Fields.Retention),
+		IsPaused:     pb.Fields.IsPaused,
+		Credentials:  fromFeedCredentialsPb(pb.Fields.Credentials),
+		FetchContent: pb.Fields.FetchContent,
 	}
 }
 
@@ -54,6 +82,40 @@ func fromFeedEditOpPbs(pbs []*api.EditFeedsRequest_Op) []*entity.FeedEditOp {
 	return ops
 }
 
+func fromFeedCredentialsPb(pb *api.FeedCredentials) *entity.FeedCredentials {
+	if pb == nil {
+		return nil
+	}
+	return &entity.FeedCredentials{
+		Username: pb.Username,
+		Password: pb.Password,
+		Token:    pb.Token,
+	}
+}
+
+func fromExecOptionsPb(pb *api.ExecOptions) *entity.ExecOptions {
+	if pb == nil {
+		return nil
+	}
+	return &entity.ExecOptions{
+		Dir:     pb.Dir,
+		Timeout: entity.FromDurationPb(pb.GetTimeout()),
+	}
+}
+
+func fromScrapeSpecPb(pb *api.ScrapeSpec) *entity.ScrapeSpec {
+	if pb == nil {
+		return nil
+	}
+	return &entity.ScrapeSpec{
+		Item:    pb.GetItem(),
+		Title:   pb.GetTitle(),
+		Link:    pb.Link,
+		Date:    pb.Date,
+		Summary: pb.Summary,
+	}
+}
+
 func toEntryPb(entry *entity.Entry) *api.Entry {
 	return &api.Entry{
 		Id:           entry.ID,
@@ -67,9 +129,27 @@ func toEntryPb(entry *entity.Entry) *api.Entry {
 		Url:          entry.URL,
 		PubTime:      toTimestampPb(entry.Published),
 		UpdateTime:   toTimestampPb(entry.Updated),
+		Authors:      entry.Authors,
+		Categories:   entry.Categories,
+		Enclosures:   toEnclosurePbs(entry.Enclosures),
 	}
 }
 
+func toEnclosurePbs(enclosures []*entity.Enclosure) []*api.Entry_Enclosure {
+	if len(enclosures) == 0 {
+		return nil
+	}
+	pbs := make([]*api.Entry_Enclosure, len(enclosures))
+	for i, enc := range enclosures {
+		pbs[i] = &api.Entry_Enclosure{
+			Url:      enc.URL,
+			MimeType: enc.MIMEType,
+			Length:   enc.Length,
+		}
+	}
+	return pbs
+}
+
 func toEntryPbs(entries []*entity.Entry) []*api.Entry {
 	pbs := make([]*api.Entry, len(entries))
 	for i, entry := range entries {
@@ -94,6 +174,22 @@ func fromEntryEditOpPbs(pbs []*api.EditEntriesRequest_Op) []*entity.EntryEditOp
 	return ops
 }
 
+func toSearchResultPb(result *entity.SearchResult) *api.SearchEntriesResponse_Result {
+	return &api.SearchEntriesResponse_Result{
+		Entry:   toEntryPb(result.Entry),
+		Snippet: result.Snippet,
+		Rank:    result.Rank,
+	}
+}
+
+func toSearchResultPbs(results []*entity.SearchResult) []*api.SearchEntriesResponse_Result {
+	pbs := make([]*api.SearchEntriesResponse_Result, len(results))
+	for i, result := range results {
+		pbs[i] = toSearchResultPb(result)
+	}
+	return pbs
+}
+
 func toStatsPb(stats *entity.Stats) *api.GetStatsResponse_Stats {
 	return &api.GetStatsResponse_Stats{
 		NumFeeds:             stats.NumFeeds,
@@ -110,3 +206,35 @@ func toTimestampPb(v *time.Time) *timestamppb.Timestamp {
 	}
 	return timestamppb.New(*v)
 }
+
+func toRetentionPolicyPb(policy *entity.RetentionPolicy) *api.RetentionPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &api.RetentionPolicy{
+		MaxEntries: policy.MaxEntries,
+		MaxReadAge: toDurationPb(policy.MaxReadAge),
+	}
+}
+
+func toDurationPb(v *time.Duration) *durationpb.Duration {
+	if v == nil {
+		return nil
+	}
+	return durationpb.New(*v)
+}
+
+func toPullStatusPb(status entity.PullStatus) api.PullFeedsResponse_Status {
+	switch status {
+	case entity.PullSuccess:
+		return api.PullFeedsResponse_SUCCESS
+	case entity.PullNotModified:
+		return api.PullFeedsResponse_NOT_MODIFIED
+	case entity.PullSkipped:
+		return api.PullFeedsResponse_SKIPPED
+	case entity.PullFail:
+		return api.PullFeedsResponse_FAIL
+	default:
+		return api.PullFeedsResponse_UNSPECIFIED
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand/v2"
+	"time"
+
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+)
+
+// defaultSchedulerTick is the default interval between checks for feeds that are due a pull.
+const defaultSchedulerTick = 1 * time.Minute
+
+// scheduler periodically pulls feeds once per pull interval. Each feed is pulled at its own offset
+// within its interval, so that feeds with the same interval are spread out instead of being pulled
+// together.
+type scheduler struct {
+	ds datastore.Datastore
+
+	// interval is the default interval between pulls, used for feeds without their own value.
+	interval time.Duration
+	// tick is the interval between checks for due feeds.
+	tick time.Duration
+
+	// lastAttempts records when the scheduler last tried to pull a feed, so that failing feeds
+	// (whose last pull times do not change) are not retried on every tick.
+	lastAttempts map[entity.ID]time.Time
+
+	now    func() time.Time
+	jitter func(time.Duration) time.Duration
+	offset func(entity.ID, time.Duration) time.Duration
+}
+
+func newScheduler(ds datastore.Datastore, interval time.Duration) *scheduler {
+	tick := defaultSchedulerTick
+	if interval < tick {
+		tick = interval
+	}
+	return &scheduler{
+		ds:           ds,
+		interval:     interval,
+		tick:         tick,
+		lastAttempts: make(map[entity.ID]time.Time),
+		now:          time.Now,
+		jitter: func(upper time.Duration) time.Duration {
+			if upper <= 0 {
+				return 0
+			}
+			return rand.N(upper) // #nosec G404
+		},
+		offset: feedOffset,
+	}
+}
+
+// run starts the pull loop, returning only when the given context is done. The first check is
+// delayed by a random duration of up to one tick, to avoid all servers and feeds starting in
+// lockstep.
+func (s *scheduler) run(ctx context.Context) {
+	pkgLogger.Info().
+		Dur("interval", s.interval).
+		Msg("starting pull scheduler")
+
+	timer := time.NewTimer(s.jitter(s.tick))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			pkgLogger.Debug().Msg("stopped pull scheduler")
+			return
+		case <-timer.C:
+			s.pullDue(ctx)
+			timer.Reset(s.tick)
+		}
+	}
+}
+
+// pullDue pulls all feeds that are due a pull.
+func (s *scheduler) pullDue(ctx context.Context) {
+
+	maxEntries := uint32(0)
+	feeds, err := s.ds.ListFeeds(ctx, &maxEntries)
+	if err != nil {
+		pkgLogger.Error().Err(err).Msg("scheduler failed to list feeds")
+		return
+	}
+
+	ids := s.dueFeedIDs(feeds)
+	if len(ids) == 0 {
+		return
+	}
+
+	pkgLogger.Debug().Int("num_feeds", len(ids)).Msg("pulling scheduled feeds")
+
+	var (
+		nok, nfail int
+		now        = s.now()
+	)
+	for _, id := range ids {
+		s.lastAttempts[id] = now
+	}
+	for pr := range s.ds.PullFeeds(ctx, ids, nil, &maxEntries, nil, false) {
+		if err := pr.Error(); err != nil {
+			nfail++
+			pkgLogger.Warn().Err(err).Str("url", pr.URL()).Msg("scheduled pull failed")
+			continue
+		}
+		nok++
+	}
+
+	pkgLogger.Info().
+		Int("num_ok", nok).
+		Int("num_fail", nfail).
+		Msg("pulled scheduled feeds")
+}
+
+// dueFeedIDs returns the IDs of feeds that have not been pulled since their latest scheduled pull
+// time, and which are due a pull according to their refresh hints.
+func (s *scheduler) dueFeedIDs(feeds []*entity.Feed) []entity.ID {
+
+	var (
+		now  = s.now()
+		ids  = make([]entity.ID, 0)
+		seen = make(map[entity.ID]struct{}, len(feeds))
+	)
+	for _, feed := range feeds {
+		seen[feed.ID] = struct{}{}
+
+		interval := s.interval
+		if feed.PullInterval != nil {
+			interval = *feed.PullInterval
+		}
+
+		last := feed.LastPulled
+		if attempt, exists := s.lastAttempts[feed.ID]; exists && attempt.After(last) {
+			last = attempt
+		}
+		if last.Before(s.scheduledTime(feed.ID, interval, now)) && feed.IsDue(now) {
+			ids = append(ids, feed.ID)
+		}
+	}
+
+	// Forget attempts of feeds that no longer exist.
+	for id := range s.lastAttempts {
+		if _, exists := seen[id]; !exists {
+			delete(s.lastAttempts, id)
+		}
+	}
+
+	return ids
+}
+
+// scheduledTime returns the latest time, up to the given time, at which the feed of the given ID is
+// scheduled to be pulled. Feeds are scheduled once per interval, at their offset within it.
+func (s *scheduler) scheduledTime(
+	id entity.ID,
+	interval time.Duration,
+	now time.Time,
+) time.Time {
+	if interval <= 0 {
+		return now
+	}
+	offset := s.offset(id, interval)
+	return now.Add(-offset).Truncate(interval).Add(offset)
+}
+
+// feedOffset returns the offset of the feed of the given ID within the given interval. The offset
+// is derived from a hash of the ID, so that it is stable across server restarts.
+func feedOffset(id entity.ID, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_ = binary.Write(h, binary.LittleEndian, uint64(id))
+	return time.Duration(h.Sum64() % uint64(interval))
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newTestScheduler(
+	t *testing.T,
+	interval time.Duration,
+	now time.Time,
+) (*scheduler, *MockDatastore) {
+	t.Helper()
+
+	ds := NewMockDatastore(gomock.NewController(t))
+	s := newScheduler(ds, interval)
+	s.now = func() time.Time { return now }
+	s.jitter = func(time.Duration) time.Duration { return 0 }
+	s.offset = func(entity.ID, time.Duration) time.Duration { return 0 }
+
+	return s, ds
+}
+
+func TestSchedulerPullDueOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	now := time.Date(2026, 10, 18, 12, 45, 0, 0, time.UTC)
+	s, ds := newTestScheduler(t, time.Hour, now)
+
+	feeds := []*entity.Feed{
+		// Not pulled since 12:00, when it was scheduled at the default interval.
+		{ID: 1, LastPulled: now.Add(-2 * time.Hour)},
+		// Pulled since 12:00.
+		{ID: 2, LastPulled: now.Add(-30 * time.Minute)},
+		// Not pulled since 12:40, when it was scheduled at its own interval.
+		{ID: 3, LastPulled: now.Add(-30 * time.Minute), PullInterval: pointer(10 * time.Minute)},
+		// Pulled since 00:00, when it was scheduled at its own interval.
+		{ID: 4, LastPulled: now.Add(-2 * time.Hour), PullInterval: pointer(24 * time.Hour)},
+	}
+	ch := make(chan entity.PullResult, 2)
+	ch <- entity.NewPullResultFromFeed(pointer("http://a.com/feed.xml"), feeds[0])
+	ch <- entity.NewPullResultFromError(pointer("http://c.com/feed.xml"), fmt.Errorf("nope"))
+	close(ch)
+
+	ds.EXPECT().
+		ListFeeds(gomock.Any(), pointer(uint32(0))).
+		Return(feeds, nil)
+	ds.EXPECT().
+		PullFeeds(gomock.Any(), []entity.ID{1, 3}, nil, pointer(uint32(0)), nil, false).
+		Return(ch)
+
+	s.pullDue(context.Background())
+
+	a.Equal(map[entity.ID]time.Time{1: now, 3: now}, s.lastAttempts)
+}
+
+func TestSchedulerPullDueNoneDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 45, 0, 0, time.UTC)
+	s, ds := newTestScheduler(t, time.Hour, now)
+
+	ds.EXPECT().
+		ListFeeds(gomock.Any(), gomock.Any()).
+		Return([]*entity.Feed{{ID: 1, LastPulled: now.Add(-time.Minute)}}, nil)
+
+	s.pullDue(context.Background())
+}
+
+func TestSchedulerPullDueListErr(t *testing.T) {
+	t.Parallel()
+
+	s, ds := newTestScheduler(t, time.Hour, time.Now())
+
+	ds.EXPECT().
+		ListFeeds(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("oh no"))
+
+	s.pullDue(context.Background())
+}
+
+func TestSchedulerDueFeedIDsSkipsRecentAttempts(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	now := time.Date(2026, 10, 18, 12, 45, 0, 0, time.UTC)
+	s, _ := newTestScheduler(t, time.Hour, now)
+	s.lastAttempts[1] = now.Add(-10 * time.Minute)
+	s.lastAttempts[9] = now.Add(-10 * time.Minute)
+
+	feeds := []*entity.Feed{
+		{ID: 1, LastPulled: now.Add(-2 * time.Hour)},
+		{ID: 2, LastPulled: now.Add(-2 * time.Hour)},
+	}
+
+	a.Equal([]entity.ID{2}, s.dueFeedIDs(feeds))
+	a.NotContains(s.lastAttempts, entity.ID(9))
+}
+
+func TestSchedulerDueFeedIDsSkipsNotDue(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	now := time.Date(2026, 10, 18, 12, 45, 0, 0, time.UTC)
+	s, _ := newTestScheduler(t, time.Hour, now)
+
+	feeds := []*entity.Feed{
+		{ID: 1, LastPulled: now.Add(-2 * time.Hour), NextPullTime: pointer(now.Add(time.Hour))},
+		{ID: 2, LastPulled: now.Add(-2 * time.Hour), NextPullTime: pointer(now)},
+		{ID: 3, LastPulled: now.Add(-2 * time.Hour)},
+	}
+
+	a.Equal([]entity.ID{2, 3}, s.dueFeedIDs(feeds))
+}
+
+func TestSchedulerDueFeedIDsSpreadsFeeds(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s := newScheduler(nil, time.Hour)
+
+	feeds := make([]*entity.Feed, 0, 100)
+	for id := range entity.ID(100) {
+		feeds = append(feeds, &entity.Feed{ID: id + 1, LastPulled: start})
+	}
+
+	var (
+		pulls    = make(map[entity.ID]int)
+		maxBatch int
+	)
+	for now := start.Add(time.Minute); !now.After(start.Add(time.Hour)); now = now.Add(time.Minute) {
+		s.now = func() time.Time { return now }
+		ids := s.dueFeedIDs(feeds)
+		for _, id := range ids {
+			pulls[id]++
+			s.lastAttempts[id] = now
+		}
+		maxBatch = max(maxBatch, len(ids))
+	}
+
+	// Each feed is pulled once per interval, and not all at the same time.
+	a.Len(pulls, len(feeds))
+	for id, n := range pulls {
+		a.Equal(1, n, "feed %d", id)
+	}
+	a.Less(maxBatch, 20)
+}
+
+func TestFeedOffset(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	offsets := make(map[time.Duration]struct{})
+	for id := range entity.ID(10) {
+		offset := feedOffset(id, time.Hour)
+		a.GreaterOrEqual(offset, time.Duration(0))
+		a.Less(offset, time.Hour)
+		a.Equal(offset, feedOffset(id, time.Hour))
+		offsets[offset] = struct{}{}
+	}
+	a.Len(offsets, 10)
+	a.Zero(feedOffset(1, 0))
+}
+
+func TestSchedulerRunStops(t *testing.T) {
+	t.Parallel()
+
+	s, ds := newTestScheduler(t, time.Hour, time.Now())
+	s.tick = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ds.EXPECT().
+		ListFeeds(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *uint32) ([]*entity.Feed, error) {
+			cancel()
+			return nil, nil
+		}).
+		MinTimes(1)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.run(ctx)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("scheduler did not stop")
+	}
+}
@@ -8,10 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/rs/zerolog"
@@ -23,6 +25,7 @@ import (
 	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal"
 	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
 )
 
 const (
@@ -38,9 +41,18 @@ type Server struct {
 	stoppedCh  chan struct{}
 
 	healthSvc *health.Server
+	scheduler *scheduler
+
+	websub    *websubManager
+	websubLis net.Listener
 }
 
-func newServer(lis net.Listener, grpcServer *grpc.Server, ds datastore.Datastore) *Server {
+func newServer(
+	lis net.Listener,
+	grpcServer *grpc.Server,
+	ds datastore.Datastore,
+	pullInterval time.Duration,
+) *Server {
 
 	svc := service{ds: ds}
 	api.RegisterNeonServer(grpcServer, &svc)
@@ -83,6 +95,9 @@ func newServer(lis net.Listener, grpcServer *grpc.Server, ds datastore.Datastore
 		stoppedCh:  stoppedCh,
 		healthSvc:  healthSvc,
 	}
+	if pullInterval > 0 {
+		s.scheduler = newScheduler(ds, pullInterval)
+	}
 
 	return &s
 }
@@ -102,6 +117,32 @@ func (s *Server) Serve(ctx context.Context) error {
 
 	s.healthSvc.SetServingStatus(s.ServiceName(), healthapi.HealthCheckResponse_NOT_SERVING)
 
+	if s.scheduler != nil {
+		sctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go s.scheduler.run(sctx)
+	}
+
+	if s.websub != nil {
+		wctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		hs := http.Server{
+			Handler:           s.websub,
+			ReadHeaderTimeout: 10 * time.Second,
+			BaseContext:       func(net.Listener) context.Context { return wctx },
+		}
+		defer hs.Close()
+		go func() {
+			if err := hs.Serve(s.websubLis); !errors.Is(err, http.ErrServerClosed) {
+				pkgLogger.Error().Err(err).Msg("websub callback server failed")
+			}
+		}()
+		pkgLogger.Info().
+			Str("addr", s.websubLis.Addr().String()).
+			Msg("websub callback server listening")
+		go s.websub.run(wctx)
+	}
+
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -135,6 +176,18 @@ type Builder struct {
 	addr       string
 	ds         datastore.Datastore
 	sqlitePath string
+
+	pullInterval    time.Duration
+	pullConcurrency int
+	perHost         int
+	hostDelay       *time.Duration
+	retention       entity.RetentionPolicy
+	fetcherConfig   datastore.FetcherConfig
+	resolvers       datastore.URLResolvers
+	allowLocal      bool
+
+	websubAddr    string
+	websubBaseURL string
 }
 
 func NewBuilder() *Builder {
@@ -164,6 +217,69 @@ func (b *Builder) Datastore(ds datastore.Datastore) *Builder {
 	return b
 }
 
+// PullInterval sets the default interval between scheduled feed pulls. A zero value disables
+// scheduled pulls.
+func (b *Builder) PullInterval(interval time.Duration) *Builder {
+	b.pullInterval = interval
+	return b
+}
+
+// PullConcurrency sets the maximum number of feeds fetched concurrently by the SQLite datastore.
+// A zero value uses the datastore default.
+func (b *Builder) PullConcurrency(n int) *Builder {
+	b.pullConcurrency = n
+	return b
+}
+
+// PullConcurrencyPerHost sets the maximum number of feeds fetched concurrently from the same host
+// by the SQLite datastore. A zero value uses the datastore default.
+func (b *Builder) PullConcurrencyPerHost(n int) *Builder {
+	b.perHost = n
+	return b
+}
+
+// PullHostDelay sets the minimum delay between requests to the same host by the SQLite datastore.
+func (b *Builder) PullHostDelay(delay time.Duration) *Builder {
+	b.hostDelay = &delay
+	return b
+}
+
+// RetentionPolicy sets the global entry retention policy of the SQLite datastore.
+func (b *Builder) RetentionPolicy(policy entity.RetentionPolicy) *Builder {
+	b.retention = policy
+	return b
+}
+
+// FetcherConfig sets how the SQLite datastore fetches feeds.
+func (b *Builder) FetcherConfig(cfg datastore.FetcherConfig) *Builder {
+	b.fetcherConfig = cfg
+	return b
+}
+
+// URLResolvers sets the resolvers through which the SQLite datastore rewrites the page URLs of
+// added feeds. A nil value uses the built-in resolvers.
+func (b *Builder) URLResolvers(resolvers datastore.URLResolvers) *Builder {
+	b.resolvers = resolvers
+	return b
+}
+
+// AllowLocalFeeds sets whether the SQLite datastore may add, pull, and discover local feed files,
+// commands, and mailboxes. They are not allowed by default, as they would let clients run
+// commands and read files on the server.
+func (b *Builder) AllowLocalFeeds(allow bool) *Builder {
+	b.allowLocal = allow
+	return b
+}
+
+// WebSub enables WebSub push subscriptions, serving the hub callbacks on the given TCP address.
+// The base URL is the public URL of the callback server, through which hubs reach it; if empty,
+// it is derived from the listening address. An empty address disables WebSub.
+func (b *Builder) WebSub(addr string, baseURL string) *Builder {
+	b.websubAddr = addr
+	b.websubBaseURL = baseURL
+	return b
+}
+
 func (b *Builder) Build() (*Server, error) {
 
 	var netw string
@@ -190,9 +306,22 @@ func (b *Builder) Build() (*Server, error) {
 	ds := b.ds
 	if sp := b.sqlitePath; sp != "" {
 		pkgLogger.Info().Str("path", sp).Msgf("initializing sqlite datastore")
-		if ds, err = datastore.NewSQLite(sp); err != nil {
+		db, err := datastore.NewSQLite(sp)
+		if err != nil {
 			return nil, fmt.Errorf("server build: %w", err)
 		}
+		db.SetPullConcurrency(b.pullConcurrency)
+		db.SetPullConcurrencyPerHost(b.perHost)
+		if b.hostDelay != nil {
+			db.SetPullHostDelay(*b.hostDelay)
+		}
+		db.SetRetentionPolicy(b.retention)
+		db.SetFetcherConfig(b.fetcherConfig)
+		if b.resolvers != nil {
+			db.SetURLResolvers(b.resolvers)
+		}
+		db.SetAllowLocalFeeds(b.allowLocal)
+		ds = db
 	}
 
 	ilogger := getLogger().With().
@@ -209,7 +338,21 @@ func (b *Builder) Build() (*Server, error) {
 			logging.StreamServerInterceptor(internal.InterceptorLogger(ilogger)),
 		),
 	)
-	s := newServer(lis, grpcs, ds)
+	s := newServer(lis, grpcs, ds, b.pullInterval)
+
+	if b.websubAddr != "" {
+		wlis, err := lc.Listen(b.ctx, "tcp", b.websubAddr)
+		if err != nil {
+			_ = lis.Close()
+			return nil, fmt.Errorf("server build: %w", err)
+		}
+		baseURL := b.websubBaseURL
+		if baseURL == "" {
+			baseURL = "http://" + wlis.Addr().String()
+		}
+		s.websub = newWebSubManager(ds, baseURL)
+		s.websubLis = wlis
+	}
 
 	return s, nil
 }
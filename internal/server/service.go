@@ -36,6 +36,9 @@ func (svc *service) AddFeed(
 		req.Description,
 		req.GetTags(),
 		req.IsStarred,
+		fromFeedCredentialsPb(req.GetCredentials()),
+		fromExecOptionsPb(req.GetExec()),
+		fromScrapeSpecPb(req.GetScrape()),
 		nil,
 	)
 	if err != nil {
@@ -47,6 +50,22 @@ func (svc *service) AddFeed(
 	return &rsp, nil
 }
 
+// DiscoverFeeds satisfies the service API.
+func (svc *service) DiscoverFeeds(
+	ctx context.Context,
+	req *api.DiscoverFeedsRequest,
+) (*api.DiscoverFeedsResponse, error) {
+
+	cands, err := svc.ds.DiscoverFeeds(ctx, req.GetUrl(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.DiscoverFeedsResponse{Candidates: toFeedCandidatePbs(cands)}
+
+	return &rsp, nil
+}
+
 // ListFeeds satisfies the service API.
 func (svc *service) ListFeeds(
 	ctx context.Context,
@@ -104,20 +123,21 @@ func (svc *service) PullFeeds(
 ) error {
 
 	convert := func(pr entity.PullResult) (*api.PullFeedsResponse, error) {
+		url := pr.URL()
+		rsp := api.PullFeedsResponse{Url: url, Status: toPullStatusPb(pr.Status())}
 		if err := pr.Error(); err != nil {
-			url := pr.URL()
 			if url == "" {
 				return nil, err
 			}
 			rspErr := err.Error()
-			rsp := api.PullFeedsResponse{Url: url, Error: &rspErr}
-			return &rsp, nil
+			rsp.Error = &rspErr
+		}
+		if feed := pr.Feed(); feed != nil {
+			rsp.Feed = toFeedPb(feed)
 		}
-		feed := pr.Feed()
-		if feed == nil {
-			return nil, nil
+		if d := pr.Throttled(); d > 0 {
+			rsp.Throttled = toDurationPb(&d)
 		}
-		rsp := api.PullFeedsResponse{Url: pr.URL(), Feed: toFeedPb(feed)}
 
 		return &rsp, nil
 	}
@@ -133,6 +153,7 @@ func (svc *service) PullFeeds(
 		nil,
 		req.MaxEntriesPerFeed,
 		nil,
+		req.GetForce(),
 	)
 
 	for pr := range ch {
@@ -140,9 +161,6 @@ func (svc *service) PullFeeds(
 		if err != nil {
 			return err
 		}
-		if payload == nil {
-			continue
-		}
 		if err := stream.Send(payload); err != nil {
 			return err
 		}
@@ -219,6 +237,60 @@ func (svc *service) GetEntry(
 	return &rsp, nil
 }
 
+// ExtractEntryContent satisfies the service API.
+func (svc *service) ExtractEntryContent(
+	ctx context.Context,
+	req *api.ExtractEntryContentRequest,
+) (*api.ExtractEntryContentResponse, error) {
+
+	entry, err := svc.ds.ExtractEntryContent(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.ExtractEntryContentResponse{Entry: toEntryPb(entry)}
+
+	return &rsp, nil
+}
+
+// SearchEntries satisfies the service API.
+func (svc *service) SearchEntries(
+	ctx context.Context,
+	req *api.SearchEntriesRequest,
+) (*api.SearchEntriesResponse, error) {
+
+	results, err := svc.ds.SearchEntries(
+		ctx,
+		req.GetQuery(),
+		req.GetFeedIds(),
+		req.GetTags(),
+		req.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.SearchEntriesResponse{Results: toSearchResultPbs(results)}
+
+	return &rsp, nil
+}
+
+// PruneEntries satisfies the service API.
+func (svc *service) PruneEntries(
+	ctx context.Context,
+	req *api.PruneEntriesRequest,
+) (*api.PruneEntriesResponse, error) {
+
+	entries, err := svc.ds.PruneEntries(ctx, req.GetFeedIds(), req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.PruneEntriesResponse{Entries: toEntryPbs(entries)}
+
+	return &rsp, nil
+}
+
 // ExportOPML satisfies the service API.
 func (svc *service) ExportOPML(
 	ctx context.Context,
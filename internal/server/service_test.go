@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal"
@@ -36,6 +37,7 @@ func TestAddFeedOk(t *testing.T) {
 		Description: pointer("user-description"),
 		Tags:        []string{"tag-1", "tag-2", "tag-3"},
 		IsStarred:   pointer(true),
+		Credentials: &api.FeedCredentials{Username: pointer("user"), Password: pointer("pass")},
 	}
 	record := &entity.Feed{
 		ID:          entity.ID(5),
@@ -56,6 +58,9 @@ func TestAddFeedOk(t *testing.T) {
 			req.Description,
 			req.Tags,
 			req.IsStarred,
+			&entity.FeedCredentials{Username: pointer("user"), Password: pointer("pass")},
+			nil,
+			nil,
 			nil,
 		).
 		Return(record, true, nil)
@@ -71,6 +76,144 @@ func TestAddFeedOk(t *testing.T) {
 	a.Equal(record.IsStarred, rsp.Feed.IsStarred)
 }
 
+func TestAddFeedOkExec(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	req := api.AddFeedRequest{
+		Url: "exec:./report.sh",
+		Exec: &api.ExecOptions{
+			Dir:     pointer("/srv/reports"),
+			Timeout: durationpb.New(30 * time.Second),
+		},
+	}
+	record := &entity.Feed{ID: entity.ID(5), Title: "Reports", FeedURL: "exec:./report.sh"}
+
+	ds.EXPECT().
+		AddFeed(
+			gomock.Any(),
+			req.GetUrl(),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			&entity.ExecOptions{Dir: pointer("/srv/reports"), Timeout: pointer(30 * time.Second)},
+			nil,
+			nil,
+		).
+		Return(record, true, nil)
+
+	rsp, err := client.AddFeed(context.Background(), &req)
+	r.NoError(err)
+
+	a.True(rsp.IsAdded)
+	a.Equal(record.FeedURL, rsp.Feed.FeedUrl)
+}
+
+func TestAddFeedErrAmbiguous(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	req := api.AddFeedRequest{Url: "http://foo.com"}
+	cands := []*entity.FeedCandidate{
+		{URL: "http://foo.com/rss.xml"},
+		{URL: "http://foo.com/atom.xml"},
+	}
+
+	ds.EXPECT().
+		AddFeed(gomock.Any(), req.GetUrl(), nil, nil, nil, nil, nil, nil, nil, nil).
+		Return(
+			nil,
+			false,
+			fmt.Errorf("wrapped: %w", entity.AmbiguousFeedError{URL: req.Url, Candidates: cands}),
+		)
+
+	rsp, err := client.AddFeed(context.Background(), &req)
+	r.Nil(rsp)
+
+	a.EqualError(
+		err,
+		"rpc error: code = FailedPrecondition desc = found 2 feeds at http://foo.com, choose one"+
+			" of: http://foo.com/rss.xml, http://foo.com/atom.xml",
+	)
+}
+
+func TestAddFeedErrInvalidCredentials(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	req := api.AddFeedRequest{
+		Url:         "http://foo.com/feed.xml",
+		Credentials: &api.FeedCredentials{Password: pointer("pass")},
+	}
+
+	ds.EXPECT().
+		AddFeed(
+			gomock.Any(),
+			req.GetUrl(),
+			nil,
+			nil,
+			nil,
+			nil,
+			&entity.FeedCredentials{Password: pointer("pass")},
+			nil,
+			nil,
+			nil,
+		).
+		Return(
+			nil,
+			false,
+			fmt.Errorf("wrapped: %w", entity.InvalidFeedCredentialsError{Reason: "no username"}),
+		)
+
+	rsp, err := client.AddFeed(context.Background(), &req)
+	r.Nil(rsp)
+
+	a.EqualError(
+		err,
+		"rpc error: code = InvalidArgument desc = invalid feed credentials: no username",
+	)
+}
+
+func TestDiscoverFeedsOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	cands := []*entity.FeedCandidate{
+		{URL: "http://foo.com/rss.xml", Title: pointer("Posts"), Format: pointer("rss")},
+		{URL: "http://foo.com/atom.xml"},
+	}
+
+	ds.EXPECT().
+		DiscoverFeeds(gomock.Any(), "http://foo.com", nil).
+		Return(cands, nil)
+
+	req := api.DiscoverFeedsRequest{Url: "http://foo.com"}
+	rsp, err := client.DiscoverFeeds(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetCandidates(), 2)
+	a.Equal("http://foo.com/rss.xml", rsp.GetCandidates()[0].GetUrl())
+	a.Equal(pointer("Posts"), rsp.GetCandidates()[0].Title)
+	a.Equal(pointer("rss"), rsp.GetCandidates()[0].Format)
+	a.Equal("http://foo.com/atom.xml", rsp.GetCandidates()[1].GetUrl())
+	a.Nil(rsp.GetCandidates()[1].Title)
+	a.Nil(rsp.GetCandidates()[1].Format)
+}
+
 func TestListFeedsOk(t *testing.T) {
 	t.Parallel()
 
@@ -95,6 +238,9 @@ func TestListFeedsOk(t *testing.T) {
 			Subscribed: mustTimeVV(t, "2022-06-22T19:39:44.037+02:00"),
 			LastPulled: mustTimeVV(t, "2022-06-22T19:39:44.037+02:00"),
 			Updated:    pointer(mustTimeVV(t, "2022-04-20T16:32:30.760+02:00")),
+
+			ConsecutiveFailures: 3,
+			LastError:           pointer("404 Not Found"),
 		},
 	}
 
@@ -106,7 +252,11 @@ func TestListFeedsOk(t *testing.T) {
 	r.NoError(err)
 
 	// TODO: Expand test.
-	a.Len(rsp.GetFeeds(), 2)
+	r.Len(rsp.GetFeeds(), 2)
+	a.Zero(rsp.GetFeeds()[0].GetConsecutiveFailures())
+	a.Nil(rsp.GetFeeds()[0].LastError)
+	a.Equal(uint32(3), rsp.GetFeeds()[1].GetConsecutiveFailures())
+	a.Equal(pointer("404 Not Found"), rsp.GetFeeds()[1].LastError)
 }
 
 func TestEditFeedsOk(t *testing.T) {
@@ -274,7 +424,7 @@ func TestPullFeedsAllOk(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
 		Return(ch)
 
 	req := api.PullFeedsRequest{}
@@ -284,7 +434,7 @@ func TestPullFeedsAllOk(t *testing.T) {
 	var (
 		rsp       *api.PullFeedsResponse
 		errStream error
-		rsps      = make([]*api.PullFeedsResponse, 2)
+		rsps      = make([]*api.PullFeedsResponse, 3)
 	)
 
 	for i := 0; i < len(rsps); i++ {
@@ -312,6 +462,12 @@ func TestPullFeedsAllOk(t *testing.T) {
 	r.Nil(rsp1.Error)
 	r.NotNil(rsp0.Feed)
 	a.Len(rsp1.GetFeed().GetEntries(), 1)
+
+	rsp2 := rsps[2]
+	r.Equal(prs[1].URL(), rsp2.GetUrl())
+	r.Nil(rsp2.Error)
+	a.Nil(rsp2.Feed)
+	a.Equal(api.PullFeedsResponse_SUCCESS, rsp2.GetStatus())
 }
 
 func TestPullFeedsSelectedAllOk(t *testing.T) {
@@ -355,17 +511,17 @@ func TestPullFeedsSelectedAllOk(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{2, 3}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{2, 3}, gomock.Any(), gomock.Any(), nil, true).
 		Return(ch)
 
-	req := api.PullFeedsRequest{FeedIds: []uint32{2, 3}}
+	req := api.PullFeedsRequest{FeedIds: []uint32{2, 3}, Force: true}
 	stream, err := client.PullFeeds(context.Background(), &req)
 	r.NoError(err)
 
 	var (
 		rsp       *api.PullFeedsResponse
 		errStream error
-		rsps      = make([]*api.PullFeedsResponse, 1)
+		rsps      = make([]*api.PullFeedsResponse, 2)
 	)
 
 	for i := 0; i < len(rsps); i++ {
@@ -387,6 +543,11 @@ func TestPullFeedsSelectedAllOk(t *testing.T) {
 	r.Nil(rsp0.Error)
 	r.NotNil(rsp0.Feed)
 	a.Len(rsp0.GetFeed().GetEntries(), 1)
+
+	rsp1 := rsps[1]
+	r.Equal(prs[0].URL(), rsp1.GetUrl())
+	r.Nil(rsp1.Error)
+	a.Nil(rsp1.Feed)
 }
 
 func TestPullFeedsErrSomeFeed(t *testing.T) {
@@ -445,7 +606,7 @@ func TestPullFeedsErrSomeFeed(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
 		Return(ch)
 
 	req := api.PullFeedsRequest{}
@@ -455,7 +616,7 @@ func TestPullFeedsErrSomeFeed(t *testing.T) {
 	var (
 		rsp       *api.PullFeedsResponse
 		errStream error
-		rsps      = make([]*api.PullFeedsResponse, 3)
+		rsps      = make([]*api.PullFeedsResponse, 4)
 	)
 
 	for i := 0; i < len(rsps); i++ {
@@ -485,6 +646,12 @@ func TestPullFeedsErrSomeFeed(t *testing.T) {
 	r.Equal(prs[1].URL(), rsp2.GetUrl())
 	a.Nil(rsp2.GetFeed())
 	a.Equal("timed out", rsp2.GetError())
+	a.Equal(api.PullFeedsResponse_FAIL, rsp2.GetStatus())
+
+	rsp3 := rsps[3]
+	r.Equal(prs[2].URL(), rsp3.GetUrl())
+	a.Nil(rsp3.GetFeed())
+	a.Nil(rsp3.Error)
 }
 
 func TestPullFeedsErrNonFeed(t *testing.T) {
@@ -543,7 +710,7 @@ func TestPullFeedsErrNonFeed(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
 		Return(ch)
 
 	req := api.PullFeedsRequest{}
@@ -572,6 +739,55 @@ func TestPullFeedsErrNonFeed(t *testing.T) {
 	a.EqualError(err, "rpc error: code = Unknown desc = tx error")
 }
 
+func TestPullFeedsStatus(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	notModified := entity.NewPullResultNotModified(pointer("https://a.com/feed.xml"))
+	notModified.SetThrottled(2 * time.Second)
+	prs := []entity.PullResult{
+		notModified,
+		entity.NewPullResultSkipped(pointer("https://b.com/feed.xml")),
+	}
+
+	ch := make(chan entity.PullResult)
+	go func() {
+		defer close(ch)
+		for _, pr := range prs {
+			ch <- pr
+		}
+	}()
+
+	ds.EXPECT().
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
+		Return(ch)
+
+	req := api.PullFeedsRequest{}
+	stream, err := client.PullFeeds(context.Background(), &req)
+	r.NoError(err)
+
+	rsp0, err := stream.Recv()
+	r.NoError(err)
+	a.Equal("https://a.com/feed.xml", rsp0.GetUrl())
+	a.Equal(api.PullFeedsResponse_NOT_MODIFIED, rsp0.GetStatus())
+	a.Equal(2*time.Second, rsp0.GetThrottled().AsDuration())
+	a.Nil(rsp0.Feed)
+	a.Nil(rsp0.Error)
+
+	rsp1, err := stream.Recv()
+	r.NoError(err)
+	a.Equal("https://b.com/feed.xml", rsp1.GetUrl())
+	a.Equal(api.PullFeedsResponse_SKIPPED, rsp1.GetStatus())
+	a.Nil(rsp1.Throttled)
+
+	rsp, err := stream.Recv()
+	a.ErrorIs(err, io.EOF)
+	a.Nil(rsp)
+}
+
 func TestListEntriesOk(t *testing.T) {
 	t.Parallel()
 
@@ -728,6 +944,10 @@ func TestGetEntryOk(t *testing.T) {
 		Published: pointer(mustTimeVV(t, "2023-07-12T05:02:23.764+02:00")),
 		Content:   pointer("Hello"),
 		URL:       pointer("http://x.com/posts/test-feed-entry.html"),
+		Authors:   []string{"Alice"},
+		Enclosures: []*entity.Enclosure{
+			{URL: "http://x.com/posts/test.mp3", Length: pointer(uint64(1024))},
+		},
 	}
 
 	ds.EXPECT().
@@ -750,9 +970,183 @@ func TestGetEntryOk(t *testing.T) {
 	a.Empty(re.GetDescription())
 	a.Equal(*entry.Content, re.GetContent())
 	a.Equal(*entry.URL, re.GetUrl())
+	a.Equal(entry.Authors, re.GetAuthors())
+	a.Empty(re.GetCategories())
+	r.Len(re.GetEnclosures(), 1)
+	a.Equal("http://x.com/posts/test.mp3", re.GetEnclosures()[0].GetUrl())
+	a.Nil(re.GetEnclosures()[0].MimeType)
+	a.Equal(uint64(1024), re.GetEnclosures()[0].GetLength())
 	// TODO: Also test timestamps.
 }
 
+func TestExtractEntryContentOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+
+	client, ds := setupServerTest(t)
+
+	entry := entity.Entry{
+		ID:          2,
+		FeedID:      3,
+		Title:       "Test Feed Entry",
+		Description: pointer("Truncated"),
+		Content:     pointer("<div>Full</div>"),
+		URL:         pointer("http://x.com/posts/test-feed-entry.html"),
+	}
+
+	ds.EXPECT().
+		ExtractEntryContent(gomock.Any(), entity.ID(2)).
+		Return(&entry, nil)
+
+	rsp, err := client.ExtractEntryContent(
+		context.Background(),
+		&api.ExtractEntryContentRequest{Id: 2},
+	)
+	r.NoError(err)
+	r.NotNil(rsp)
+	r.NotNil(rsp.Entry)
+	a.Equal(entry.ID, rsp.Entry.Id)
+	a.Equal("Truncated", rsp.Entry.GetDescription())
+	a.Equal("<div>Full</div>", rsp.Entry.GetContent())
+}
+
+func TestExtractEntryContentErrNoURL(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		ExtractEntryContent(gomock.Any(), entity.ID(2)).
+		Return(nil, fmt.Errorf("wrapped: %w", entity.NoEntryURLError{ID: entity.ID(2)}))
+
+	rsp, err := client.ExtractEntryContent(
+		context.Background(),
+		&api.ExtractEntryContentRequest{Id: 2},
+	)
+	r.Nil(rsp)
+	a.ErrorContains(err, "code = FailedPrecondition")
+}
+
+func TestSearchEntriesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	results := []*entity.SearchResult{
+		{
+			Entry:   &entity.Entry{ID: 5, FeedID: 2, Title: "Garbage collection"},
+			Snippet: "\x02Garbage\x03 collection",
+			Rank:    -2.5,
+		},
+		{
+			Entry:   &entity.Entry{ID: 9, FeedID: 3, Title: "Recipes"},
+			Snippet: "a \x02garbage\x03-free kitchen",
+			Rank:    -0.5,
+		},
+	}
+
+	ds.EXPECT().
+		SearchEntries(gomock.Any(), "garbage", []entity.ID{2, 3}, []string{"go"}, pointer(uint32(5))).
+		Return(results, nil)
+
+	req := api.SearchEntriesRequest{
+		Query:      "garbage",
+		FeedIds:    []uint32{2, 3},
+		Tags:       []string{"go"},
+		MaxResults: pointer(uint32(5)),
+	}
+	rsp, err := client.SearchEntries(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetResults(), 2)
+	res0 := rsp.GetResults()[0]
+	a.Equal(uint32(5), res0.GetEntry().GetId())
+	a.Equal(uint32(2), res0.GetEntry().GetFeedId())
+	a.Equal(results[0].Snippet, res0.GetSnippet())
+	a.Equal(results[0].Rank, res0.GetRank())
+	res1 := rsp.GetResults()[1]
+	a.Equal(uint32(9), res1.GetEntry().GetId())
+	a.Equal(results[1].Snippet, res1.GetSnippet())
+}
+
+func TestSearchEntriesErrInvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		SearchEntries(gomock.Any(), `"foo`, gomock.Any(), gomock.Any(), nil).
+		Return(
+			nil,
+			fmt.Errorf(
+				"wrapped: %w",
+				entity.InvalidSearchQueryError{Query: `"foo`, Err: fmt.Errorf("unterminated string")},
+			),
+		)
+
+	req := api.SearchEntriesRequest{Query: `"foo`}
+	rsp, err := client.SearchEntries(context.Background(), &req)
+	r.Nil(rsp)
+
+	a.EqualError(
+		err,
+		`rpc error: code = InvalidArgument desc = invalid search query "\"foo": unterminated string`,
+	)
+}
+
+func TestPruneEntriesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	entries := []*entity.Entry{
+		{ID: 5, FeedID: 2, Title: "Old news", IsRead: true},
+		{ID: 7, FeedID: 2, Title: "Older news", IsRead: true},
+	}
+
+	ds.EXPECT().
+		PruneEntries(gomock.Any(), []entity.ID{2}, true).
+		Return(entries, nil)
+
+	req := api.PruneEntriesRequest{FeedIds: []uint32{2}, DryRun: true}
+	rsp, err := client.PruneEntries(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetEntries(), 2)
+	a.Equal(uint32(5), rsp.GetEntries()[0].GetId())
+	a.Equal("Old news", rsp.GetEntries()[0].GetTitle())
+	a.Equal(uint32(7), rsp.GetEntries()[1].GetId())
+}
+
+func TestPruneEntriesErrFeedNotFound(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		PruneEntries(gomock.Any(), []entity.ID{48}, false).
+		Return(nil, fmt.Errorf("wrapped: %w", entity.FeedNotFoundError{ID: entity.ID(48)}))
+
+	req := api.PruneEntriesRequest{FeedIds: []uint32{48}}
+	rsp, err := client.PruneEntries(context.Background(), &req)
+	r.Nil(rsp)
+
+	a.EqualError(err, "rpc error: code = NotFound desc = feed with ID=48 not found")
+}
+
 func TestExportOPMLOk(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,423 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505: required by hubs that sign with sha1.
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+)
+
+const (
+	// websubPath is the path under which the WebSub callbacks of feeds are served, followed by
+	// the feed ID.
+	websubPath = "/websub/"
+	// defaultWebSubTick is the default interval between checks for subscriptions to request or
+	// renew.
+	defaultWebSubTick = 1 * time.Minute
+	// defaultWebSubLease is the lease requested from hubs, which may grant a different one.
+	defaultWebSubLease = 7 * 24 * time.Hour
+	// websubRenewMargin is how long before their expiry active subscriptions are renewed.
+	websubRenewMargin = 1 * time.Hour
+	// websubRetryDelay is how long the manager waits for a requested subscription to be verified
+	// before requesting it again.
+	websubRetryDelay = 15 * time.Minute
+	// websubRequestTimeout is the timeout of subscription requests to hubs.
+	websubRequestTimeout = 30 * time.Second
+)
+
+// websubManager subscribes to the WebSub hubs advertised by feeds, and serves the callbacks
+// through which hubs verify the subscriptions and push the contents of updated feeds.
+type websubManager struct {
+	ds datastore.Datastore
+
+	// baseURL is the public URL at which the callbacks are served, without the callback path.
+	baseURL string
+	client  *http.Client
+	mux     *http.ServeMux
+
+	// lease is the subscription lease requested from hubs.
+	lease time.Duration
+	// tick is the interval between checks for subscriptions to request or renew.
+	tick time.Duration
+
+	// lastAttempts records when the manager last requested the subscription of a feed, so that
+	// unverified subscriptions are not requested again on every tick.
+	lastAttempts map[entity.ID]time.Time
+
+	now       func() time.Time
+	newSecret func() (string, error)
+}
+
+func newWebSubManager(ds datastore.Datastore, baseURL string) *websubManager {
+	m := websubManager{
+		ds:           ds,
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		client:       &http.Client{Timeout: websubRequestTimeout},
+		mux:          http.NewServeMux(),
+		lease:        defaultWebSubLease,
+		tick:         defaultWebSubTick,
+		lastAttempts: make(map[entity.ID]time.Time),
+		now:          time.Now,
+		newSecret: func() (string, error) {
+			raw := make([]byte, 32)
+			if _, err := rand.Read(raw); err != nil {
+				return "", err
+			}
+			return hex.EncodeToString(raw), nil
+		},
+	}
+	m.mux.HandleFunc("GET "+websubPath+"{id}", m.handleVerification)
+	m.mux.HandleFunc("POST "+websubPath+"{id}", m.handlePush)
+	return &m
+}
+
+// ServeHTTP satisfies the http.Handler interface.
+func (m *websubManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mux.ServeHTTP(w, r)
+}
+
+// run starts the subscription loop, returning only when the given context is done.
+func (m *websubManager) run(ctx context.Context) {
+	pkgLogger.Info().
+		Str("base_url", m.baseURL).
+		Msg("starting websub manager")
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			pkgLogger.Debug().Msg("stopped websub manager")
+			return
+		case <-timer.C:
+			m.subscribeDue(ctx)
+			timer.Reset(m.tick)
+		}
+	}
+}
+
+// subscribeDue requests all subscriptions that are due to be requested or renewed.
+func (m *websubManager) subscribeDue(ctx context.Context) {
+
+	subs, err := m.ds.ListWebSubSubscriptions(ctx)
+	if err != nil {
+		pkgLogger.Error().Err(err).Msg("websub manager failed to list subscriptions")
+		return
+	}
+
+	for _, sub := range m.dueSubscriptions(subs) {
+		m.lastAttempts[sub.FeedID] = m.now()
+		if err := m.subscribe(ctx, sub); err != nil {
+			pkgLogger.Warn().
+				Err(err).
+				Str("hub", sub.HubURL).
+				Str("topic", sub.TopicURL).
+				Msg("websub subscription request failed")
+		}
+	}
+}
+
+// dueSubscriptions returns the subscriptions that have not been requested yet, those whose
+// request has not been verified within the retry delay, and active ones that are about to expire.
+func (m *websubManager) dueSubscriptions(
+	subs []*entity.WebSubSubscription,
+) []*entity.WebSubSubscription {
+
+	var (
+		now  = m.now()
+		due  = make([]*entity.WebSubSubscription, 0)
+		seen = make(map[entity.ID]struct{}, len(subs))
+	)
+	for _, sub := range subs {
+		seen[sub.FeedID] = struct{}{}
+
+		if attempt, exists := m.lastAttempts[sub.FeedID]; exists &&
+			now.Sub(attempt) < websubRetryDelay {
+			continue
+		}
+
+		switch sub.State {
+		case entity.WebSubUnsubscribed, entity.WebSubPending:
+			due = append(due, sub)
+		case entity.WebSubActive:
+			if sub.LeaseExpires == nil || !now.Before(sub.LeaseExpires.Add(-websubRenewMargin)) {
+				due = append(due, sub)
+			}
+		case entity.WebSubDenied:
+		}
+	}
+
+	// Forget attempts of subscriptions that no longer exist.
+	for id := range m.lastAttempts {
+		if _, exists := seen[id]; !exists {
+			delete(m.lastAttempts, id)
+		}
+	}
+
+	return due
+}
+
+// subscribe requests the given subscription from its hub. New subscriptions get a new secret and
+// are marked as pending before the request is sent, since hubs may verify them before responding,
+// while active ones are renewed with their current secret.
+func (m *websubManager) subscribe(ctx context.Context, sub *entity.WebSubSubscription) error {
+
+	secret := ""
+	if sub.State == entity.WebSubActive && sub.Secret != nil {
+		secret = *sub.Secret
+	} else {
+		var err error
+		if secret, err = m.newSecret(); err != nil {
+			return err
+		}
+		pending := *sub
+		pending.State = entity.WebSubPending
+		pending.Secret = &secret
+		if err = m.ds.UpdateWebSubSubscription(ctx, &pending); err != nil {
+			return err
+		}
+	}
+
+	form := url.Values{
+		"hub.mode":          {"subscribe"},
+		"hub.topic":         {sub.TopicURL},
+		"hub.callback":      {m.feedCallbackURL(sub.FeedID)},
+		"hub.secret":        {secret},
+		"hub.lease_seconds": {strconv.FormatInt(int64(m.lease/time.Second), 10)},
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		sub.HubURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", datastore.DefaultUserAgent())
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("hub responded with status %s", resp.Status)
+	}
+
+	pkgLogger.Debug().
+		Str("hub", sub.HubURL).
+		Str("topic", sub.TopicURL).
+		Msg("requested websub subscription")
+
+	return nil
+}
+
+func (m *websubManager) feedCallbackURL(feedID entity.ID) string {
+	return m.baseURL + websubPath + strconv.FormatUint(uint64(feedID), 10)
+}
+
+// findSubscription returns the subscription of the feed whose ID is in the given request path, or
+// nil if the feed has none.
+func (m *websubManager) findSubscription(r *http.Request) (*entity.WebSubSubscription, error) {
+
+	feedID, err := entity.ToFeedID(r.PathValue("id"))
+	if err != nil {
+		return nil, nil
+	}
+
+	subs, err := m.ds.ListWebSubSubscriptions(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		if sub.FeedID == feedID {
+			return sub, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// handleVerification answers the intent verification requests of hubs, confirming only the
+// subscriptions the manager has requested, and the unsubscriptions of feeds it no longer
+// subscribes to.
+func (m *websubManager) handleVerification(w http.ResponseWriter, r *http.Request) {
+
+	sub, err := m.findSubscription(r)
+	if err != nil {
+		pkgLogger.Error().Err(err).Msg("websub manager failed to find subscription")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		query     = r.URL.Query()
+		mode      = query.Get("hub.mode")
+		challenge = query.Get("hub.challenge")
+		matches   = sub != nil && sub.TopicURL == query.Get("hub.topic")
+	)
+
+	switch mode {
+
+	case "subscribe":
+		if !matches || challenge == "" ||
+			(sub.State != entity.WebSubPending && sub.State != entity.WebSubActive) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		secs, err := strconv.ParseInt(query.Get("hub.lease_seconds"), 10, 64)
+		if err != nil || secs <= 0 {
+			secs = int64(m.lease / time.Second)
+		}
+		active := *sub
+		active.State = entity.WebSubActive
+		expires := m.now().Add(time.Duration(secs) * time.Second)
+		active.LeaseExpires = &expires
+		if err := m.ds.UpdateWebSubSubscription(r.Context(), &active); err != nil {
+			pkgLogger.Error().Err(err).Msg("websub manager failed to activate subscription")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		pkgLogger.Info().
+			Str("topic", sub.TopicURL).
+			Time("lease_expires", *active.LeaseExpires).
+			Msg("websub subscription verified")
+
+	case "unsubscribe":
+		if matches || challenge == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+	case "denied":
+		if matches {
+			denied := *sub
+			denied.State = entity.WebSubDenied
+			denied.Secret = nil
+			denied.LeaseExpires = nil
+			if err := m.ds.UpdateWebSubSubscription(r.Context(), &denied); err != nil {
+				pkgLogger.Error().Err(err).Msg("websub manager failed to record denial")
+			}
+			pkgLogger.Warn().
+				Str("topic", sub.TopicURL).
+				Str("reason", query.Get("hub.reason")).
+				Msg("websub subscription denied")
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, challenge)
+}
+
+// handlePush stores the feed contents pushed by hubs. Contents that are not signed with the secret
+// of the subscription are acknowledged, as required by the specification, but ignored.
+func (m *websubManager) handlePush(w http.ResponseWriter, r *http.Request) {
+
+	sub, err := m.findSubscription(r)
+	if err != nil {
+		pkgLogger.Error().Err(err).Msg("websub manager failed to find subscription")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if sub == nil {
+		w.WriteHeader(http.StatusGone)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, datastore.DefaultMaxResponseSize+1))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > datastore.DefaultMaxResponseSize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if sub.Secret == nil || !validSignature(r.Header.Get("X-Hub-Signature"), *sub.Secret, body) {
+		pkgLogger.Warn().
+			Str("topic", sub.TopicURL).
+			Msg("ignored websub content with invalid signature")
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	n, err := m.ds.StorePushedFeed(r.Context(), sub.FeedID, body)
+	if err != nil {
+		pkgLogger.Error().Err(err).Str("topic", sub.TopicURL).Msg("failed to store websub content")
+		if errors.As(err, new(entity.FeedNotFoundError)) {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	pkgLogger.Info().
+		Str("topic", sub.TopicURL).
+		Int("num_new_entries", n).
+		Msg("stored websub content")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// signatureHashes are the hash functions hubs may sign contents with, by their names in the
+// X-Hub-Signature header.
+var signatureHashes = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// validSignature returns true if the given X-Hub-Signature header value is the HMAC of the given
+// body, keyed with the given secret.
+func validSignature(header, secret string, body []byte) bool {
+
+	method, signature, found := strings.Cut(strings.TrimSpace(header), "=")
+	if !found {
+		return false
+	}
+	newHash, ok := signatureHashes[strings.ToLower(method)]
+	if !ok {
+		return false
+	}
+	want, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), want)
+}
@@ -0,0 +1,380 @@
+// Copyright (c) 2026 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" // #nosec G505
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// testWebSubHub is a stand-in WebSub hub, which verifies subscription requests before answering
+// them and pushes signed contents to the verified callbacks.
+type testWebSubHub struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu        sync.Mutex
+	form      url.Values
+	verified  bool
+	leaseSecs string
+}
+
+func newTestWebSubHub(t *testing.T) *testWebSubHub {
+	t.Helper()
+
+	hub := testWebSubHub{t: t, leaseSecs: "3600"}
+	hub.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		query := url.Values{
+			"hub.mode":          {"subscribe"},
+			"hub.topic":         {r.PostForm.Get("hub.topic")},
+			"hub.challenge":     {"ch4ll3ng3"},
+			"hub.lease_seconds": {hub.leaseSecs},
+		}
+		resp, err := http.Get(r.PostForm.Get("hub.callback") + "?" + query.Encode())
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+
+		hub.mu.Lock()
+		hub.form = r.PostForm
+		hub.verified = resp.StatusCode == http.StatusOK && string(body) == "ch4ll3ng3"
+		hub.mu.Unlock()
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(hub.srv.Close)
+
+	return &hub
+}
+
+// push sends the given content to the callback of the verified subscription, signed with the
+// given hash function and secret.
+func (hub *testWebSubHub) push(
+	newHash func() hash.Hash,
+	method string,
+	secret string,
+	content []byte,
+) int {
+	hub.t.Helper()
+
+	hub.mu.Lock()
+	callback := hub.form.Get("hub.callback")
+	hub.mu.Unlock()
+
+	req, err := http.NewRequest(http.MethodPost, callback, bytes.NewReader(content))
+	require.NoError(hub.t, err)
+	req.Header.Set("Content-Type", "application/atom+xml")
+	if newHash != nil {
+		mac := hmac.New(newHash, []byte(secret))
+		_, _ = mac.Write(content)
+		req.Header.Set("X-Hub-Signature", method+"="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(hub.t, err)
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestWebSubManagerSubscribeAndPush(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	hub := newTestWebSubHub(t)
+	ds := NewMockDatastore(gomock.NewController(t))
+
+	var (
+		mu  sync.Mutex
+		sub = entity.WebSubSubscription{
+			FeedID:   3,
+			HubURL:   hub.srv.URL,
+			TopicURL: "http://a.com/feed.xml",
+			State:    entity.WebSubUnsubscribed,
+		}
+	)
+	ds.EXPECT().
+		ListWebSubSubscriptions(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(context.Context) ([]*entity.WebSubSubscription, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			current := sub
+			return []*entity.WebSubSubscription{&current}, nil
+		})
+	ds.EXPECT().
+		UpdateWebSubSubscription(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(_ context.Context, update *entity.WebSubSubscription) error {
+			mu.Lock()
+			defer mu.Unlock()
+			sub = *update
+			return nil
+		})
+
+	m := newWebSubManager(nil, "")
+	m.ds = ds
+	m.now = func() time.Time { return now }
+	m.newSecret = func() (string, error) { return "s3cr3t", nil }
+	callbacks := httptest.NewServer(m)
+	t.Cleanup(callbacks.Close)
+	m.baseURL = callbacks.URL
+
+	m.subscribeDue(context.Background())
+
+	hub.mu.Lock()
+	a.True(hub.verified)
+	a.Equal("subscribe", hub.form.Get("hub.mode"))
+	a.Equal("http://a.com/feed.xml", hub.form.Get("hub.topic"))
+	a.Equal(callbacks.URL+"/websub/3", hub.form.Get("hub.callback"))
+	a.Equal("s3cr3t", hub.form.Get("hub.secret"))
+	a.Equal("604800", hub.form.Get("hub.lease_seconds"))
+	hub.mu.Unlock()
+
+	mu.Lock()
+	a.Equal(entity.WebSubActive, sub.State)
+	a.Equal(pointer("s3cr3t"), sub.Secret)
+	a.Equal(pointer(now.Add(time.Hour)), sub.LeaseExpires)
+	mu.Unlock()
+
+	// Verified subscriptions are not requested again until they are about to expire.
+	a.Empty(m.dueSubscriptions([]*entity.WebSubSubscription{&sub}))
+
+	content := []byte(`<feed xmlns="http://www.w3.org/2005/Atom"><title>A</title></feed>`)
+	ds.EXPECT().
+		StorePushedFeed(gomock.Any(), entity.ID(3), content).
+		Times(2).
+		Return(1, nil)
+
+	a.Equal(http.StatusNoContent, hub.push(sha256.New, "sha256", "s3cr3t", content))
+	a.Equal(http.StatusNoContent, hub.push(sha1.New, "sha1", "s3cr3t", content))
+
+	// Contents with invalid signatures are acknowledged but ignored.
+	a.Equal(http.StatusAccepted, hub.push(sha256.New, "sha256", "wrong", content))
+	a.Equal(http.StatusAccepted, hub.push(sha256.New, "md5", "s3cr3t", content))
+	a.Equal(http.StatusAccepted, hub.push(nil, "", "", content))
+
+	// Contents for feeds without a subscription are refused.
+	req, err := http.NewRequest(http.MethodPost, callbacks.URL+"/websub/4", nil)
+	r.NoError(err)
+	resp, err := http.DefaultClient.Do(req)
+	r.NoError(err)
+	resp.Body.Close()
+	a.Equal(http.StatusGone, resp.StatusCode)
+}
+
+func TestWebSubManagerVerification(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	pending := entity.WebSubSubscription{
+		FeedID:   3,
+		HubURL:   "http://hub.com/",
+		TopicURL: "http://a.com/feed.xml",
+		State:    entity.WebSubPending,
+		Secret:   pointer("s3cr3t"),
+	}
+
+	tests := []struct {
+		name       string
+		query      url.Values
+		feedID     string
+		wantStatus int
+		wantBody   string
+		wantUpdate *entity.WebSubSubscription
+	}{
+		{
+			name: "subscribe ok",
+			query: url.Values{
+				"hub.mode":          {"subscribe"},
+				"hub.topic":         {pending.TopicURL},
+				"hub.challenge":     {"abc"},
+				"hub.lease_seconds": {"60"},
+			},
+			feedID:     "3",
+			wantStatus: http.StatusOK,
+			wantBody:   "abc",
+			wantUpdate: &entity.WebSubSubscription{
+				FeedID:       3,
+				HubURL:       pending.HubURL,
+				TopicURL:     pending.TopicURL,
+				State:        entity.WebSubActive,
+				Secret:       pending.Secret,
+				LeaseExpires: pointer(now.Add(time.Minute)),
+			},
+		},
+		{
+			name: "subscribe other topic",
+			query: url.Values{
+				"hub.mode":      {"subscribe"},
+				"hub.topic":     {"http://b.com/feed.xml"},
+				"hub.challenge": {"abc"},
+			},
+			feedID:     "3",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "subscribe unknown feed",
+			query: url.Values{
+				"hub.mode":      {"subscribe"},
+				"hub.topic":     {pending.TopicURL},
+				"hub.challenge": {"abc"},
+			},
+			feedID:     "4",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "unsubscribe wanted",
+			query: url.Values{
+				"hub.mode":      {"unsubscribe"},
+				"hub.topic":     {pending.TopicURL},
+				"hub.challenge": {"abc"},
+			},
+			feedID:     "3",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "unsubscribe unwanted",
+			query: url.Values{
+				"hub.mode":      {"unsubscribe"},
+				"hub.topic":     {pending.TopicURL},
+				"hub.challenge": {"abc"},
+			},
+			feedID:     "4",
+			wantStatus: http.StatusOK,
+			wantBody:   "abc",
+		},
+		{
+			name: "denied",
+			query: url.Values{
+				"hub.mode":   {"denied"},
+				"hub.topic":  {pending.TopicURL},
+				"hub.reason": {"nope"},
+			},
+			feedID:     "3",
+			wantStatus: http.StatusOK,
+			wantUpdate: &entity.WebSubSubscription{
+				FeedID:   3,
+				HubURL:   pending.HubURL,
+				TopicURL: pending.TopicURL,
+				State:    entity.WebSubDenied,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := assert.New(t)
+			ds := NewMockDatastore(gomock.NewController(t))
+			m := newWebSubManager(ds, "http://neon.local")
+			m.now = func() time.Time { return now }
+
+			current := pending
+			ds.EXPECT().
+				ListWebSubSubscriptions(gomock.Any()).
+				Return([]*entity.WebSubSubscription{&current}, nil)
+			if test.wantUpdate != nil {
+				ds.EXPECT().
+					UpdateWebSubSubscription(gomock.Any(), test.wantUpdate).
+					Return(nil)
+			}
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/websub/"+test.feedID+"?"+test.query.Encode(),
+				nil,
+			)
+			m.ServeHTTP(rec, req)
+
+			a.Equal(test.wantStatus, rec.Code)
+			a.Equal(test.wantBody, rec.Body.String())
+		})
+	}
+}
+
+func TestWebSubManagerDueSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	m := newWebSubManager(nil, "http://neon.local")
+	m.now = func() time.Time { return now }
+	m.lastAttempts = map[entity.ID]time.Time{
+		2: now.Add(-time.Minute),
+		3: now.Add(-time.Hour),
+		9: now.Add(-time.Hour),
+	}
+
+	subs := []*entity.WebSubSubscription{
+		// Not yet requested.
+		{FeedID: 1, State: entity.WebSubUnsubscribed},
+		// Requested recently, not yet verified.
+		{FeedID: 2, State: entity.WebSubPending},
+		// Requested long ago, never verified.
+		{FeedID: 3, State: entity.WebSubPending},
+		// Active, lease far from expiry.
+		{FeedID: 4, State: entity.WebSubActive, LeaseExpires: pointer(now.Add(24 * time.Hour))},
+		// Active, lease about to expire.
+		{FeedID: 5, State: entity.WebSubActive, LeaseExpires: pointer(now.Add(time.Minute))},
+		// Denied by the hub.
+		{FeedID: 6, State: entity.WebSubDenied},
+	}
+
+	ids := make([]entity.ID, 0)
+	for _, sub := range m.dueSubscriptions(subs) {
+		ids = append(ids, sub.FeedID)
+	}
+
+	a.Equal([]entity.ID{1, 3, 5}, ids)
+	a.NotContains(m.lastAttempts, entity.ID(9))
+}
+
+func TestValidSignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte("content")
+	mac := hmac.New(sha256.New, []byte("key"))
+	_, _ = mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, validSignature("sha256="+signature, "key", body))
+	assert.True(t, validSignature("SHA256="+signature, "key", body))
+	assert.False(t, validSignature("sha256="+signature, "other", body))
+	assert.False(t, validSignature("sha512="+signature, "key", body))
+	assert.False(t, validSignature(signature, "key", body))
+	assert.False(t, validSignature("sha256=zz", "key", body))
+	assert.False(t, validSignature("", "key", body))
+}